@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 // Binance Test New Order endpoint (POST /api/v3/order/test)
@@ -320,10 +321,10 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 		r.setParam("quantity", strconv.FormatFloat(*s.quantity, 'f', -1, 64))
 	}
 	if s.quoteOrderQty != nil {
-		r.setParam("quoteOrderQty", *s.quoteOrderQty)
+		r.setParam("quoteOrderQty", strconv.FormatFloat(*s.quoteOrderQty, 'f', -1, 64))
 	}
 	if s.price != nil {
-		r.setParam("price", *s.price)
+		r.setParam("price", strconv.FormatFloat(*s.price, 'f', -1, 64))
 	}
 	if s.newClientOrderId != nil {
 		r.setParam("newClientOrderId", *s.newClientOrderId)
@@ -335,13 +336,13 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 		r.setParam("strategyType", *s.strategyType)
 	}
 	if s.stopPrice != nil {
-		r.setParam("stopPrice", *s.stopPrice)
+		r.setParam("stopPrice", strconv.FormatFloat(*s.stopPrice, 'f', -1, 64))
 	}
 	if s.trailingDelta != nil {
 		r.setParam("trailingDelta", *s.trailingDelta)
 	}
 	if s.icebergQty != nil {
-		r.setParam("icebergQty", *s.icebergQty)
+		r.setParam("icebergQty", strconv.FormatFloat(*s.icebergQty, 'f', -1, 64))
 	}
 	if s.newOrderRespType != nil {
 		r.setParam("newOrderRespType", *s.newOrderRespType)
@@ -1115,6 +1116,23 @@ func (s *GetAllOrdersService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
+// Range returns an iterator over every order created between from and to,
+// splitting the range into the 24 hour windows accepted by the endpoint
+func (s *GetAllOrdersService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*NewAllOrdersResponse] {
+	p := &windowPager[*NewAllOrdersResponse]{
+		window: 24 * time.Hour,
+		limit:  1000,
+		key:    func(o *NewAllOrdersResponse) any { return o.OrderId },
+		timeOf: func(o *NewAllOrdersResponse) uint64 { return o.Time },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*NewAllOrdersResponse, error) {
+			svc := *s
+			svc.orderId = nil
+			return svc.StartTime(start).EndTime(end).Limit(1000).Do(ctx)
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 // Create NewAllOrdersResponse
 type NewAllOrdersResponse struct {
 	Symbol                  string `json:"symbol"`
@@ -1394,6 +1412,261 @@ type OrderOCOResponse struct {
 	} `json:"orderReports"`
 }
 
+// Binance New Order list - OCO (TRADE) (POST /api/v3/orderList/oco)
+// OrderListOCOService create new OCO order list with an above and a below leg
+type OrderListOCOService struct {
+	c      *Client
+	params orderListOCOParams
+}
+
+// Symbol set symbol
+func (s *OrderListOCOService) Symbol(symbol string) *OrderListOCOService {
+	s.params.symbol = symbol
+	return s
+}
+
+// ListClientOrderId set listClientOrderId
+func (s *OrderListOCOService) ListClientOrderId(listClientOrderId string) *OrderListOCOService {
+	s.params.listClientOrderId = &listClientOrderId
+	return s
+}
+
+// Side set side
+func (s *OrderListOCOService) Side(side string) *OrderListOCOService {
+	s.params.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *OrderListOCOService) Quantity(quantity float64) *OrderListOCOService {
+	s.params.quantity = quantity
+	return s
+}
+
+// Above set the leg placed above the market price
+func (s *OrderListOCOService) Above(above *OrderListLeg) *OrderListOCOService {
+	s.params.above = above
+	return s
+}
+
+// Below set the leg placed below the market price
+func (s *OrderListOCOService) Below(below *OrderListLeg) *OrderListOCOService {
+	s.params.below = below
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *OrderListOCOService) NewOrderRespType(newOrderRespType string) *OrderListOCOService {
+	s.params.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *OrderListOCOService) SelfTradePreventionMode(selfTradePreventionMode string) *OrderListOCOService {
+	s.params.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request
+func (s *OrderListOCOService) Do(ctx context.Context, opts ...RequestOption) (res *OrderListResponse, err error) {
+	m, err := s.params.parameters()
+	if err != nil {
+		return nil, err
+	}
+	return s.c.placeOrderList(ctx, "/api/v3/orderList/oco", m, opts...)
+}
+
+// Binance New Order list - OTO (TRADE) (POST /api/v3/orderList/oto)
+// OrderListOTOService create a working order that places a pending order once it fills
+type OrderListOTOService struct {
+	c      *Client
+	params orderListOTOParams
+}
+
+// Symbol set symbol
+func (s *OrderListOTOService) Symbol(symbol string) *OrderListOTOService {
+	s.params.symbol = symbol
+	return s
+}
+
+// ListClientOrderId set listClientOrderId
+func (s *OrderListOTOService) ListClientOrderId(listClientOrderId string) *OrderListOTOService {
+	s.params.listClientOrderId = &listClientOrderId
+	return s
+}
+
+// Working set the working leg, LIMIT or LIMIT_MAKER
+func (s *OrderListOTOService) Working(working *OrderListLeg) *OrderListOTOService {
+	s.params.working = working
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *OrderListOTOService) WorkingSide(workingSide string) *OrderListOTOService {
+	s.params.workingSide = workingSide
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *OrderListOTOService) WorkingQuantity(workingQuantity float64) *OrderListOTOService {
+	s.params.workingQuantity = workingQuantity
+	return s
+}
+
+// Pending set the leg placed once the working order fills
+func (s *OrderListOTOService) Pending(pending *OrderListLeg) *OrderListOTOService {
+	s.params.pending = pending
+	return s
+}
+
+// PendingSide set pendingSide
+func (s *OrderListOTOService) PendingSide(pendingSide string) *OrderListOTOService {
+	s.params.pendingSide = pendingSide
+	return s
+}
+
+// PendingQuantity set pendingQuantity
+func (s *OrderListOTOService) PendingQuantity(pendingQuantity float64) *OrderListOTOService {
+	s.params.pendingQuantity = pendingQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *OrderListOTOService) NewOrderRespType(newOrderRespType string) *OrderListOTOService {
+	s.params.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *OrderListOTOService) SelfTradePreventionMode(selfTradePreventionMode string) *OrderListOTOService {
+	s.params.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request
+func (s *OrderListOTOService) Do(ctx context.Context, opts ...RequestOption) (res *OrderListResponse, err error) {
+	m, err := s.params.parameters()
+	if err != nil {
+		return nil, err
+	}
+	return s.c.placeOrderList(ctx, "/api/v3/orderList/oto", m, opts...)
+}
+
+// Binance New Order list - OTOCO (TRADE) (POST /api/v3/orderList/otoco)
+// OrderListOTOCOService create a working order that places an OCO pair once it fills
+type OrderListOTOCOService struct {
+	c      *Client
+	params orderListOTOCOParams
+}
+
+// Symbol set symbol
+func (s *OrderListOTOCOService) Symbol(symbol string) *OrderListOTOCOService {
+	s.params.symbol = symbol
+	return s
+}
+
+// ListClientOrderId set listClientOrderId
+func (s *OrderListOTOCOService) ListClientOrderId(listClientOrderId string) *OrderListOTOCOService {
+	s.params.listClientOrderId = &listClientOrderId
+	return s
+}
+
+// Working set the working leg, LIMIT or LIMIT_MAKER
+func (s *OrderListOTOCOService) Working(working *OrderListLeg) *OrderListOTOCOService {
+	s.params.working = working
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *OrderListOTOCOService) WorkingSide(workingSide string) *OrderListOTOCOService {
+	s.params.workingSide = workingSide
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *OrderListOTOCOService) WorkingQuantity(workingQuantity float64) *OrderListOTOCOService {
+	s.params.workingQuantity = workingQuantity
+	return s
+}
+
+// PendingSide set pendingSide
+func (s *OrderListOTOCOService) PendingSide(pendingSide string) *OrderListOTOCOService {
+	s.params.pendingSide = pendingSide
+	return s
+}
+
+// PendingQuantity set pendingQuantity
+func (s *OrderListOTOCOService) PendingQuantity(pendingQuantity float64) *OrderListOTOCOService {
+	s.params.pendingQuantity = pendingQuantity
+	return s
+}
+
+// PendingAbove set the pending leg placed above the market price
+func (s *OrderListOTOCOService) PendingAbove(pendingAbove *OrderListLeg) *OrderListOTOCOService {
+	s.params.pendingAbove = pendingAbove
+	return s
+}
+
+// PendingBelow set the pending leg placed below the market price
+func (s *OrderListOTOCOService) PendingBelow(pendingBelow *OrderListLeg) *OrderListOTOCOService {
+	s.params.pendingBelow = pendingBelow
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *OrderListOTOCOService) NewOrderRespType(newOrderRespType string) *OrderListOTOCOService {
+	s.params.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *OrderListOTOCOService) SelfTradePreventionMode(selfTradePreventionMode string) *OrderListOTOCOService {
+	s.params.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request
+func (s *OrderListOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *OrderListResponse, err error) {
+	m, err := s.params.parameters()
+	if err != nil {
+		return nil, err
+	}
+	return s.c.placeOrderList(ctx, "/api/v3/orderList/otoco", m, opts...)
+}
+
+func (c *Client) placeOrderList(ctx context.Context, endpoint string, m map[string]string, opts ...RequestOption) (*OrderListResponse, error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	for k, v := range m {
+		r.setParam(k, v)
+	}
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(OrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type OrderListResponse struct {
+	OrderListId       int64          `json:"orderListId"`
+	ContingencyType   string         `json:"contingencyType"`
+	ListStatusType    string         `json:"listStatusType"`
+	ListOrderStatus   string         `json:"listOrderStatus"`
+	ListClientOrderId string         `json:"listClientOrderId"`
+	TransactionTime   uint64         `json:"transactionTime"`
+	Symbol            string         `json:"symbol"`
+	Orders            []*OrderInfo   `json:"orders"`
+	OrderReports      []*OrderReport `json:"orderReports"`
+}
+
 // Binance Cancel OCO (TRADE) (DELETE /api/v3/orderList)
 // CancelOCOService cancel OCO order
 type CancelOCOService struct {
@@ -1744,6 +2017,23 @@ func (s *GetMyTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Range returns an iterator over every trade executed between from and to,
+// splitting the range into the 24 hour windows accepted by the endpoint
+func (s *GetMyTradesService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*AccountTradeListResponse] {
+	p := &windowPager[*AccountTradeListResponse]{
+		window: 24 * time.Hour,
+		limit:  1000,
+		key:    func(t *AccountTradeListResponse) any { return t.Id },
+		timeOf: func(t *AccountTradeListResponse) uint64 { return t.Time },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*AccountTradeListResponse, error) {
+			svc := *s
+			svc.fromId = nil
+			return svc.StartTime(start).EndTime(end).Limit(1000).Do(ctx)
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 //type AccountTradeListResponse []AccountTrade
 
 type AccountTradeListResponse struct {
@@ -1886,3 +2176,637 @@ type QueryPreventedMatchesResponse struct {
 		TransactTime            uint64 `json:"transactTime"`
 	} `json:"preventedMatches"`
 }
+
+// Binance New Order using SOR endpoint (POST /api/v3/sor/order)
+// SOROrderService places an order using smart order routing
+type SOROrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    string
+	orderType               string
+	quantity                float64
+	timeInForce             *string
+	price                   *float64
+	newClientOrderId        *string
+	strategyId              *int
+	strategyType            *int
+	icebergQty              *float64
+	newOrderRespType        *string
+	selfTradePreventionMode *string
+}
+
+// Symbol set symbol
+func (s *SOROrderService) Symbol(symbol string) *SOROrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *SOROrderService) Side(side string) *SOROrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *SOROrderService) Type(orderType string) *SOROrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Quantity set quantity
+func (s *SOROrderService) Quantity(quantity float64) *SOROrderService {
+	s.quantity = quantity
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *SOROrderService) TimeInForce(timeInForce string) *SOROrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Price set price
+func (s *SOROrderService) Price(price float64) *SOROrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderId set newClientOrderId
+func (s *SOROrderService) NewClientOrderId(newClientOrderId string) *SOROrderService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+// StrategyId set strategyId
+func (s *SOROrderService) StrategyId(strategyId int) *SOROrderService {
+	s.strategyId = &strategyId
+	return s
+}
+
+// StrategyType set strategyType
+func (s *SOROrderService) StrategyType(strategyType int) *SOROrderService {
+	s.strategyType = &strategyType
+	return s
+}
+
+// IcebergQty set icebergQty
+func (s *SOROrderService) IcebergQty(icebergQty float64) *SOROrderService {
+	s.icebergQty = &icebergQty
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *SOROrderService) NewOrderRespType(newOrderRespType string) *SOROrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *SOROrderService) SelfTradePreventionMode(selfTradePreventionMode string) *SOROrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+func (s *SOROrderService) request(endpoint string) *request {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("side", s.side)
+	r.setParam("type", s.orderType)
+	r.setParam("quantity", strconv.FormatFloat(s.quantity, 'f', -1, 64))
+	if s.timeInForce != nil {
+		r.setParam("timeInForce", *s.timeInForce)
+	}
+	if s.price != nil {
+		r.setParam("price", strconv.FormatFloat(*s.price, 'f', -1, 64))
+	}
+	if s.newClientOrderId != nil {
+		r.setParam("newClientOrderId", *s.newClientOrderId)
+	}
+	if s.strategyId != nil {
+		r.setParam("strategyId", *s.strategyId)
+	}
+	if s.strategyType != nil {
+		r.setParam("strategyType", *s.strategyType)
+	}
+	if s.icebergQty != nil {
+		r.setParam("icebergQty", strconv.FormatFloat(*s.icebergQty, 'f', -1, 64))
+	}
+	if s.newOrderRespType != nil {
+		r.setParam("newOrderRespType", *s.newOrderRespType)
+	}
+	if s.selfTradePreventionMode != nil {
+		r.setParam("selfTradePreventionMode", *s.selfTradePreventionMode)
+	}
+	return r
+}
+
+// Do send request
+func (s *SOROrderService) Do(ctx context.Context, opts ...RequestOption) (res *SOROrderResponse, err error) {
+	data, err := s.c.callAPI(ctx, s.request("/api/v3/sor/order"), opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SOROrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SOROrderResponse define response of SOROrderService. Fields past
+// TransactTime are only set for the RESULT and FULL response types.
+type SOROrderResponse struct {
+	Symbol                  string     `json:"symbol"`
+	OrderId                 int64      `json:"orderId"`
+	OrderListId             int64      `json:"orderListId"`
+	ClientOrderId           string     `json:"clientOrderId"`
+	TransactTime            uint64     `json:"transactTime"`
+	Price                   string     `json:"price"`
+	OrigQty                 string     `json:"origQty"`
+	ExecutedQty             string     `json:"executedQty"`
+	CumulativeQuoteQty      string     `json:"cummulativeQuoteQty"`
+	Status                  string     `json:"status"`
+	TimeInForce             string     `json:"timeInForce"`
+	Type                    string     `json:"type"`
+	Side                    string     `json:"side"`
+	WorkingTime             uint64     `json:"workingTime"`
+	Fills                   []*SORFill `json:"fills,omitempty"`
+	WorkingFloor            string     `json:"workingFloor"`
+	SelfTradePreventionMode string     `json:"selfTradePreventionMode"`
+	UsedSor                 bool       `json:"usedSor"`
+}
+
+// SORFill define a fill of an SOR order. MatchType is "ONE_PARTY_TRADE_REPORT"
+// for fills allocated by the SOR, and AllocId refers to the allocation.
+type SORFill struct {
+	MatchType       string `json:"matchType"`
+	Price           string `json:"price"`
+	Qty             string `json:"qty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+	TradeId         int64  `json:"tradeId"`
+	AllocId         int64  `json:"allocId"`
+}
+
+// Binance Test New Order using SOR endpoint (POST /api/v3/sor/order/test)
+// TestSOROrderService validates an SOR order without sending it to the matching engine
+type TestSOROrderService struct {
+	order                  SOROrderService
+	computeCommissionRates *bool
+}
+
+// Symbol set symbol
+func (s *TestSOROrderService) Symbol(symbol string) *TestSOROrderService {
+	s.order.Symbol(symbol)
+	return s
+}
+
+// Side set side
+func (s *TestSOROrderService) Side(side string) *TestSOROrderService {
+	s.order.Side(side)
+	return s
+}
+
+// Type set type
+func (s *TestSOROrderService) Type(orderType string) *TestSOROrderService {
+	s.order.Type(orderType)
+	return s
+}
+
+// Quantity set quantity
+func (s *TestSOROrderService) Quantity(quantity float64) *TestSOROrderService {
+	s.order.Quantity(quantity)
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *TestSOROrderService) TimeInForce(timeInForce string) *TestSOROrderService {
+	s.order.TimeInForce(timeInForce)
+	return s
+}
+
+// Price set price
+func (s *TestSOROrderService) Price(price float64) *TestSOROrderService {
+	s.order.Price(price)
+	return s
+}
+
+// NewClientOrderId set newClientOrderId
+func (s *TestSOROrderService) NewClientOrderId(newClientOrderId string) *TestSOROrderService {
+	s.order.NewClientOrderId(newClientOrderId)
+	return s
+}
+
+// StrategyId set strategyId
+func (s *TestSOROrderService) StrategyId(strategyId int) *TestSOROrderService {
+	s.order.StrategyId(strategyId)
+	return s
+}
+
+// StrategyType set strategyType
+func (s *TestSOROrderService) StrategyType(strategyType int) *TestSOROrderService {
+	s.order.StrategyType(strategyType)
+	return s
+}
+
+// IcebergQty set icebergQty
+func (s *TestSOROrderService) IcebergQty(icebergQty float64) *TestSOROrderService {
+	s.order.IcebergQty(icebergQty)
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *TestSOROrderService) NewOrderRespType(newOrderRespType string) *TestSOROrderService {
+	s.order.NewOrderRespType(newOrderRespType)
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *TestSOROrderService) SelfTradePreventionMode(selfTradePreventionMode string) *TestSOROrderService {
+	s.order.SelfTradePreventionMode(selfTradePreventionMode)
+	return s
+}
+
+// ComputeCommissionRates set computeCommissionRates
+func (s *TestSOROrderService) ComputeCommissionRates(computeCommissionRates bool) *TestSOROrderService {
+	s.computeCommissionRates = &computeCommissionRates
+	return s
+}
+
+// Do send request
+func (s *TestSOROrderService) Do(ctx context.Context, opts ...RequestOption) (res *TestSOROrderResponse, err error) {
+	r := s.order.request("/api/v3/sor/order/test")
+	if s.computeCommissionRates != nil {
+		r.setParam("computeCommissionRates", *s.computeCommissionRates)
+	}
+	data, err := s.order.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(TestSOROrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TestSOROrderResponse define response of TestSOROrderService. It is empty
+// unless computeCommissionRates is set.
+type TestSOROrderResponse struct {
+	StandardCommissionForOrder *OrderCommissionRates `json:"standardCommissionForOrder,omitempty"`
+	TaxCommissionForOrder      *OrderCommissionRates `json:"taxCommissionForOrder,omitempty"`
+	Discount                   *CommissionDiscount   `json:"discount,omitempty"`
+}
+
+// OrderCommissionRates define the maker and taker rates applying to an order
+type OrderCommissionRates struct {
+	Maker string `json:"maker"`
+	Taker string `json:"taker"`
+}
+
+// CommissionDiscount define the commission discount for paying fees in DiscountAsset
+type CommissionDiscount struct {
+	EnabledForAccount bool   `json:"enabledForAccount"`
+	EnabledForSymbol  bool   `json:"enabledForSymbol"`
+	DiscountAsset     string `json:"discountAsset"`
+	Discount          string `json:"discount"`
+}
+
+// Binance Query Allocations endpoint (GET /api/v3/myAllocations)
+// MyAllocationsService retrieves allocations resulting from SOR order placement
+type MyAllocationsService struct {
+	c                *Client
+	symbol           string
+	startTime        *uint64
+	endTime          *uint64
+	fromAllocationId *int64
+	limit            *int
+	orderId          *int64
+}
+
+// Symbol set symbol
+func (s *MyAllocationsService) Symbol(symbol string) *MyAllocationsService {
+	s.symbol = symbol
+	return s
+}
+
+// StartTime set startTime
+func (s *MyAllocationsService) StartTime(startTime uint64) *MyAllocationsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MyAllocationsService) EndTime(endTime uint64) *MyAllocationsService {
+	s.endTime = &endTime
+	return s
+}
+
+// FromAllocationId set fromAllocationId
+func (s *MyAllocationsService) FromAllocationId(fromAllocationId int64) *MyAllocationsService {
+	s.fromAllocationId = &fromAllocationId
+	return s
+}
+
+// Limit set limit
+func (s *MyAllocationsService) Limit(limit int) *MyAllocationsService {
+	s.limit = &limit
+	return s
+}
+
+// OrderId set orderId
+func (s *MyAllocationsService) OrderId(orderId int64) *MyAllocationsService {
+	s.orderId = &orderId
+	return s
+}
+
+// Do send request
+func (s *MyAllocationsService) Do(ctx context.Context, opts ...RequestOption) (res []*AllocationResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/myAllocations",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.fromAllocationId != nil {
+		r.setParam("fromAllocationId", *s.fromAllocationId)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*AllocationResponse{}, err
+	}
+	res = make([]*AllocationResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*AllocationResponse{}, err
+	}
+	return res, nil
+}
+
+// AllocationResponse define an allocation returned by MyAllocationsService
+type AllocationResponse struct {
+	Symbol          string `json:"symbol"`
+	AllocationId    int64  `json:"allocationId"`
+	AllocationType  string `json:"allocationType"`
+	OrderId         int64  `json:"orderId"`
+	OrderListId     int64  `json:"orderListId"`
+	Price           string `json:"price"`
+	Qty             string `json:"qty"`
+	QuoteQty        string `json:"quoteQty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+	Time            uint64 `json:"time"`
+	IsBuyer         bool   `json:"isBuyer"`
+	IsMaker         bool   `json:"isMaker"`
+	IsAllocator     bool   `json:"isAllocator"`
+}
+
+// Binance Order Amend Keep Priority (TRADE) (PUT /api/v3/order/amend/keepPriority)
+// AmendOrderKeepPriorityService reduce the quantity of an open order without losing its queue priority
+type AmendOrderKeepPriorityService struct {
+	c                 *Client
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+	newClientOrderId  *string
+	newQty            float64
+}
+
+// Symbol set symbol
+func (s *AmendOrderKeepPriorityService) Symbol(symbol string) *AmendOrderKeepPriorityService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *AmendOrderKeepPriorityService) OrderId(orderId int64) *AmendOrderKeepPriorityService {
+	s.orderId = &orderId
+	return s
+}
+
+// OrigClientOrderId set origClientOrderId
+func (s *AmendOrderKeepPriorityService) OrigClientOrderId(origClientOrderId string) *AmendOrderKeepPriorityService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+// NewClientOrderId set newClientOrderId
+func (s *AmendOrderKeepPriorityService) NewClientOrderId(newClientOrderId string) *AmendOrderKeepPriorityService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+// NewQty set newQty, which must be greater than 0 and less than the order's quantity
+func (s *AmendOrderKeepPriorityService) NewQty(newQty float64) *AmendOrderKeepPriorityService {
+	s.newQty = newQty
+	return s
+}
+
+// Do send request
+func (s *AmendOrderKeepPriorityService) Do(ctx context.Context, opts ...RequestOption) (res *AmendOrderKeepPriorityResponse, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/api/v3/order/amend/keepPriority",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("newQty", strconv.FormatFloat(s.newQty, 'f', -1, 64))
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderId)
+	}
+	if s.newClientOrderId != nil {
+		r.setParam("newClientOrderId", *s.newClientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AmendOrderKeepPriorityResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AmendOrderKeepPriorityResponse define the result of an order amendment
+type AmendOrderKeepPriorityResponse struct {
+	TransactTime uint64                  `json:"transactTime"`
+	ExecutionId  int64                   `json:"executionId"`
+	AmendedOrder *AmendedOrder           `json:"amendedOrder"`
+	ListStatus   *AmendedOrderListStatus `json:"listStatus,omitempty"`
+}
+
+// AmendedOrder define the state of an order after its amendment
+type AmendedOrder struct {
+	Symbol                  string `json:"symbol"`
+	OrderId                 int64  `json:"orderId"`
+	OrderListId             int64  `json:"orderListId"`
+	OrigClientOrderId       string `json:"origClientOrderId"`
+	ClientOrderId           string `json:"clientOrderId"`
+	Price                   string `json:"price"`
+	Qty                     string `json:"qty"`
+	ExecutedQty             string `json:"executedQty"`
+	PreventedQty            string `json:"preventedQty"`
+	QuoteOrderQty           string `json:"quoteOrderQty"`
+	CumulativeQuoteQty      string `json:"cumulativeQuoteQty"`
+	Status                  string `json:"status"`
+	TimeInForce             string `json:"timeInForce"`
+	Type                    string `json:"type"`
+	Side                    string `json:"side"`
+	WorkingTime             int64  `json:"workingTime"`
+	SelfTradePreventionMode string `json:"selfTradePreventionMode"`
+}
+
+// AmendedOrderListStatus define the order list of an amended order, only set when the order belongs to one
+type AmendedOrderListStatus struct {
+	OrderListId       int64        `json:"orderListId"`
+	ContingencyType   string       `json:"contingencyType"`
+	ListOrderStatus   string       `json:"listOrderStatus"`
+	ListClientOrderId string       `json:"listClientOrderId"`
+	Symbol            string       `json:"symbol"`
+	Orders            []*OrderInfo `json:"orders"`
+}
+
+// Binance Query Order Amendments (USER_DATA) (GET /api/v3/order/amendments)
+// QueryOrderAmendmentsService query all amendments of a single order
+type QueryOrderAmendmentsService struct {
+	c               *Client
+	symbol          string
+	orderId         int64
+	fromExecutionId *int64
+	limit           *int
+}
+
+// Symbol set symbol
+func (s *QueryOrderAmendmentsService) Symbol(symbol string) *QueryOrderAmendmentsService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *QueryOrderAmendmentsService) OrderId(orderId int64) *QueryOrderAmendmentsService {
+	s.orderId = orderId
+	return s
+}
+
+// FromExecutionId set fromExecutionId
+func (s *QueryOrderAmendmentsService) FromExecutionId(fromExecutionId int64) *QueryOrderAmendmentsService {
+	s.fromExecutionId = &fromExecutionId
+	return s
+}
+
+// Limit set limit
+func (s *QueryOrderAmendmentsService) Limit(limit int) *QueryOrderAmendmentsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *QueryOrderAmendmentsService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderAmendment, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/order/amendments",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("orderId", s.orderId)
+	if s.fromExecutionId != nil {
+		r.setParam("fromExecutionId", *s.fromExecutionId)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	res = make([]*OrderAmendment, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	return res, nil
+}
+
+// OrderAmendment define one amendment returned by QueryOrderAmendmentsService
+type OrderAmendment struct {
+	Symbol            string `json:"symbol"`
+	OrderId           int64  `json:"orderId"`
+	ExecutionId       int64  `json:"executionId"`
+	OrigClientOrderId string `json:"origClientOrderId"`
+	NewClientOrderId  string `json:"newClientOrderId"`
+	OrigQty           string `json:"origQty"`
+	NewQty            string `json:"newQty"`
+	Time              uint64 `json:"time"`
+}
+
+// Binance Query Commission Rates (USER_DATA) (GET /api/v3/account/commission)
+// GetAccountCommissionService get the current account commission rates of a symbol
+type GetAccountCommissionService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetAccountCommissionService) Symbol(symbol string) *GetAccountCommissionService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetAccountCommissionService) Do(ctx context.Context, opts ...RequestOption) (res *AccountCommissionResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/account/commission",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AccountCommissionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AccountCommissionResponse define the commission rates of an account for a symbol
+type AccountCommissionResponse struct {
+	Symbol             string              `json:"symbol"`
+	StandardCommission *CommissionRates    `json:"standardCommission"`
+	TaxCommission      *CommissionRates    `json:"taxCommission"`
+	Discount           *CommissionDiscount `json:"discount"`
+}
+
+// CommissionRates define the maker, taker, buyer and seller rates of a commission type
+type CommissionRates struct {
+	Maker  string `json:"maker"`
+	Taker  string `json:"taker"`
+	Buyer  string `json:"buyer"`
+	Seller string `json:"seller"`
+}
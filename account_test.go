@@ -878,3 +878,459 @@ func (s *accountTestSuite) TestGetQueryPreventedMatches() {
 	s.Equal("0.00005", resp.PreventedMatches[0].MakerPreventedQuantity)
 	s.Equal(uint64(1613450271000), resp.PreventedMatches[0].TransactTime)
 }
+
+func (s *accountTestSuite) TestSOROrder() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 2,
+		"orderListId": -1,
+		"clientOrderId": "sBI1KM6nNtOfj5tccZSKly",
+		"transactTime": 1689149087774,
+		"price": "31000.00000000",
+		"origQty": "0.50000000",
+		"executedQty": "0.50000000",
+		"cummulativeQuoteQty": "14000.00000000",
+		"status": "FILLED",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "BUY",
+		"workingTime": 1689149087774,
+		"fills": [
+			{
+				"matchType": "ONE_PARTY_TRADE_REPORT",
+				"price": "28000.00000000",
+				"qty": "0.50000000",
+				"commission": "0.00000000",
+				"commissionAsset": "BTC",
+				"tradeId": -1,
+				"allocId": 0
+			}
+		],
+		"workingFloor": "SOR",
+		"selfTradePreventionMode": "NONE",
+		"usedSor": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":      "BTCUSDT",
+			"side":        "BUY",
+			"type":        "LIMIT",
+			"quantity":    0.5,
+			"timeInForce": "GTC",
+			"price":       31000.0,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSOROrderService().Symbol("BTCUSDT").Side("BUY").Type("LIMIT").
+		Quantity(0.5).TimeInForce("GTC").Price(31000).Do(newContext())
+	s.r().NoError(err)
+	s.True(resp.UsedSor)
+	s.Equal("SOR", resp.WorkingFloor)
+	s.Equal("14000.00000000", resp.CumulativeQuoteQty)
+	s.Len(resp.Fills, 1)
+	s.Equal("ONE_PARTY_TRADE_REPORT", resp.Fills[0].MatchType)
+	s.Equal(int64(0), resp.Fills[0].AllocId)
+}
+
+func (s *accountTestSuite) TestSOROrderTest() {
+	data := []byte(`{
+		"standardCommissionForOrder": {
+			"maker": "0.00000112",
+			"taker": "0.00000114"
+		},
+		"taxCommissionForOrder": {
+			"maker": "0.00000112",
+			"taker": "0.00000114"
+		},
+		"discount": {
+			"enabledForAccount": true,
+			"enabledForSymbol": true,
+			"discountAsset": "BNB",
+			"discount": "0.25000000"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":                 "BTCUSDT",
+			"side":                   "BUY",
+			"type":                   "MARKET",
+			"quantity":               0.5,
+			"computeCommissionRates": true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewTestSOROrderService().Symbol("BTCUSDT").Side("BUY").Type("MARKET").
+		Quantity(0.5).ComputeCommissionRates(true).Do(newContext())
+	s.r().NoError(err)
+	s.Equal("0.00000114", resp.StandardCommissionForOrder.Taker)
+	s.Equal("BNB", resp.Discount.DiscountAsset)
+	s.True(resp.Discount.EnabledForSymbol)
+}
+
+func (s *accountTestSuite) TestMyAllocations() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"allocationId": 0,
+			"allocationType": "SOR",
+			"orderId": 1,
+			"orderListId": -1,
+			"price": "1.00000000",
+			"qty": "5.00000000",
+			"quoteQty": "5.00000000",
+			"commission": "0.00000000",
+			"commissionAsset": "BTC",
+			"time": 1687506878118,
+			"isBuyer": true,
+			"isMaker": false,
+			"isAllocator": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  "BTCUSDT",
+			"orderId": 1,
+			"limit":   10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewMyAllocationsService().Symbol("BTCUSDT").OrderId(1).Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp, 1)
+	s.Equal("SOR", resp[0].AllocationType)
+	s.Equal(uint64(1687506878118), resp[0].Time)
+	s.True(resp[0].IsBuyer)
+}
+
+func (s *accountTestSuite) TestOrderListOCO() {
+	data := []byte(`{
+		"orderListId": 1,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "lH1YDkuQKWiXVXHPSKYEIp",
+		"transactionTime": 1710485608839,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 10, "clientOrderId": "44nZvqpemY7sVYgPYbvPih"},
+			{"symbol": "LTCBTC", "orderId": 11, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK"}
+		],
+		"orderReports": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 10,
+				"orderListId": 1,
+				"clientOrderId": "44nZvqpemY7sVYgPYbvPih",
+				"transactTime": 1710485608839,
+				"price": "1.00000000",
+				"origQty": "5.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "STOP_LOSS_LIMIT",
+				"side": "SELL",
+				"stopPrice": "1.00000000",
+				"workingTime": -1,
+				"icebergQty": "1.00000000",
+				"selfTradePreventionMode": "NONE"
+			},
+			{
+				"symbol": "LTCBTC",
+				"orderId": 11,
+				"orderListId": 1,
+				"clientOrderId": "NuMp0nVYnciDiFmVqfpBqK",
+				"transactTime": 1710485608839,
+				"price": "3.00000000",
+				"origQty": "5.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT_MAKER",
+				"side": "SELL",
+				"workingTime": 1710485608839,
+				"selfTradePreventionMode": "NONE"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":           "LTCBTC",
+			"side":             "SELL",
+			"quantity":         "5",
+			"aboveType":        "LIMIT_MAKER",
+			"abovePrice":       "3",
+			"belowType":        "STOP_LOSS_LIMIT",
+			"belowPrice":       "1",
+			"belowStopPrice":   "1",
+			"belowTimeInForce": "GTC",
+			"belowIcebergQty":  "1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewOrderListOCOService().Symbol("LTCBTC").Side("SELL").Quantity(5).
+		Above(NewOrderListLeg("LIMIT_MAKER").Price(3)).
+		Below(NewOrderListLeg("STOP_LOSS_LIMIT").Price(1).StopPrice(1).TimeInForce("GTC").IcebergQty(1)).
+		Do(newContext())
+	s.r().NoError(err)
+	s.Equal("OCO", resp.ContingencyType)
+	s.Len(resp.Orders, 2)
+	s.Len(resp.OrderReports, 2)
+	s.Equal("1.00000000", resp.OrderReports[0].StopPrice)
+	s.Equal("LIMIT_MAKER", resp.OrderReports[1].Type)
+}
+
+func (s *accountTestSuite) TestOrderListOTO() {
+	data := []byte(`{
+		"orderListId": 626,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "KA4EBjGnzoyuHg4hrKJ2ti",
+		"transactionTime": 1712289389158,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 13, "clientOrderId": "YiAUtM9yJjl1a2jXHSp9Ny"},
+			{"symbol": "LTCBTC", "orderId": 14, "clientOrderId": "9MxJSE1TYkmyx5lbGLve7R"}
+		],
+		"orderReports": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":             "LTCBTC",
+			"workingType":        "LIMIT",
+			"workingSide":        "SELL",
+			"workingPrice":       "1",
+			"workingQuantity":    "1",
+			"workingTimeInForce": "GTC",
+			"pendingType":        "MARKET",
+			"pendingSide":        "BUY",
+			"pendingQuantity":    "5",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewOrderListOTOService().Symbol("LTCBTC").
+		Working(NewOrderListLeg("LIMIT").Price(1).TimeInForce("GTC")).WorkingSide("SELL").WorkingQuantity(1).
+		Pending(NewOrderListLeg("MARKET")).PendingSide("BUY").PendingQuantity(5).
+		Do(newContext())
+	s.r().NoError(err)
+	s.Equal("OTO", resp.ContingencyType)
+	s.Equal(int64(626), resp.OrderListId)
+}
+
+func (s *accountTestSuite) TestOrderListOTOCO() {
+	data := []byte(`{
+		"orderListId": 629,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "GaeJHjZPasPItFj4x7ataM",
+		"transactionTime": 1712291372842,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 24, "clientOrderId": "TXOvglzXuaubXAaENpaRCB"},
+			{"symbol": "LTCBTC", "orderId": 25, "clientOrderId": "ZOxVaJvMqnm6hVEvAoXBQ0"},
+			{"symbol": "LTCBTC", "orderId": 26, "clientOrderId": "U2PN2dEO7VsGaghy3QwWpP"}
+		],
+		"orderReports": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":                    "LTCBTC",
+			"workingType":               "LIMIT",
+			"workingSide":               "BUY",
+			"workingPrice":              "1.5",
+			"workingQuantity":           "1",
+			"workingTimeInForce":        "GTC",
+			"pendingSide":               "SELL",
+			"pendingQuantity":           "1",
+			"pendingAboveType":          "LIMIT_MAKER",
+			"pendingAbovePrice":         "5",
+			"pendingBelowType":          "STOP_LOSS",
+			"pendingBelowStopPrice":     "0.5",
+			"pendingBelowClientOrderId": "stop",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewOrderListOTOCOService().Symbol("LTCBTC").
+		Working(NewOrderListLeg("LIMIT").Price(1.5).TimeInForce("GTC")).WorkingSide("BUY").WorkingQuantity(1).
+		PendingSide("SELL").PendingQuantity(1).
+		PendingAbove(NewOrderListLeg("LIMIT_MAKER").Price(5)).
+		PendingBelow(NewOrderListLeg("STOP_LOSS").StopPrice(0.5).ClientOrderId("stop")).
+		Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp.Orders, 3)
+}
+
+func (s *accountTestSuite) TestOrderListInvalidLegs() {
+	_, err := s.client.NewOrderListOCOService().Symbol("LTCBTC").Side("BUY").Quantity(5).
+		Above(NewOrderListLeg("LIMIT_MAKER").Price(3)).
+		Below(NewOrderListLeg("STOP_LOSS").StopPrice(1)).
+		Do(newContext())
+	s.ErrorIs(err, ErrInvalidOrderList)
+	s.ErrorContains(err, "belowType must be LIMIT_MAKER")
+
+	_, err = s.client.NewOrderListOCOService().Symbol("LTCBTC").Side("SELL").Quantity(5).
+		Above(NewOrderListLeg("LIMIT_MAKER").Price(3)).
+		Do(newContext())
+	s.ErrorIs(err, ErrInvalidOrderList)
+	s.ErrorContains(err, "below leg is required")
+
+	_, err = s.client.NewOrderListOTOService().Symbol("LTCBTC").
+		Working(NewOrderListLeg("MARKET")).WorkingSide("SELL").WorkingQuantity(1).
+		Pending(NewOrderListLeg("MARKET")).PendingSide("BUY").PendingQuantity(1).
+		Do(newContext())
+	s.ErrorIs(err, ErrInvalidOrderList)
+	s.ErrorContains(err, "workingType must be LIMIT or LIMIT_MAKER")
+
+	_, err = s.client.NewOrderListOTOCOService().Symbol("LTCBTC").
+		Working(NewOrderListLeg("LIMIT").Price(1).TimeInForce("GTC")).WorkingSide("BUY").WorkingQuantity(1).
+		PendingSide("SELL").PendingQuantity(1).
+		PendingAbove(NewOrderListLeg("LIMIT_MAKER").Price(5)).
+		PendingBelow(NewOrderListLeg("STOP_LOSS_LIMIT").StopPrice(0.5).Price(0.4)).
+		Do(newContext())
+	s.ErrorIs(err, ErrInvalidOrderList)
+	s.ErrorContains(err, "pendingBelowTimeInForce is required")
+}
+
+func (s *accountTestSuite) TestAmendOrderKeepPriority() {
+	data := []byte(`{
+		"transactTime": 1741926410255,
+		"executionId": 75,
+		"amendedOrder": {
+			"symbol": "BTCUSDT",
+			"orderId": 33,
+			"orderListId": -1,
+			"origClientOrderId": "5xrgbMyg6z36NzBn2pbT8H",
+			"clientOrderId": "PFaq6hIHxqFENGfdtn4J6Q",
+			"price": "6.00000000",
+			"qty": "5.00000000",
+			"executedQty": "0.00000000",
+			"preventedQty": "0.00000000",
+			"quoteOrderQty": "0.00000000",
+			"cumulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"workingTime": 1741926410242,
+			"selfTradePreventionMode": "NONE"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  "BTCUSDT",
+			"orderId": 33,
+			"newQty":  "5",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").OrderId(33).NewQty(5).Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(75), resp.ExecutionId)
+	s.Equal("5.00000000", resp.AmendedOrder.Qty)
+	s.Equal("PFaq6hIHxqFENGfdtn4J6Q", resp.AmendedOrder.ClientOrderId)
+	s.Nil(resp.ListStatus)
+}
+
+func (s *accountTestSuite) TestQueryOrderAmendments() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"orderId": 9,
+			"executionId": 22,
+			"origClientOrderId": "W0fJ9fiLKHOJutovPK3oJp",
+			"newClientOrderId": "UQ1Np3bmQ71jJzsSDW9Vpi",
+			"origQty": "5.00000000",
+			"newQty": "4.00000000",
+			"time": 1741669661670
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":          "BTCUSDT",
+			"orderId":         9,
+			"fromExecutionId": 20,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewQueryOrderAmendmentsService().Symbol("BTCUSDT").OrderId(9).FromExecutionId(20).Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp, 1)
+	s.Equal("5.00000000", resp[0].OrigQty)
+	s.Equal("4.00000000", resp[0].NewQty)
+	s.Equal(uint64(1741669661670), resp[0].Time)
+}
+
+func (s *accountTestSuite) TestGetAccountCommission() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"standardCommission": {
+			"maker": "0.00000010",
+			"taker": "0.00000020",
+			"buyer": "0.00000030",
+			"seller": "0.00000040"
+		},
+		"taxCommission": {
+			"maker": "0.00000112",
+			"taker": "0.00000114",
+			"buyer": "0.00000118",
+			"seller": "0.00000116"
+		},
+		"discount": {
+			"enabledForAccount": true,
+			"enabledForSymbol": true,
+			"discountAsset": "BNB",
+			"discount": "0.75000000"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewGetAccountCommissionService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.Equal("BTCUSDT", resp.Symbol)
+	s.Equal("0.00000040", resp.StandardCommission.Seller)
+	s.Equal("0.00000118", resp.TaxCommission.Buyer)
+	s.Equal("0.75000000", resp.Discount.Discount)
+}
@@ -0,0 +1,959 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Get target asset list (USER_DATA) API Endpoint
+const (
+	autoInvestTargetAssetListEndpoint = "/sapi/v1/lending/auto-invest/target-asset/list"
+)
+
+// AutoInvestTargetAssetListService list the assets a plan can invest in
+type AutoInvestTargetAssetListService struct {
+	c           *Client
+	targetAsset *string
+	size        *int64
+	current     *int64
+}
+
+// TargetAsset set targetAsset
+func (s *AutoInvestTargetAssetListService) TargetAsset(targetAsset string) *AutoInvestTargetAssetListService {
+	s.targetAsset = &targetAsset
+	return s
+}
+
+// Size set size
+func (s *AutoInvestTargetAssetListService) Size(size int64) *AutoInvestTargetAssetListService {
+	s.size = &size
+	return s
+}
+
+// Current set current
+func (s *AutoInvestTargetAssetListService) Current(current int64) *AutoInvestTargetAssetListService {
+	s.current = &current
+	return s
+}
+
+// Do send request
+func (s *AutoInvestTargetAssetListService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestTargetAssetListResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: autoInvestTargetAssetListEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.targetAsset != nil {
+		r.setParam("targetAsset", *s.targetAsset)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestTargetAssetListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestTargetAssetListResponse define target asset list response
+type AutoInvestTargetAssetListResponse struct {
+	TargetAssets        []string `json:"targetAssets"`
+	AutoInvestAssetList []struct {
+		TargetAsset             string `json:"targetAsset"`
+		RoiAndDimensionTypeList []struct {
+			SimulateRoi    string `json:"simulateRoi"`
+			DimensionValue string `json:"dimensionValue"`
+			DimensionUnit  string `json:"dimensionUnit"`
+		} `json:"roiAndDimensionTypeList"`
+	} `json:"autoInvestAssetList"`
+}
+
+// Query source asset list (USER_DATA) API Endpoint
+const (
+	autoInvestSourceAssetListEndpoint = "/sapi/v1/lending/auto-invest/source-asset/list"
+)
+
+// AutoInvestSourceAssetListService list the assets a plan can be paid with
+type AutoInvestSourceAssetListService struct {
+	c                    *Client
+	usageType            string
+	targetAsset          *string
+	indexId              *int64
+	flexibleAllowedToUse *bool
+	sourceType           *string
+}
+
+// UsageType set usageType, RECURRING or ONE_TIME
+func (s *AutoInvestSourceAssetListService) UsageType(usageType string) *AutoInvestSourceAssetListService {
+	s.usageType = usageType
+	return s
+}
+
+// TargetAsset set targetAsset
+func (s *AutoInvestSourceAssetListService) TargetAsset(targetAsset string) *AutoInvestSourceAssetListService {
+	s.targetAsset = &targetAsset
+	return s
+}
+
+// IndexId set indexId
+func (s *AutoInvestSourceAssetListService) IndexId(indexId int64) *AutoInvestSourceAssetListService {
+	s.indexId = &indexId
+	return s
+}
+
+// FlexibleAllowedToUse set flexibleAllowedToUse
+func (s *AutoInvestSourceAssetListService) FlexibleAllowedToUse(flexibleAllowedToUse bool) *AutoInvestSourceAssetListService {
+	s.flexibleAllowedToUse = &flexibleAllowedToUse
+	return s
+}
+
+// SourceType set sourceType, MAIN_SITE or TR
+func (s *AutoInvestSourceAssetListService) SourceType(sourceType string) *AutoInvestSourceAssetListService {
+	s.sourceType = &sourceType
+	return s
+}
+
+// Do send request
+func (s *AutoInvestSourceAssetListService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestSourceAssetListResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: autoInvestSourceAssetListEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("usageType", s.usageType)
+	if s.targetAsset != nil {
+		r.setParam("targetAsset", *s.targetAsset)
+	}
+	if s.indexId != nil {
+		r.setParam("indexId", *s.indexId)
+	}
+	if s.flexibleAllowedToUse != nil {
+		r.setParam("flexibleAllowedToUse", *s.flexibleAllowedToUse)
+	}
+	if s.sourceType != nil {
+		r.setParam("sourceType", *s.sourceType)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestSourceAssetListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestSourceAssetListResponse define source asset list response
+type AutoInvestSourceAssetListResponse struct {
+	FeeRate      string `json:"feeRate"`
+	TaxRate      string `json:"taxRate"`
+	SourceAssets []struct {
+		SourceAsset    string `json:"sourceAsset"`
+		AssetMinAmount string `json:"assetMinAmount"`
+		AssetMaxAmount string `json:"assetMaxAmount"`
+		Scale          string `json:"scale"`
+		FlexibleAmount string `json:"flexibleAmount"`
+	} `json:"sourceAssets"`
+}
+
+// Investment plan creation (USER_DATA) API Endpoint
+const (
+	autoInvestPlanAddEndpoint = "/sapi/v1/lending/auto-invest/plan/add"
+)
+
+// AutoInvestPlanAddService create a recurring investment plan
+type AutoInvestPlanAddService struct {
+	c                        *Client
+	sourceType               string
+	planType                 string
+	subscriptionAmount       float64
+	subscriptionCycle        string
+	subscriptionStartDay     *int
+	subscriptionStartWeekday *string
+	subscriptionStartTime    int
+	sourceAsset              string
+	flexibleAllowedToUse     *bool
+	requestId                *string
+	indexId                  *int64
+	details                  []AutoInvestPlanDetail
+}
+
+// SourceType set sourceType, MAIN_SITE or TR
+func (s *AutoInvestPlanAddService) SourceType(sourceType string) *AutoInvestPlanAddService {
+	s.sourceType = sourceType
+	return s
+}
+
+// PlanType set planType, SINGLE, PORTFOLIO or INDEX
+func (s *AutoInvestPlanAddService) PlanType(planType string) *AutoInvestPlanAddService {
+	s.planType = planType
+	return s
+}
+
+// SubscriptionAmount set subscriptionAmount
+func (s *AutoInvestPlanAddService) SubscriptionAmount(subscriptionAmount float64) *AutoInvestPlanAddService {
+	s.subscriptionAmount = subscriptionAmount
+	return s
+}
+
+// SubscriptionCycle set subscriptionCycle, H1, H4, H8, H12, DAILY, WEEKLY, BI_WEEKLY or MONTHLY
+func (s *AutoInvestPlanAddService) SubscriptionCycle(subscriptionCycle string) *AutoInvestPlanAddService {
+	s.subscriptionCycle = subscriptionCycle
+	return s
+}
+
+// SubscriptionStartDay set subscriptionStartDay
+func (s *AutoInvestPlanAddService) SubscriptionStartDay(subscriptionStartDay int) *AutoInvestPlanAddService {
+	s.subscriptionStartDay = &subscriptionStartDay
+	return s
+}
+
+// SubscriptionStartWeekday set subscriptionStartWeekday, MON to SUN
+func (s *AutoInvestPlanAddService) SubscriptionStartWeekday(subscriptionStartWeekday string) *AutoInvestPlanAddService {
+	s.subscriptionStartWeekday = &subscriptionStartWeekday
+	return s
+}
+
+// SubscriptionStartTime set subscriptionStartTime, the hour of the day from 0 to 23
+func (s *AutoInvestPlanAddService) SubscriptionStartTime(subscriptionStartTime int) *AutoInvestPlanAddService {
+	s.subscriptionStartTime = subscriptionStartTime
+	return s
+}
+
+// SourceAsset set sourceAsset
+func (s *AutoInvestPlanAddService) SourceAsset(sourceAsset string) *AutoInvestPlanAddService {
+	s.sourceAsset = sourceAsset
+	return s
+}
+
+// FlexibleAllowedToUse set flexibleAllowedToUse
+func (s *AutoInvestPlanAddService) FlexibleAllowedToUse(flexibleAllowedToUse bool) *AutoInvestPlanAddService {
+	s.flexibleAllowedToUse = &flexibleAllowedToUse
+	return s
+}
+
+// RequestId set requestId
+func (s *AutoInvestPlanAddService) RequestId(requestId string) *AutoInvestPlanAddService {
+	s.requestId = &requestId
+	return s
+}
+
+// IndexId set indexId
+func (s *AutoInvestPlanAddService) IndexId(indexId int64) *AutoInvestPlanAddService {
+	s.indexId = &indexId
+	return s
+}
+
+// Details set details, the target assets of the plan and their percentage
+func (s *AutoInvestPlanAddService) Details(details ...AutoInvestPlanDetail) *AutoInvestPlanAddService {
+	s.details = details
+	return s
+}
+
+// Do send request
+func (s *AutoInvestPlanAddService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestPlanResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: autoInvestPlanAddEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("sourceType", s.sourceType)
+	r.setParam("planType", s.planType)
+	r.setParam("subscriptionAmount", strconv.FormatFloat(s.subscriptionAmount, 'f', -1, 64))
+	r.setParam("subscriptionCycle", s.subscriptionCycle)
+	r.setParam("subscriptionStartTime", s.subscriptionStartTime)
+	r.setParam("sourceAsset", s.sourceAsset)
+	if s.subscriptionStartDay != nil {
+		r.setParam("subscriptionStartDay", *s.subscriptionStartDay)
+	}
+	if s.subscriptionStartWeekday != nil {
+		r.setParam("subscriptionStartWeekday", *s.subscriptionStartWeekday)
+	}
+	if s.flexibleAllowedToUse != nil {
+		r.setParam("flexibleAllowedToUse", *s.flexibleAllowedToUse)
+	}
+	if s.requestId != nil {
+		r.setParam("requestId", *s.requestId)
+	}
+	if s.indexId != nil {
+		r.setParam("indexId", *s.indexId)
+	}
+	setAutoInvestDetails(r, s.details)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestPlanResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Investment plan adjustment (TRADE) API Endpoint
+const (
+	autoInvestPlanEditEndpoint = "/sapi/v1/lending/auto-invest/plan/edit"
+)
+
+// AutoInvestPlanEditService change the schedule, amount or target assets of a plan
+type AutoInvestPlanEditService struct {
+	c                        *Client
+	planId                   int64
+	subscriptionAmount       float64
+	subscriptionCycle        string
+	subscriptionStartDay     *int
+	subscriptionStartWeekday *string
+	subscriptionStartTime    int
+	sourceAsset              string
+	flexibleAllowedToUse     *bool
+	details                  []AutoInvestPlanDetail
+}
+
+// PlanId set planId
+func (s *AutoInvestPlanEditService) PlanId(planId int64) *AutoInvestPlanEditService {
+	s.planId = planId
+	return s
+}
+
+// SubscriptionAmount set subscriptionAmount
+func (s *AutoInvestPlanEditService) SubscriptionAmount(subscriptionAmount float64) *AutoInvestPlanEditService {
+	s.subscriptionAmount = subscriptionAmount
+	return s
+}
+
+// SubscriptionCycle set subscriptionCycle, H1, H4, H8, H12, DAILY, WEEKLY, BI_WEEKLY or MONTHLY
+func (s *AutoInvestPlanEditService) SubscriptionCycle(subscriptionCycle string) *AutoInvestPlanEditService {
+	s.subscriptionCycle = subscriptionCycle
+	return s
+}
+
+// SubscriptionStartDay set subscriptionStartDay
+func (s *AutoInvestPlanEditService) SubscriptionStartDay(subscriptionStartDay int) *AutoInvestPlanEditService {
+	s.subscriptionStartDay = &subscriptionStartDay
+	return s
+}
+
+// SubscriptionStartWeekday set subscriptionStartWeekday, MON to SUN
+func (s *AutoInvestPlanEditService) SubscriptionStartWeekday(subscriptionStartWeekday string) *AutoInvestPlanEditService {
+	s.subscriptionStartWeekday = &subscriptionStartWeekday
+	return s
+}
+
+// SubscriptionStartTime set subscriptionStartTime, the hour of the day from 0 to 23
+func (s *AutoInvestPlanEditService) SubscriptionStartTime(subscriptionStartTime int) *AutoInvestPlanEditService {
+	s.subscriptionStartTime = subscriptionStartTime
+	return s
+}
+
+// SourceAsset set sourceAsset
+func (s *AutoInvestPlanEditService) SourceAsset(sourceAsset string) *AutoInvestPlanEditService {
+	s.sourceAsset = sourceAsset
+	return s
+}
+
+// FlexibleAllowedToUse set flexibleAllowedToUse
+func (s *AutoInvestPlanEditService) FlexibleAllowedToUse(flexibleAllowedToUse bool) *AutoInvestPlanEditService {
+	s.flexibleAllowedToUse = &flexibleAllowedToUse
+	return s
+}
+
+// Details set details, the target assets of the plan and their percentage
+func (s *AutoInvestPlanEditService) Details(details ...AutoInvestPlanDetail) *AutoInvestPlanEditService {
+	s.details = details
+	return s
+}
+
+// Do send request
+func (s *AutoInvestPlanEditService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestPlanResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: autoInvestPlanEditEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("planId", s.planId)
+	r.setParam("subscriptionAmount", strconv.FormatFloat(s.subscriptionAmount, 'f', -1, 64))
+	r.setParam("subscriptionCycle", s.subscriptionCycle)
+	r.setParam("subscriptionStartTime", s.subscriptionStartTime)
+	r.setParam("sourceAsset", s.sourceAsset)
+	if s.subscriptionStartDay != nil {
+		r.setParam("subscriptionStartDay", *s.subscriptionStartDay)
+	}
+	if s.subscriptionStartWeekday != nil {
+		r.setParam("subscriptionStartWeekday", *s.subscriptionStartWeekday)
+	}
+	if s.flexibleAllowedToUse != nil {
+		r.setParam("flexibleAllowedToUse", *s.flexibleAllowedToUse)
+	}
+	setAutoInvestDetails(r, s.details)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestPlanResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Change Plan Status (TRADE) API Endpoint
+const (
+	autoInvestPlanEditStatusEndpoint = "/sapi/v1/lending/auto-invest/plan/edit-status"
+)
+
+// AutoInvestPlanEditStatusService pause, resume or remove a plan
+type AutoInvestPlanEditStatusService struct {
+	c      *Client
+	planId int64
+	status string
+}
+
+// PlanId set planId
+func (s *AutoInvestPlanEditStatusService) PlanId(planId int64) *AutoInvestPlanEditStatusService {
+	s.planId = planId
+	return s
+}
+
+// Status set status, ONGOING, PAUSED or REMOVED
+func (s *AutoInvestPlanEditStatusService) Status(status string) *AutoInvestPlanEditStatusService {
+	s.status = status
+	return s
+}
+
+// Do send request
+func (s *AutoInvestPlanEditStatusService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestPlanResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: autoInvestPlanEditStatusEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("planId", s.planId)
+	r.setParam("status", s.status)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestPlanResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestPlanResponse define the response of plan creation, adjustment and status change.
+// Status is only returned on status change.
+type AutoInvestPlanResponse struct {
+	PlanId                int64  `json:"planId"`
+	NextExecutionDateTime int64  `json:"nextExecutionDateTime"`
+	Status                string `json:"status"`
+}
+
+// Get list of plans (USER_DATA) API Endpoint
+const (
+	autoInvestPlanListEndpoint = "/sapi/v1/lending/auto-invest/plan/list"
+)
+
+// AutoInvestPlanListService list the plans of a plan type
+type AutoInvestPlanListService struct {
+	c        *Client
+	planType string
+}
+
+// PlanType set planType, SINGLE, PORTFOLIO or INDEX
+func (s *AutoInvestPlanListService) PlanType(planType string) *AutoInvestPlanListService {
+	s.planType = planType
+	return s
+}
+
+// Do send request
+func (s *AutoInvestPlanListService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestPlanListResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: autoInvestPlanListEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("planType", s.planType)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestPlanListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestPlanListResponse define plan list response
+type AutoInvestPlanListResponse struct {
+	PlanValueInUSD string            `json:"planValueInUSD"`
+	PlanValueInBTC string            `json:"planValueInBTC"`
+	PnlInUSD       string            `json:"pnlInUSD"`
+	Roi            string            `json:"roi"`
+	Plans          []*AutoInvestPlan `json:"plans"`
+}
+
+// AutoInvestPlan define an investment plan
+type AutoInvestPlan struct {
+	PlanId                   int64  `json:"planId"`
+	PlanType                 string `json:"planType"`
+	EditAllowed              string `json:"editAllowed"`
+	CreationDateTime         int64  `json:"creationDateTime"`
+	FirstExecutionDateTime   int64  `json:"firstExecutionDateTime"`
+	NextExecutionDateTime    int64  `json:"nextExecutionDateTime"`
+	Status                   string `json:"status"`
+	LastUpdatedDateTime      int64  `json:"lastUpdatedDateTime"`
+	TargetAsset              string `json:"targetAsset"`
+	TotalTargetAmount        string `json:"totalTargetAmount"`
+	SourceAsset              string `json:"sourceAsset"`
+	TotalInvestedInUSD       string `json:"totalInvestedInUSD"`
+	SubscriptionAmount       string `json:"subscriptionAmount"`
+	SubscriptionCycle        string `json:"subscriptionCycle"`
+	SubscriptionStartDay     string `json:"subscriptionStartDay"`
+	SubscriptionStartWeekday string `json:"subscriptionStartWeekday"`
+	SubscriptionStartTime    string `json:"subscriptionStartTime"`
+	SourceWallet             string `json:"sourceWallet"`
+	FlexibleAllowedToUse     string `json:"flexibleAllowedToUse"`
+	PlanValueInUSD           string `json:"planValueInUSD"`
+	PnlInUSD                 string `json:"pnlInUSD"`
+	Roi                      string `json:"roi"`
+}
+
+// Query holding details of the plan (USER_DATA) API Endpoint
+const (
+	autoInvestHoldingDetailsEndpoint = "/sapi/v1/lending/auto-invest/plan/id"
+)
+
+// AutoInvestHoldingDetailsService query a plan and the holdings of its target assets
+type AutoInvestHoldingDetailsService struct {
+	c         *Client
+	planId    *int64
+	requestId *string
+}
+
+// PlanId set planId
+func (s *AutoInvestHoldingDetailsService) PlanId(planId int64) *AutoInvestHoldingDetailsService {
+	s.planId = &planId
+	return s
+}
+
+// RequestId set requestId
+func (s *AutoInvestHoldingDetailsService) RequestId(requestId string) *AutoInvestHoldingDetailsService {
+	s.requestId = &requestId
+	return s
+}
+
+// Do send request
+func (s *AutoInvestHoldingDetailsService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestHoldingDetailsResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: autoInvestHoldingDetailsEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.planId != nil {
+		r.setParam("planId", *s.planId)
+	}
+	if s.requestId != nil {
+		r.setParam("requestId", *s.requestId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestHoldingDetailsResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestHoldingDetailsResponse define holding details response
+type AutoInvestHoldingDetailsResponse struct {
+	AutoInvestPlan
+	PlanValueInBTC string               `json:"planValueInBTC"`
+	Details        []*AutoInvestHolding `json:"details"`
+}
+
+// AutoInvestHolding define the holding of a target asset of a plan
+type AutoInvestHolding struct {
+	TargetAsset         string `json:"targetAsset"`
+	AveragePriceInUSD   string `json:"averagePriceInUSD"`
+	TotalInvestedInUSD  string `json:"totalInvestedInUSD"`
+	PurchasedAmount     string `json:"purchasedAmount"`
+	PurchasedAmountUnit string `json:"purchasedAmountUnit"`
+	PnlInUSD            string `json:"pnlInUSD"`
+	Roi                 string `json:"roi"`
+	Percentage          string `json:"percentage"`
+	AssetStatus         string `json:"assetStatus"`
+	AvailableAmount     string `json:"availableAmount"`
+	AvailableAmountUnit string `json:"availableAmountUnit"`
+	RedeemedAmount      string `json:"redeemedAmout"`
+	RedeemedAmountUnit  string `json:"redeemedAmoutUnit"`
+	AssetValueInUSD     string `json:"assetValueInUSD"`
+}
+
+// Query subscription transaction history (USER_DATA) API Endpoint
+const (
+	autoInvestSubscriptionHistoryEndpoint = "/sapi/v1/lending/auto-invest/history/list"
+)
+
+// AutoInvestSubscriptionHistoryService query the executed subscriptions of plans
+type AutoInvestSubscriptionHistoryService struct {
+	c           *Client
+	planId      *int64
+	startTime   *uint64
+	endTime     *uint64
+	targetAsset *string
+	planType    *string
+	size        *int64
+	current     *int64
+}
+
+// PlanId set planId
+func (s *AutoInvestSubscriptionHistoryService) PlanId(planId int64) *AutoInvestSubscriptionHistoryService {
+	s.planId = &planId
+	return s
+}
+
+// StartTime set startTime
+func (s *AutoInvestSubscriptionHistoryService) StartTime(startTime uint64) *AutoInvestSubscriptionHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *AutoInvestSubscriptionHistoryService) EndTime(endTime uint64) *AutoInvestSubscriptionHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// TargetAsset set targetAsset
+func (s *AutoInvestSubscriptionHistoryService) TargetAsset(targetAsset string) *AutoInvestSubscriptionHistoryService {
+	s.targetAsset = &targetAsset
+	return s
+}
+
+// PlanType set planType
+func (s *AutoInvestSubscriptionHistoryService) PlanType(planType string) *AutoInvestSubscriptionHistoryService {
+	s.planType = &planType
+	return s
+}
+
+// Size set size
+func (s *AutoInvestSubscriptionHistoryService) Size(size int64) *AutoInvestSubscriptionHistoryService {
+	s.size = &size
+	return s
+}
+
+// Current set current
+func (s *AutoInvestSubscriptionHistoryService) Current(current int64) *AutoInvestSubscriptionHistoryService {
+	s.current = &current
+	return s
+}
+
+// Do send request
+func (s *AutoInvestSubscriptionHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestSubscriptionHistoryResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: autoInvestSubscriptionHistoryEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.planId != nil {
+		r.setParam("planId", *s.planId)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.targetAsset != nil {
+		r.setParam("targetAsset", *s.targetAsset)
+	}
+	if s.planType != nil {
+		r.setParam("planType", *s.planType)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestSubscriptionHistoryResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestSubscriptionHistoryResponse define subscription history response
+type AutoInvestSubscriptionHistoryResponse struct {
+	List []struct {
+		Id                  int64  `json:"id"`
+		TargetAsset         string `json:"targetAsset"`
+		PlanType            string `json:"planType"`
+		PlanName            string `json:"planName"`
+		PlanId              int64  `json:"planId"`
+		TransactionDateTime int64  `json:"transactionDateTime"`
+		TransactionStatus   string `json:"transactionStatus"`
+		FailedType          string `json:"failedType"`
+		SourceAsset         string `json:"sourceAsset"`
+		SourceAssetAmount   string `json:"sourceAssetAmount"`
+		TargetAssetAmount   string `json:"targetAssetAmount"`
+		SourceWallet        string `json:"sourceWallet"`
+		FlexibleUsed        string `json:"flexibleUsed"`
+		TransactionFee      string `json:"transactionFee"`
+		TransactionFeeUnit  string `json:"transactionFeeUnit"`
+		ExecutionPrice      string `json:"executionPrice"`
+		ExecutionType       string `json:"executionType"`
+		SubscriptionCycle   string `json:"subscriptionCycle"`
+	} `json:"list"`
+}
+
+// Query Index Details (USER_DATA) API Endpoint
+const (
+	autoInvestIndexInfoEndpoint = "/sapi/v1/lending/auto-invest/index/info"
+)
+
+// AutoInvestIndexInfoService query the composition of an index
+type AutoInvestIndexInfoService struct {
+	c       *Client
+	indexId int64
+}
+
+// IndexId set indexId
+func (s *AutoInvestIndexInfoService) IndexId(indexId int64) *AutoInvestIndexInfoService {
+	s.indexId = indexId
+	return s
+}
+
+// Do send request
+func (s *AutoInvestIndexInfoService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestIndexInfoResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: autoInvestIndexInfoEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("indexId", s.indexId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestIndexInfoResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestIndexInfoResponse define index info response
+type AutoInvestIndexInfoResponse struct {
+	IndexId         int64  `json:"indexId"`
+	IndexName       string `json:"indexName"`
+	Status          string `json:"status"`
+	AssetAllocation []struct {
+		TargetAsset string `json:"targetAsset"`
+		Allocation  string `json:"allocation"`
+	} `json:"assetAllocation"`
+	BasketValue string `json:"basketValue"`
+}
+
+// One Time Transaction (TRADE) API Endpoint
+const (
+	autoInvestOneTimeTransactionEndpoint = "/sapi/v1/lending/auto-invest/one-off"
+)
+
+// AutoInvestOneTimeTransactionService invest once in an index, a plan or a set of target assets
+type AutoInvestOneTimeTransactionService struct {
+	c                    *Client
+	sourceType           string
+	subscriptionAmount   float64
+	sourceAsset          string
+	requestId            *string
+	flexibleAllowedToUse *bool
+	planId               *int64
+	indexId              *int64
+	details              []AutoInvestPlanDetail
+}
+
+// SourceType set sourceType, MAIN_SITE or TR
+func (s *AutoInvestOneTimeTransactionService) SourceType(sourceType string) *AutoInvestOneTimeTransactionService {
+	s.sourceType = sourceType
+	return s
+}
+
+// SubscriptionAmount set subscriptionAmount
+func (s *AutoInvestOneTimeTransactionService) SubscriptionAmount(subscriptionAmount float64) *AutoInvestOneTimeTransactionService {
+	s.subscriptionAmount = subscriptionAmount
+	return s
+}
+
+// SourceAsset set sourceAsset
+func (s *AutoInvestOneTimeTransactionService) SourceAsset(sourceAsset string) *AutoInvestOneTimeTransactionService {
+	s.sourceAsset = sourceAsset
+	return s
+}
+
+// RequestId set requestId
+func (s *AutoInvestOneTimeTransactionService) RequestId(requestId string) *AutoInvestOneTimeTransactionService {
+	s.requestId = &requestId
+	return s
+}
+
+// FlexibleAllowedToUse set flexibleAllowedToUse
+func (s *AutoInvestOneTimeTransactionService) FlexibleAllowedToUse(flexibleAllowedToUse bool) *AutoInvestOneTimeTransactionService {
+	s.flexibleAllowedToUse = &flexibleAllowedToUse
+	return s
+}
+
+// PlanId set planId
+func (s *AutoInvestOneTimeTransactionService) PlanId(planId int64) *AutoInvestOneTimeTransactionService {
+	s.planId = &planId
+	return s
+}
+
+// IndexId set indexId
+func (s *AutoInvestOneTimeTransactionService) IndexId(indexId int64) *AutoInvestOneTimeTransactionService {
+	s.indexId = &indexId
+	return s
+}
+
+// Details set details, the target assets of the plan and their percentage
+func (s *AutoInvestOneTimeTransactionService) Details(details ...AutoInvestPlanDetail) *AutoInvestOneTimeTransactionService {
+	s.details = details
+	return s
+}
+
+// Do send request
+func (s *AutoInvestOneTimeTransactionService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestOneTimeTransactionResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: autoInvestOneTimeTransactionEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("sourceType", s.sourceType)
+	r.setParam("subscriptionAmount", strconv.FormatFloat(s.subscriptionAmount, 'f', -1, 64))
+	r.setParam("sourceAsset", s.sourceAsset)
+	if s.requestId != nil {
+		r.setParam("requestId", *s.requestId)
+	}
+	if s.flexibleAllowedToUse != nil {
+		r.setParam("flexibleAllowedToUse", *s.flexibleAllowedToUse)
+	}
+	if s.planId != nil {
+		r.setParam("planId", *s.planId)
+	}
+	if s.indexId != nil {
+		r.setParam("indexId", *s.indexId)
+	}
+	setAutoInvestDetails(r, s.details)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestOneTimeTransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestOneTimeTransactionResponse define one time transaction response
+type AutoInvestOneTimeTransactionResponse struct {
+	TransactionId int64 `json:"transactionId"`
+	WaitSecond    int64 `json:"waitSecond"`
+}
+
+// Query One-Time Transaction Status (USER_DATA) API Endpoint
+const (
+	autoInvestOneTimeTransactionStatusEndpoint = "/sapi/v1/lending/auto-invest/one-off/status"
+)
+
+// AutoInvestOneTimeTransactionStatusService query the status of a one time transaction
+type AutoInvestOneTimeTransactionStatusService struct {
+	c             *Client
+	transactionId int64
+	requestId     *string
+}
+
+// TransactionId set transactionId
+func (s *AutoInvestOneTimeTransactionStatusService) TransactionId(transactionId int64) *AutoInvestOneTimeTransactionStatusService {
+	s.transactionId = transactionId
+	return s
+}
+
+// RequestId set requestId
+func (s *AutoInvestOneTimeTransactionStatusService) RequestId(requestId string) *AutoInvestOneTimeTransactionStatusService {
+	s.requestId = &requestId
+	return s
+}
+
+// Do send request
+func (s *AutoInvestOneTimeTransactionStatusService) Do(ctx context.Context, opts ...RequestOption) (res *AutoInvestOneTimeTransactionStatusResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: autoInvestOneTimeTransactionStatusEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("transactionId", s.transactionId)
+	if s.requestId != nil {
+		r.setParam("requestId", *s.requestId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AutoInvestOneTimeTransactionStatusResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AutoInvestOneTimeTransactionStatusResponse define one time transaction status response
+type AutoInvestOneTimeTransactionStatusResponse struct {
+	TransactionId int64  `json:"transactionId"`
+	Status        string `json:"status"`
+}
+
+// AutoInvestPlanDetail define a target asset of a plan and the percentage of
+// the subscription amount invested in it
+type AutoInvestPlanDetail struct {
+	TargetAsset string
+	Percentage  int
+}
+
+// setAutoInvestDetails encode details as details[i].targetAsset and details[i].percentage
+func setAutoInvestDetails(r *request, details []AutoInvestPlanDetail) {
+	for i, d := range details {
+		r.setParam(fmt.Sprintf("details[%d].targetAsset", i), d.TargetAsset)
+		r.setParam(fmt.Sprintf("details[%d].percentage", i), d.Percentage)
+	}
+}
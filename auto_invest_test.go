@@ -0,0 +1,232 @@
+package binance_connector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type autoInvestTestSuite struct {
+	baseTestSuite
+}
+
+func TestAutoInvest(t *testing.T) {
+	suite.Run(t, new(autoInvestTestSuite))
+}
+
+func (s *autoInvestTestSuite) TestSourceAssetList() {
+	data := []byte(`{
+		"feeRate": "0.0001",
+		"taxRate": "0.0001",
+		"sourceAssets": [
+			{
+				"sourceAsset": "USDT",
+				"assetMinAmount": "1.00000000",
+				"assetMaxAmount": "100.00000000",
+				"scale": "8",
+				"flexibleAmount": "0"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"usageType":   "RECURRING",
+			"targetAsset": "BTC",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewAutoInvestSourceAssetListService().UsageType("RECURRING").TargetAsset("BTC").
+		Do(newContext())
+	s.r().NoError(err)
+	s.Equal("0.0001", resp.FeeRate)
+	s.Len(resp.SourceAssets, 1)
+	s.Equal("USDT", resp.SourceAssets[0].SourceAsset)
+}
+
+func (s *autoInvestTestSuite) TestPlanAdd() {
+	data := []byte(`{
+		"planId": 12345,
+		"nextExecutionDateTime": 1699574400000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"sourceType":               "MAIN_SITE",
+			"planType":                 "PORTFOLIO",
+			"subscriptionAmount":       "50.5",
+			"subscriptionCycle":        "WEEKLY",
+			"subscriptionStartWeekday": "MON",
+			"subscriptionStartTime":    8,
+			"sourceAsset":              "USDT",
+			"details[0].targetAsset":   "BTC",
+			"details[0].percentage":    60,
+			"details[1].targetAsset":   "ETH",
+			"details[1].percentage":    40,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewAutoInvestPlanAddService().SourceType("MAIN_SITE").PlanType("PORTFOLIO").
+		SubscriptionAmount(50.5).SubscriptionCycle("WEEKLY").SubscriptionStartWeekday("MON").
+		SubscriptionStartTime(8).SourceAsset("USDT").
+		Details(
+			AutoInvestPlanDetail{TargetAsset: "BTC", Percentage: 60},
+			AutoInvestPlanDetail{TargetAsset: "ETH", Percentage: 40},
+		).Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(12345), resp.PlanId)
+	s.Equal(int64(1699574400000), resp.NextExecutionDateTime)
+}
+
+func (s *autoInvestTestSuite) TestPlanEditStatus() {
+	data := []byte(`{
+		"planId": 12345,
+		"nextExecutionDateTime": 1699574400000,
+		"status": "PAUSED"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"planId": 12345,
+			"status": "PAUSED",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewAutoInvestPlanEditStatusService().PlanId(12345).Status("PAUSED").Do(newContext())
+	s.r().NoError(err)
+	s.Equal("PAUSED", resp.Status)
+}
+
+func (s *autoInvestTestSuite) TestPlanList() {
+	data := []byte(`{
+		"planValueInUSD": "105.00",
+		"planValueInBTC": "0.0035",
+		"pnlInUSD": "5.00",
+		"roi": "0.05",
+		"plans": [
+			{
+				"planId": 12345,
+				"planType": "SINGLE",
+				"editAllowed": "true",
+				"creationDateTime": 1648378800000,
+				"firstExecutionDateTime": 1648378800000,
+				"nextExecutionDateTime": 1699574400000,
+				"status": "ONGOING",
+				"lastUpdatedDateTime": 1648378800000,
+				"targetAsset": "BTC",
+				"totalTargetAmount": "0.0035",
+				"sourceAsset": "USDT",
+				"totalInvestedInUSD": "100",
+				"subscriptionAmount": "10",
+				"subscriptionCycle": "WEEKLY",
+				"subscriptionStartDay": "",
+				"subscriptionStartWeekday": "MON",
+				"subscriptionStartTime": "8",
+				"sourceWallet": "SPOT_WALLET",
+				"flexibleAllowedToUse": "false",
+				"planValueInUSD": "105.00",
+				"pnlInUSD": "5.00",
+				"roi": "0.05"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"planType": "SINGLE",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewAutoInvestPlanListService().PlanType("SINGLE").Do(newContext())
+	s.r().NoError(err)
+	s.Equal("0.05", resp.Roi)
+	s.Len(resp.Plans, 1)
+	s.Equal(int64(12345), resp.Plans[0].PlanId)
+	s.Equal("ONGOING", resp.Plans[0].Status)
+}
+
+func (s *autoInvestTestSuite) TestHoldingDetails() {
+	data := []byte(`{
+		"targetAsset": "",
+		"planValueInUSD": "105.00",
+		"planValueInBTC": "0.0035",
+		"pnlInUSD": "5.00",
+		"roi": "0.05",
+		"totalInvestedInUSD": "100",
+		"planId": 12345,
+		"planType": "PORTFOLIO",
+		"status": "ONGOING",
+		"details": [
+			{
+				"targetAsset": "BTC",
+				"averagePriceInUSD": "30000",
+				"totalInvestedInUSD": "60",
+				"purchasedAmount": "0.002",
+				"purchasedAmountUnit": "BTC",
+				"pnlInUSD": "3",
+				"roi": "0.05",
+				"percentage": "60",
+				"assetStatus": "NORMAL",
+				"availableAmount": "0.002",
+				"availableAmountUnit": "BTC",
+				"redeemedAmout": "0",
+				"redeemedAmoutUnit": "BTC",
+				"assetValueInUSD": "63"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"planId": 12345,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewAutoInvestHoldingDetailsService().PlanId(12345).Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(12345), resp.PlanId)
+	s.Equal("0.0035", resp.PlanValueInBTC)
+	s.Len(resp.Details, 1)
+	s.Equal("0.002", resp.Details[0].PurchasedAmount)
+	s.Equal("0", resp.Details[0].RedeemedAmount)
+}
+
+func (s *autoInvestTestSuite) TestOneTimeTransaction() {
+	data := []byte(`{
+		"transactionId": 12345,
+		"waitSecond": 3
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"sourceType":         "MAIN_SITE",
+			"subscriptionAmount": "100",
+			"sourceAsset":        "USDT",
+			"indexId":            1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewAutoInvestOneTimeTransactionService().SourceType("MAIN_SITE").SubscriptionAmount(100).
+		SourceAsset("USDT").IndexId(1).Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(12345), resp.TransactionId)
+	s.Equal(int64(3), resp.WaitSecond)
+}
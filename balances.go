@@ -0,0 +1,421 @@
+package binance_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// WalletKind define a wallet of the account
+type WalletKind string
+
+// Wallet kinds, in the order of BalanceSnapshot.Balances
+const (
+	WalletKindSpot           WalletKind = "SPOT"
+	WalletKindFunding        WalletKind = "FUNDING"
+	WalletKindCrossMargin    WalletKind = "CROSS_MARGIN"
+	WalletKindIsolatedMargin WalletKind = "ISOLATED_MARGIN"
+	WalletKindFutures        WalletKind = "USDM_FUTURES"
+	WalletKindEarnFlexible   WalletKind = "EARN_FLEXIBLE"
+	WalletKindEarnLocked     WalletKind = "EARN_LOCKED"
+)
+
+var walletKinds = []WalletKind{
+	WalletKindSpot, WalletKindFunding, WalletKindCrossMargin, WalletKindIsolatedMargin,
+	WalletKindFutures, WalletKindEarnFlexible, WalletKindEarnLocked,
+}
+
+// WalletKindCoinFutures is the COIN-M futures wallet, a destination of
+// TransferOrchestrator that BalanceAggregator does not read
+const WalletKindCoinFutures WalletKind = "COINM_FUTURES"
+
+// WalletBalance define the balance of an asset in a wallet
+type WalletBalance struct {
+	Wallet   WalletKind
+	Symbol   string // isolated margin pair
+	Asset    string
+	Free     float64
+	Locked   float64 // includes frozen and withdrawing funds, and Simple Earn positions
+	Borrowed float64
+	Interest float64
+	// Value is Net valued in the quote asset of the snapshot, when Priced
+	Value  float64
+	Priced bool
+}
+
+// Net returns the balance net of borrowed funds and interest
+func (b *WalletBalance) Net() float64 {
+	return b.Free + b.Locked - b.Borrowed - b.Interest
+}
+
+// BalanceSnapshot define the balances of every wallet at a point in time
+type BalanceSnapshot struct {
+	Time     time.Time
+	Quote    string
+	Balances []*WalletBalance
+	Total    float64  // sum of the priced values
+	Unpriced []string // assets without a price in Quote
+}
+
+// ByAsset returns the balances summed across wallets, one per asset sorted by asset
+func (s *BalanceSnapshot) ByAsset() []*WalletBalance {
+	assets := make(map[string]*WalletBalance)
+	for _, b := range s.Balances {
+		sum, ok := assets[b.Asset]
+		if !ok {
+			sum = &WalletBalance{Asset: b.Asset, Priced: true}
+			assets[b.Asset] = sum
+		}
+		sum.Free += b.Free
+		sum.Locked += b.Locked
+		sum.Borrowed += b.Borrowed
+		sum.Interest += b.Interest
+		sum.Value += b.Value
+		sum.Priced = sum.Priced && b.Priced
+	}
+	res := make([]*WalletBalance, 0, len(assets))
+	for _, b := range assets {
+		res = append(res, b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res
+}
+
+// BalanceChange define the change of a balance between two snapshots
+type BalanceChange struct {
+	Wallet   WalletKind
+	Symbol   string
+	Asset    string
+	Free     float64
+	Locked   float64
+	Borrowed float64
+	Interest float64
+	Value    float64
+}
+
+// Diff returns the balances that changed from s to newer. Balances missing
+// from a snapshot count as zero.
+func (s *BalanceSnapshot) Diff(newer *BalanceSnapshot) []*BalanceChange {
+	type key struct {
+		wallet        WalletKind
+		symbol, asset string
+	}
+	changes := make(map[key]*BalanceChange)
+	var keys []key
+	add := func(b *WalletBalance, sign float64) {
+		k := key{b.Wallet, b.Symbol, b.Asset}
+		c, ok := changes[k]
+		if !ok {
+			c = &BalanceChange{Wallet: b.Wallet, Symbol: b.Symbol, Asset: b.Asset}
+			changes[k] = c
+			keys = append(keys, k)
+		}
+		c.Free += sign * b.Free
+		c.Locked += sign * b.Locked
+		c.Borrowed += sign * b.Borrowed
+		c.Interest += sign * b.Interest
+		c.Value += sign * b.Value
+	}
+	for _, b := range s.Balances {
+		add(b, -1)
+	}
+	for _, b := range newer.Balances {
+		add(b, 1)
+	}
+	var res []*BalanceChange
+	for _, k := range keys {
+		c := changes[k]
+		if c.Free != 0 || c.Locked != 0 || c.Borrowed != 0 || c.Interest != 0 {
+			res = append(res, c)
+		}
+	}
+	sortBalances(res, func(c *BalanceChange) (WalletKind, string, string) { return c.Wallet, c.Symbol, c.Asset })
+	return res
+}
+
+// BalanceAggregator reads the spot, funding, cross and isolated margin, USD-M
+// futures and Simple Earn wallets concurrently, and values them in a quote
+// asset with the prices of TickerPrice.
+type BalanceAggregator struct {
+	// Quote is the asset the balances are valued in, USDT by default
+	Quote string
+	// Bridges are the assets tried, in order, to value an asset that has no
+	// pair with Quote
+	Bridges []string
+	// Wallets are the wallets read, all of them by default
+	Wallets []WalletKind
+	// FuturesClient reads the USD-M futures wallet, it needs the
+	// https://fapi.binance.com base URL. The futures wallet is skipped when nil.
+	FuturesClient *Client
+
+	c   *Client
+	now func() time.Time
+}
+
+// NewBalanceAggregator create a BalanceAggregator valuing balances in quote
+func NewBalanceAggregator(c *Client, quote string) *BalanceAggregator {
+	if quote == "" {
+		quote = "USDT"
+	}
+	return &BalanceAggregator{
+		Quote:   quote,
+		Bridges: []string{"USDT", "BTC", "ETH", "BNB", "FDUSD"},
+		Wallets: walletKinds,
+		c:       c,
+		now:     time.Now,
+	}
+}
+
+// Snapshot read every wallet and value the balances. Zero balances are left
+// out. When some wallets cannot be read, the snapshot of the other wallets is
+// returned with an error joining the failures.
+func (a *BalanceAggregator) Snapshot(ctx context.Context) (*BalanceSnapshot, error) {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		balances  []*WalletBalance
+		errs      []error
+		prices    map[string]float64
+		pricesErr error
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		prices, pricesErr = a.prices(ctx)
+	}()
+	for _, wallet := range a.Wallets {
+		if wallet == WalletKindFutures && a.FuturesClient == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(wallet WalletKind) {
+			defer wg.Done()
+			res, err := a.read(ctx, wallet)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s wallet: %w", wallet, err))
+				return
+			}
+			balances = append(balances, res...)
+		}(wallet)
+	}
+	wg.Wait()
+	if pricesErr != nil {
+		return nil, pricesErr
+	}
+
+	snapshot := &BalanceSnapshot{Time: a.now(), Quote: a.Quote}
+	unpriced := make(map[string]bool)
+	for _, b := range balances {
+		if b.Free == 0 && b.Locked == 0 && b.Borrowed == 0 && b.Interest == 0 {
+			continue
+		}
+		if rate, ok := a.rate(prices, b.Asset); ok {
+			b.Value = b.Net() * rate
+			b.Priced = true
+			snapshot.Total += b.Value
+		} else if !unpriced[b.Asset] {
+			unpriced[b.Asset] = true
+			snapshot.Unpriced = append(snapshot.Unpriced, b.Asset)
+		}
+		snapshot.Balances = append(snapshot.Balances, b)
+	}
+	sort.Strings(snapshot.Unpriced)
+	sortBalances(snapshot.Balances, func(b *WalletBalance) (WalletKind, string, string) { return b.Wallet, b.Symbol, b.Asset })
+	return snapshot, errors.Join(errs...)
+}
+
+func (a *BalanceAggregator) prices(ctx context.Context) (map[string]float64, error) {
+	tickers, err := a.c.NewTickerPricesService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prices := make(map[string]float64, len(tickers))
+	for _, t := range tickers {
+		price, err := parseAmount(t.Price)
+		if err != nil {
+			return nil, fmt.Errorf("price of %s: %w", t.Symbol, err)
+		}
+		if price > 0 {
+			prices[t.Symbol] = price
+		}
+	}
+	return prices, nil
+}
+
+// rate returns the price of asset in Quote, directly or through a bridge asset
+func (a *BalanceAggregator) rate(prices map[string]float64, asset string) (float64, bool) {
+	direct := func(from, to string) (float64, bool) {
+		if from == to {
+			return 1, true
+		}
+		if price, ok := prices[from+to]; ok {
+			return price, true
+		}
+		if price, ok := prices[to+from]; ok {
+			return 1 / price, true
+		}
+		return 0, false
+	}
+	if rate, ok := direct(asset, a.Quote); ok {
+		return rate, true
+	}
+	for _, bridge := range a.Bridges {
+		toBridge, ok := direct(asset, bridge)
+		if !ok {
+			continue
+		}
+		if fromBridge, ok := direct(bridge, a.Quote); ok {
+			return toBridge * fromBridge, true
+		}
+	}
+	return 0, false
+}
+
+func (a *BalanceAggregator) read(ctx context.Context, wallet WalletKind) ([]*WalletBalance, error) {
+	var res []*WalletBalance
+	var amounts amountParser
+	switch wallet {
+	case WalletKindSpot:
+		account, err := a.c.NewGetAccountService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range account.Balances {
+			res = append(res, &WalletBalance{Wallet: wallet, Asset: b.Asset, Free: amounts.parse(b.Free), Locked: amounts.parse(b.Locked)})
+		}
+	case WalletKindFunding:
+		funding, err := a.c.NewFundingWalletService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range funding {
+			res = append(res, &WalletBalance{Wallet: wallet, Asset: b.Asset, Free: amounts.parse(b.Free),
+				Locked: amounts.parse(b.Locked) + amounts.parse(b.Freeze) + amounts.parse(b.Withdrawing)})
+		}
+	case WalletKindCrossMargin:
+		margin, err := a.c.NewCrossMarginAccountDetailService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range margin.UserAssets {
+			res = append(res, &WalletBalance{Wallet: wallet, Asset: b.Asset, Free: amounts.parse(b.Free), Locked: amounts.parse(b.Locked),
+				Borrowed: amounts.parse(b.Borrowed), Interest: amounts.parse(b.Interest)})
+		}
+	case WalletKindIsolatedMargin:
+		info, err := a.c.NewMarginIsolatedAccountInfoService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		isolated, ok := info.(*MarginIsolatedAccountInfoResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected isolated margin response %T", info)
+		}
+		for _, pair := range isolated.Assets {
+			for _, b := range []IsolatedMarginAsset{pair.BaseAsset, pair.QuoteAsset} {
+				res = append(res, &WalletBalance{Wallet: wallet, Symbol: pair.Symbol, Asset: b.Asset, Free: amounts.parse(b.Free),
+					Locked: amounts.parse(b.Locked), Borrowed: amounts.parse(b.Borrowed), Interest: amounts.parse(b.Interest)})
+			}
+		}
+	case WalletKindFutures:
+		futures, err := a.FuturesClient.NewFuturesGetBalanceService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range futures {
+			res = append(res, &WalletBalance{Wallet: wallet, Asset: b.Asset, Free: amounts.parse(b.Balance)})
+		}
+	case WalletKindEarnFlexible:
+		for page := int64(1); ; page++ {
+			positions, err := a.c.NewSimpleEarnFlexiblePositionService().Current(page).Size(100).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range positions.Rows {
+				res = append(res, &WalletBalance{Wallet: wallet, Asset: p.Asset, Locked: amounts.parse(p.TotalAmount)})
+			}
+			if len(positions.Rows) < 100 {
+				break
+			}
+		}
+	case WalletKindEarnLocked:
+		for page := int64(1); ; page++ {
+			positions, err := a.c.NewSimpleEarnLockedPositionService().Current(page).Size(100).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range positions.Rows {
+				res = append(res, &WalletBalance{Wallet: wallet, Asset: p.Asset, Locked: amounts.parse(p.Amount)})
+			}
+			if len(positions.Rows) < 100 {
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown wallet %s", wallet)
+	}
+	if amounts.err != nil {
+		return nil, amounts.err
+	}
+	return res, nil
+}
+
+func sortBalances[T any](balances []T, key func(T) (WalletKind, string, string)) {
+	order := make(map[WalletKind]int, len(walletKinds))
+	for i, wallet := range walletKinds {
+		order[wallet] = i
+	}
+	sort.SliceStable(balances, func(i, j int) bool {
+		wi, si, ai := key(balances[i])
+		wj, sj, aj := key(balances[j])
+		if wi != wj {
+			return order[wi] < order[wj]
+		}
+		if si != sj {
+			return si < sj
+		}
+		return ai < aj
+	})
+}
+
+// parseAmount parses a decimal amount of the API, returning 0 for an empty
+// amount
+func parseAmount(amount string) (float64, error) {
+	if amount == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	return f, nil
+}
+
+// amountParser parses amounts with parseAmount, keeping the first error
+type amountParser struct {
+	err error
+}
+
+func (p *amountParser) parse(amount string) float64 {
+	f, err := parseAmount(amount)
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	return f
+}
+
+// zero reports whether all amounts are zero
+func (p *amountParser) zero(amounts ...string) bool {
+	zero := true
+	for _, amount := range amounts {
+		if p.parse(amount) != 0 {
+			zero = false
+		}
+	}
+	return zero
+}
@@ -0,0 +1,150 @@
+package binance_connector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+	"github.com/stretchr/testify/suite"
+)
+
+type balanceAggregatorTestSuite struct {
+	suite.Suite
+	responses map[string]string
+	client    *Client
+}
+
+func TestBalanceAggregator(t *testing.T) {
+	suite.Run(t, new(balanceAggregatorTestSuite))
+}
+
+func (s *balanceAggregatorTestSuite) SetupTest() {
+	s.responses = map[string]string{
+		"/api/v3/ticker/price": `[
+			{"symbol":"BTCUSDT","price":"30000"},
+			{"symbol":"ETHBTC","price":"0.06"},
+			{"symbol":"USDTTRY","price":"25"}]`,
+		"/api/v3/account": `{"balances":[
+			{"asset":"BTC","free":"1.5","locked":"0.5"},
+			{"asset":"BNB","free":"0.00000000","locked":"0.00000000"},
+			{"asset":"XYZ","free":"10","locked":"0"}]}`,
+		"/sapi/v1/asset/get-funding-asset": `[{"asset":"TRY","free":"250","locked":"0","freeze":"250","withdrawing":"0"}]`,
+		"/sapi/v1/margin/account": `{"userAssets":[
+			{"asset":"USDT","free":"1000","locked":"0","borrowed":"500","interest":"1"}]}`,
+		"/sapi/v1/margin/isolated/account": `{"assets":[{"symbol":"ETHBTC",
+			"baseAsset":{"asset":"ETH","free":"2","locked":"0","borrowed":"1","interest":"0"},
+			"quoteAsset":{"asset":"BTC","free":"0","locked":"0","borrowed":"0","interest":"0"}}]}`,
+		"/fapi/v2/balance":                       `[{"asset":"USDT","balance":"200","availableBalance":"150"}]`,
+		"/sapi/v1/simple-earn/flexible/position": `{"rows":[{"asset":"USDT","totalAmount":"300"}],"total":1}`,
+		"/sapi/v1/simple-earn/locked/position":   `{"rows":[],"total":0}`,
+	}
+	s.client = s.newClient()
+}
+
+func (s *balanceAggregatorTestSuite) newClient() *Client {
+	return newFakeClient(func(req *http.Request) (interface{}, error) {
+		body, ok := s.responses[req.URL.Path]
+		if !ok {
+			return nil, &handlers.APIError{Code: -2015, Message: "Invalid API-key, IP, or permissions for action."}
+		}
+		return body, nil
+	})
+}
+
+func (s *balanceAggregatorTestSuite) TestSnapshot() {
+	r := s.Require()
+	aggregator := NewBalanceAggregator(s.client, "USDT")
+	aggregator.FuturesClient = s.newClient()
+
+	snapshot, err := aggregator.Snapshot(context.Background())
+	r.NoError(err)
+	r.Equal("USDT", snapshot.Quote)
+	r.Equal([]string{"XYZ"}, snapshot.Unpriced)
+	r.Len(snapshot.Balances, 7)
+
+	spot := snapshot.Balances[0]
+	r.Equal(WalletKindSpot, spot.Wallet)
+	r.Equal("BTC", spot.Asset)
+	r.InDelta(60000, spot.Value, 1e-6)
+	r.False(snapshot.Balances[1].Priced)
+
+	// TRY is valued through USDTTRY, frozen funds are locked
+	funding := snapshot.Balances[2]
+	r.Equal(WalletKindFunding, funding.Wallet)
+	r.InDelta(250, funding.Locked, 1e-9)
+	r.InDelta(20, funding.Value, 1e-9)
+
+	margin := snapshot.Balances[3]
+	r.Equal(WalletKindCrossMargin, margin.Wallet)
+	r.InDelta(499, margin.Net(), 1e-9)
+
+	// ETH is valued through the BTC bridge
+	isolated := snapshot.Balances[4]
+	r.Equal(WalletKindIsolatedMargin, isolated.Wallet)
+	r.Equal("ETHBTC", isolated.Symbol)
+	r.Equal("ETH", isolated.Asset)
+	r.InDelta(1800, isolated.Value, 1e-6)
+
+	r.Equal(WalletKindFutures, snapshot.Balances[5].Wallet)
+	r.Equal(WalletKindEarnFlexible, snapshot.Balances[6].Wallet)
+	r.InDelta(60000+20+499+1800+200+300, snapshot.Total, 1e-6)
+
+	assets := snapshot.ByAsset()
+	r.Len(assets, 5)
+	r.Equal("USDT", assets[3].Asset)
+	r.InDelta(1500, assets[3].Free+assets[3].Locked, 1e-9)
+	r.InDelta(500, assets[3].Borrowed, 1e-9)
+}
+
+func (s *balanceAggregatorTestSuite) TestSnapshotReturnsWalletErrors() {
+	r := s.Require()
+	delete(s.responses, "/sapi/v1/margin/isolated/account")
+	aggregator := NewBalanceAggregator(s.client, "BTC")
+
+	snapshot, err := aggregator.Snapshot(context.Background())
+	var apiErr *handlers.APIError
+	r.True(errors.As(err, &apiErr))
+	r.Contains(err.Error(), "ISOLATED_MARGIN wallet")
+	r.NotNil(snapshot)
+	r.Len(snapshot.Balances, 5)
+	r.InDelta(2, snapshot.Balances[0].Value, 1e-9)
+}
+
+func (s *balanceAggregatorTestSuite) TestSnapshotInvalidAmounts() {
+	r := s.Require()
+	s.responses["/api/v3/account"] = `{"balances":[{"asset":"BTC","free":"1.5.0","locked":"0"}]}`
+	aggregator := NewBalanceAggregator(s.client, "USDT")
+	aggregator.Wallets = []WalletKind{WalletKindSpot, WalletKindFunding}
+
+	snapshot, err := aggregator.Snapshot(context.Background())
+	r.ErrorContains(err, `SPOT wallet: invalid amount "1.5.0"`)
+	r.Len(snapshot.Balances, 1)
+	r.Equal(WalletKindFunding, snapshot.Balances[0].Wallet)
+
+	s.responses["/api/v3/ticker/price"] = `[{"symbol":"BTCUSDT","price":"n/a"}]`
+	_, err = aggregator.Snapshot(context.Background())
+	r.ErrorContains(err, `price of BTCUSDT: invalid amount "n/a"`)
+}
+
+func (s *balanceAggregatorTestSuite) TestDiff() {
+	r := s.Require()
+	before := &BalanceSnapshot{Time: time.Unix(0, 0), Balances: []*WalletBalance{
+		{Wallet: WalletKindSpot, Asset: "BTC", Free: 1, Value: 30000},
+		{Wallet: WalletKindSpot, Asset: "ETH", Free: 2, Value: 3600},
+		{Wallet: WalletKindFunding, Asset: "USDT", Free: 100, Value: 100},
+	}}
+	after := &BalanceSnapshot{Time: time.Unix(60, 0), Balances: []*WalletBalance{
+		{Wallet: WalletKindSpot, Asset: "BTC", Free: 0.5, Locked: 0.5, Value: 31000},
+		{Wallet: WalletKindFunding, Asset: "USDT", Free: 100, Value: 100},
+		{Wallet: WalletKindCrossMargin, Asset: "USDT", Borrowed: 50, Value: -50},
+	}}
+
+	changes := before.Diff(after)
+	r.Len(changes, 3)
+	r.Equal(&BalanceChange{Wallet: WalletKindSpot, Asset: "BTC", Free: -0.5, Locked: 0.5, Value: 1000}, changes[0])
+	r.Equal(&BalanceChange{Wallet: WalletKindSpot, Asset: "ETH", Free: -2, Value: -3600}, changes[1])
+	r.Equal(&BalanceChange{Wallet: WalletKindCrossMargin, Asset: "USDT", Borrowed: 50, Value: -50}, changes[2])
+}
@@ -0,0 +1,670 @@
+package binancetest
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Order sides, types and statuses as used on the wire
+const (
+	sideBuy  = "BUY"
+	sideSell = "SELL"
+
+	typeLimit  = "LIMIT"
+	typeMarket = "MARKET"
+
+	tifGTC = "GTC"
+	tifIOC = "IOC"
+	tifFOK = "FOK"
+
+	statusNew             = "NEW"
+	statusPartiallyFilled = "PARTIALLY_FILLED"
+	statusFilled          = "FILLED"
+	statusCanceled        = "CANCELED"
+	statusExpired         = "EXPIRED"
+)
+
+// Symbol define a trading pair listed on the fake exchange
+type Symbol struct {
+	Name       string
+	BaseAsset  string
+	QuoteAsset string
+}
+
+// Account define a fake exchange account, identified by its API key
+type Account struct {
+	APIKey    string
+	SecretKey string
+
+	// balances are guarded by the exchange lock
+	free   map[string]*big.Rat
+	locked map[string]*big.Rat
+}
+
+type balance struct {
+	Asset  string
+	Free   *big.Rat
+	Locked *big.Rat
+}
+
+type order struct {
+	id            int64
+	clientOrderId string
+	account       *Account
+	symbol        *Symbol
+	side          string
+	orderType     string
+	timeInForce   string
+	price         *big.Rat // nil for market orders
+	origQty       *big.Rat
+	quoteOrderQty *big.Rat
+	executedQty   *big.Rat
+	cumQuote      *big.Rat
+	status        string
+	time          int64
+	updateTime    int64
+	fills         []*fill
+}
+
+func (o *order) remaining() *big.Rat {
+	return new(big.Rat).Sub(o.origQty, o.executedQty)
+}
+
+func (o *order) isOpen() bool {
+	return o.status == statusNew || o.status == statusPartiallyFilled
+}
+
+type fill struct {
+	tradeId         int64
+	price           *big.Rat
+	qty             *big.Rat
+	commissionAsset string
+	isMaker         bool
+	time            int64
+}
+
+type trade struct {
+	id       int64
+	orderId  int64
+	symbol   string
+	price    *big.Rat
+	qty      *big.Rat
+	quoteQty *big.Rat
+	isBuyer  bool
+	isMaker  bool
+	asset    string
+	time     int64
+}
+
+// orderUpdate is emitted to user data streams whenever an order changes. It
+// captures the order state at the time of the change.
+type orderUpdate struct {
+	order         *order
+	executionType string
+	status        string
+	executedQty   *big.Rat
+	cumQuote      *big.Rat
+	lastFill      *fill
+	time          int64
+}
+
+func (o *order) update(executionType string, lastFill *fill) orderUpdate {
+	return orderUpdate{
+		order:         o,
+		executionType: executionType,
+		status:        o.status,
+		executedQty:   new(big.Rat).Set(o.executedQty),
+		cumQuote:      new(big.Rat).Set(o.cumQuote),
+		lastFill:      lastFill,
+		time:          o.updateTime,
+	}
+}
+
+// exchange is a single-lock, price-time priority matching engine
+type exchange struct {
+	mu       sync.Mutex
+	now      func() time.Time
+	symbols  map[string]*Symbol
+	accounts map[string]*Account
+	books    map[string]*book
+	orders   map[int64]*order
+	trades   map[*Account][]*trade
+	nextId   int64
+	nextTrd  int64
+	notify   func(acct *Account, updates []orderUpdate, assets []string)
+}
+
+type book struct {
+	bids []*order // best (highest) first
+	asks []*order // best (lowest) first
+}
+
+func newExchange(now func() time.Time) *exchange {
+	return &exchange{
+		now:      now,
+		symbols:  make(map[string]*Symbol),
+		accounts: make(map[string]*Account),
+		books:    make(map[string]*book),
+		orders:   make(map[int64]*order),
+		trades:   make(map[*Account][]*trade),
+		notify:   func(*Account, []orderUpdate, []string) {},
+	}
+}
+
+func (e *exchange) timestamp() int64 {
+	return e.now().UnixNano() / int64(time.Millisecond)
+}
+
+func (a *Account) bal(m map[string]*big.Rat, asset string) *big.Rat {
+	v, ok := m[asset]
+	if !ok {
+		v = new(big.Rat)
+		m[asset] = v
+	}
+	return v
+}
+
+func (a *Account) balances() []balance {
+	assets := make(map[string]struct{})
+	for k := range a.free {
+		assets[k] = struct{}{}
+	}
+	for k := range a.locked {
+		assets[k] = struct{}{}
+	}
+	res := make([]balance, 0, len(assets))
+	for asset := range assets {
+		res = append(res, balance{
+			Asset:  asset,
+			Free:   a.bal(a.free, asset),
+			Locked: a.bal(a.locked, asset),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res
+}
+
+// placeRequest carries the validated parameters of a new order
+type placeRequest struct {
+	symbol        string
+	side          string
+	orderType     string
+	timeInForce   string
+	price         *big.Rat
+	quantity      *big.Rat
+	quoteOrderQty *big.Rat
+	clientOrderId string
+}
+
+func (e *exchange) place(acct *Account, req *placeRequest) (*order, *apiError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	sym, ok := e.symbols[req.symbol]
+	if !ok {
+		return nil, errInvalidSymbol
+	}
+	if req.side != sideBuy && req.side != sideSell {
+		return nil, newAPIError(-1117, "Invalid side.")
+	}
+	now := e.timestamp()
+	e.nextId++
+	o := &order{
+		id:            e.nextId,
+		clientOrderId: req.clientOrderId,
+		account:       acct,
+		symbol:        sym,
+		side:          req.side,
+		orderType:     req.orderType,
+		timeInForce:   req.timeInForce,
+		price:         req.price,
+		origQty:       req.quantity,
+		quoteOrderQty: req.quoteOrderQty,
+		executedQty:   new(big.Rat),
+		cumQuote:      new(big.Rat),
+		status:        statusNew,
+		time:          now,
+		updateTime:    now,
+	}
+	if o.clientOrderId == "" {
+		o.clientOrderId = newClientOrderId()
+	}
+
+	switch req.orderType {
+	case typeLimit:
+		if req.timeInForce == "" || req.price == nil || req.quantity == nil {
+			return nil, errMandatoryParam
+		}
+		if req.timeInForce != tifGTC && req.timeInForce != tifIOC && req.timeInForce != tifFOK {
+			return nil, newAPIError(-1115, "Invalid timeInForce.")
+		}
+		if err := e.lockForLimit(o); err != nil {
+			return nil, err
+		}
+	case typeMarket:
+		if req.quantity == nil && req.quoteOrderQty == nil {
+			return nil, errMandatoryParam
+		}
+		if o.origQty == nil {
+			o.origQty = e.marketQtyForQuote(o)
+		}
+		if err := e.checkMarket(o); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, newAPIError(-1116, "Invalid orderType.")
+	}
+
+	e.orders[o.id] = o
+	updates := []orderUpdate{o.update("NEW", nil)}
+	if o.timeInForce == tifFOK && e.fillable(o).Cmp(o.origQty) < 0 {
+		e.unlockRemaining(o)
+		o.status = statusExpired
+		e.notify(acct, append(updates, o.update(statusExpired, nil)), e.assetsOf(o))
+		return o, nil
+	}
+
+	updates, makers := e.match(o, updates)
+	switch {
+	case o.orderType == typeMarket && o.executedQty.Sign() == 0:
+		o.status = statusExpired
+	case o.remaining().Sign() == 0:
+		o.status = statusFilled
+	case o.orderType == typeMarket || o.timeInForce == tifIOC:
+		e.unlockRemaining(o)
+		o.status = statusExpired
+	default:
+		e.rest(o)
+	}
+
+	if o.status == statusExpired {
+		updates = append(updates, o.update(statusExpired, nil))
+	}
+	e.notify(acct, updates, e.assetsOf(o))
+	for _, m := range makers {
+		e.notify(m.order.account, []orderUpdate{m}, e.assetsOf(m.order))
+	}
+	return o, nil
+}
+
+// lockForLimit moves the funds a limit order may spend from free to locked
+func (e *exchange) lockForLimit(o *order) *apiError {
+	acct := o.account
+	asset, amount := o.symbol.BaseAsset, o.origQty
+	if o.side == sideBuy {
+		asset, amount = o.symbol.QuoteAsset, new(big.Rat).Mul(o.price, o.origQty)
+	}
+	free := acct.bal(acct.free, asset)
+	if free.Cmp(amount) < 0 {
+		return errInsufficientBalance
+	}
+	free.Sub(free, amount)
+	locked := acct.bal(acct.locked, asset)
+	locked.Add(locked, amount)
+	return nil
+}
+
+// checkMarket makes sure a market order can be paid for at current book prices
+func (e *exchange) checkMarket(o *order) *apiError {
+	acct := o.account
+	if o.side == sideSell {
+		if acct.bal(acct.free, o.symbol.BaseAsset).Cmp(o.origQty) < 0 {
+			return errInsufficientBalance
+		}
+		return nil
+	}
+	cost := new(big.Rat)
+	left := new(big.Rat).Set(o.origQty)
+	for _, m := range e.opposite(o) {
+		if left.Sign() == 0 {
+			break
+		}
+		qty := minRat(left, m.remaining())
+		cost.Add(cost, new(big.Rat).Mul(qty, m.price))
+		left.Sub(left, qty)
+	}
+	if acct.bal(acct.free, o.symbol.QuoteAsset).Cmp(cost) < 0 {
+		return errInsufficientBalance
+	}
+	return nil
+}
+
+// marketQtyForQuote converts a quoteOrderQty into the base quantity it buys
+// or sells at current book prices
+func (e *exchange) marketQtyForQuote(o *order) *big.Rat {
+	qty := new(big.Rat)
+	left := new(big.Rat).Set(o.quoteOrderQty)
+	for _, m := range e.opposite(o) {
+		if left.Sign() == 0 {
+			break
+		}
+		levelQuote := new(big.Rat).Mul(m.remaining(), m.price)
+		if levelQuote.Cmp(left) <= 0 {
+			qty.Add(qty, m.remaining())
+			left.Sub(left, levelQuote)
+			continue
+		}
+		qty.Add(qty, new(big.Rat).Quo(left, m.price))
+		left.SetInt64(0)
+	}
+	return qty
+}
+
+func (e *exchange) opposite(o *order) []*order {
+	b := e.book(o.symbol.Name)
+	if o.side == sideBuy {
+		return b.asks
+	}
+	return b.bids
+}
+
+func (e *exchange) book(symbol string) *book {
+	b, ok := e.books[symbol]
+	if !ok {
+		b = &book{}
+		e.books[symbol] = b
+	}
+	return b
+}
+
+func (e *exchange) crosses(taker, maker *order) bool {
+	if taker.price == nil {
+		return true
+	}
+	if taker.side == sideBuy {
+		return maker.price.Cmp(taker.price) <= 0
+	}
+	return maker.price.Cmp(taker.price) >= 0
+}
+
+// fillable returns how much of o could execute immediately
+func (e *exchange) fillable(o *order) *big.Rat {
+	total := new(big.Rat)
+	for _, m := range e.opposite(o) {
+		if !e.crosses(o, m) {
+			break
+		}
+		total.Add(total, m.remaining())
+	}
+	return total
+}
+
+// match executes o against resting orders. It appends the taker updates to
+// updates and returns them along with the maker updates.
+func (e *exchange) match(o *order, updates []orderUpdate) (taker, makers []orderUpdate) {
+	b := e.book(o.symbol.Name)
+	side := &b.asks
+	if o.side == sideSell {
+		side = &b.bids
+	}
+	for len(*side) > 0 && o.remaining().Sign() > 0 {
+		m := (*side)[0]
+		if !e.crosses(o, m) {
+			break
+		}
+		qty := minRat(o.remaining(), m.remaining())
+		now := e.timestamp()
+		e.nextTrd++
+		takerFill := e.execute(o, qty, m.price, false, now)
+		makerFill := e.execute(m, qty, m.price, true, now)
+		takerFill.tradeId, makerFill.tradeId = e.nextTrd, e.nextTrd
+		if m.remaining().Sign() == 0 {
+			m.status = statusFilled
+			*side = (*side)[1:]
+		} else {
+			m.status = statusPartiallyFilled
+		}
+		makers = append(makers, m.update("TRADE", makerFill))
+		if o.remaining().Sign() == 0 {
+			o.status = statusFilled
+		} else {
+			o.status = statusPartiallyFilled
+		}
+		updates = append(updates, o.update("TRADE", takerFill))
+	}
+	return updates, makers
+}
+
+// execute books a fill of qty at price against o and settles its balances
+func (e *exchange) execute(o *order, qty, price *big.Rat, isMaker bool, now int64) *fill {
+	acct := o.account
+	base, quote := o.symbol.BaseAsset, o.symbol.QuoteAsset
+	quoteQty := new(big.Rat).Mul(qty, price)
+	if o.side == sideBuy {
+		if o.price != nil {
+			// release the funds reserved at the limit price
+			reserved := new(big.Rat).Mul(qty, o.price)
+			locked := acct.bal(acct.locked, quote)
+			locked.Sub(locked, reserved)
+			free := acct.bal(acct.free, quote)
+			free.Add(free, reserved.Sub(reserved, quoteQty))
+		} else {
+			free := acct.bal(acct.free, quote)
+			free.Sub(free, quoteQty)
+		}
+		free := acct.bal(acct.free, base)
+		free.Add(free, qty)
+	} else {
+		if o.price != nil {
+			locked := acct.bal(acct.locked, base)
+			locked.Sub(locked, qty)
+		} else {
+			free := acct.bal(acct.free, base)
+			free.Sub(free, qty)
+		}
+		free := acct.bal(acct.free, quote)
+		free.Add(free, quoteQty)
+	}
+	o.executedQty.Add(o.executedQty, qty)
+	o.cumQuote.Add(o.cumQuote, quoteQty)
+	o.updateTime = now
+	commissionAsset := base
+	if o.side == sideSell {
+		commissionAsset = quote
+	}
+	f := &fill{
+		price:           price,
+		qty:             qty,
+		commissionAsset: commissionAsset,
+		isMaker:         isMaker,
+		time:            now,
+	}
+	o.fills = append(o.fills, f)
+	e.trades[acct] = append(e.trades[acct], &trade{
+		id:       e.nextTrd,
+		orderId:  o.id,
+		symbol:   o.symbol.Name,
+		price:    price,
+		qty:      qty,
+		quoteQty: quoteQty,
+		isBuyer:  o.side == sideBuy,
+		isMaker:  isMaker,
+		asset:    commissionAsset,
+		time:     now,
+	})
+	return f
+}
+
+// rest inserts o into the book behind orders of equal or better price
+func (e *exchange) rest(o *order) {
+	b := e.book(o.symbol.Name)
+	side := &b.bids
+	better := func(a, b *big.Rat) bool { return a.Cmp(b) > 0 }
+	if o.side == sideSell {
+		side = &b.asks
+		better = func(a, b *big.Rat) bool { return a.Cmp(b) < 0 }
+	}
+	i := sort.Search(len(*side), func(i int) bool {
+		return better(o.price, (*side)[i].price)
+	})
+	*side = append(*side, nil)
+	copy((*side)[i+1:], (*side)[i:])
+	(*side)[i] = o
+}
+
+func (e *exchange) unlockRemaining(o *order) {
+	if o.price == nil {
+		return
+	}
+	acct := o.account
+	asset, amount := o.symbol.BaseAsset, o.remaining()
+	if o.side == sideBuy {
+		asset, amount = o.symbol.QuoteAsset, new(big.Rat).Mul(o.price, o.remaining())
+	}
+	locked := acct.bal(acct.locked, asset)
+	locked.Sub(locked, amount)
+	free := acct.bal(acct.free, asset)
+	free.Add(free, amount)
+}
+
+func (e *exchange) cancel(acct *Account, symbol string, orderId int64, clientOrderId string) (*order, *apiError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o := e.find(acct, symbol, orderId, clientOrderId)
+	if o == nil || !o.isOpen() {
+		return nil, errUnknownOrder
+	}
+	e.remove(o)
+	e.unlockRemaining(o)
+	o.status = statusCanceled
+	o.updateTime = e.timestamp()
+	e.notify(acct, []orderUpdate{o.update(statusCanceled, nil)}, e.assetsOf(o))
+	return o, nil
+}
+
+func (e *exchange) cancelAll(acct *Account, symbol string) ([]*order, *apiError) {
+	open := e.openOrders(acct, symbol)
+	if len(open) == 0 {
+		return nil, errUnknownOrder
+	}
+	res := make([]*order, 0, len(open))
+	for _, o := range open {
+		if c, err := e.cancel(acct, symbol, o.id, ""); err == nil {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+func (e *exchange) remove(o *order) {
+	b := e.book(o.symbol.Name)
+	for _, side := range []*[]*order{&b.bids, &b.asks} {
+		for i, r := range *side {
+			if r == o {
+				*side = append((*side)[:i], (*side)[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (e *exchange) find(acct *Account, symbol string, orderId int64, clientOrderId string) *order {
+	if orderId != 0 {
+		o, ok := e.orders[orderId]
+		if !ok || o.account != acct || o.symbol.Name != symbol {
+			return nil
+		}
+		return o
+	}
+	for _, o := range e.orders {
+		if o.account == acct && o.symbol.Name == symbol && o.clientOrderId == clientOrderId {
+			return o
+		}
+	}
+	return nil
+}
+
+func (e *exchange) get(acct *Account, symbol string, orderId int64, clientOrderId string) (*order, *apiError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o := e.find(acct, symbol, orderId, clientOrderId)
+	if o == nil {
+		return nil, newAPIError(-2013, "Order does not exist.")
+	}
+	return o, nil
+}
+
+func (e *exchange) openOrders(acct *Account, symbol string) []*order {
+	return e.filterOrders(acct, symbol, func(o *order) bool { return o.isOpen() })
+}
+
+func (e *exchange) allOrders(acct *Account, symbol string) []*order {
+	return e.filterOrders(acct, symbol, func(o *order) bool { return true })
+}
+
+func (e *exchange) filterOrders(acct *Account, symbol string, keep func(o *order) bool) []*order {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var res []*order
+	for _, o := range e.orders {
+		if o.account == acct && (symbol == "" || o.symbol.Name == symbol) && keep(o) {
+			res = append(res, o)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].id < res[j].id })
+	return res
+}
+
+func (e *exchange) myTrades(acct *Account, symbol string) []*trade {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var res []*trade
+	for _, t := range e.trades[acct] {
+		if t.symbol == symbol {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func (e *exchange) depth(symbol string) (bids, asks [][2]*big.Rat) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	b := e.book(symbol)
+	return levels(b.bids), levels(b.asks)
+}
+
+// levels aggregates resting orders into price levels
+func levels(orders []*order) [][2]*big.Rat {
+	var res [][2]*big.Rat
+	for _, o := range orders {
+		if n := len(res); n > 0 && res[n-1][0].Cmp(o.price) == 0 {
+			res[n-1][1].Add(res[n-1][1], o.remaining())
+			continue
+		}
+		res = append(res, [2]*big.Rat{o.price, o.remaining()})
+	}
+	return res
+}
+
+func (e *exchange) assetsOf(o *order) []string {
+	return []string{o.symbol.BaseAsset, o.symbol.QuoteAsset}
+}
+
+func minRat(a, b *big.Rat) *big.Rat {
+	if a.Cmp(b) <= 0 {
+		return new(big.Rat).Set(a)
+	}
+	return new(big.Rat).Set(b)
+}
+
+func formatRat(r *big.Rat) string {
+	if r == nil {
+		return "0.00000000"
+	}
+	return r.FloatString(8)
+}
+
+func parseRat(s string) (*big.Rat, bool) {
+	if s == "" {
+		return nil, true
+	}
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || r.Sign() < 0 {
+		return nil, false
+	}
+	return r, true
+}
@@ -0,0 +1,739 @@
+// Package binancetest provides an in-process fake Binance spot exchange for
+// integration tests.
+//
+// The server speaks the wire protocol of the REST API, the Websocket API and
+// the user data streams, so the connector clients can be pointed at it
+// unchanged:
+//
+//	srv := binancetest.NewServer()
+//	defer srv.Close()
+//	srv.AddSymbol("BTCUSDT", "BTC", "USDT")
+//	srv.AddAccount("key", "secret").SetBalance("USDT", "10000")
+//
+//	client := binance_connector.NewClient("key", "secret", srv.URL)
+//	wsAPI := binance_connector.NewWebsocketAPIClient("key", "secret", srv.WebsocketAPIURL())
+//	streams := binance_connector.NewWebsocketStreamClient(false, srv.StreamURL())
+//
+// Requests are authenticated like on Binance: the API key must belong to an
+// account added with AddAccount, signatures are checked with its secret key and
+// timestamps must fall within recvWindow of the server clock. Orders are
+// matched by a price-time priority engine; there are no fees.
+package binancetest
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultRecvWindow is used when a signed request does not set recvWindow
+const defaultRecvWindow = 5000
+
+// Server is a fake Binance exchange listening on a local port
+type Server struct {
+	*httptest.Server
+
+	// Now is the server clock, used for timestamps and recvWindow checks
+	Now func() time.Time
+
+	ex      *exchange
+	streams *streamHub
+}
+
+// NewServer starts a fake exchange. Call Close when done.
+func NewServer() *Server {
+	s := &Server{Now: time.Now}
+	s.ex = newExchange(func() time.Time { return s.Now() })
+	s.streams = newStreamHub()
+	s.ex.notify = s.streams.publish
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/ping", s.handlePing)
+	mux.HandleFunc("/api/v3/time", s.handleTime)
+	mux.HandleFunc("/api/v3/depth", s.handleDepth)
+	mux.HandleFunc("/api/v3/order", s.handleOrder)
+	mux.HandleFunc("/api/v3/order/test", s.handleOrderTest)
+	mux.HandleFunc("/api/v3/openOrders", s.handleOpenOrders)
+	mux.HandleFunc("/api/v3/allOrders", s.handleAllOrders)
+	mux.HandleFunc("/api/v3/account", s.handleAccount)
+	mux.HandleFunc("/api/v3/myTrades", s.handleMyTrades)
+	mux.HandleFunc("/api/v3/userDataStream", s.handleUserDataStream)
+	mux.HandleFunc("/ws-api/v3", s.handleWebsocketAPI)
+	mux.HandleFunc("/ws/", s.handleUserDataWebsocket)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// WebsocketAPIURL returns the base URL to pass to NewWebsocketAPIClient
+func (s *Server) WebsocketAPIURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http") + "/ws-api/v3"
+}
+
+// StreamURL returns the base URL to pass to NewWebsocketStreamClient
+func (s *Server) StreamURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// AddSymbol lists a trading pair
+func (s *Server) AddSymbol(symbol, baseAsset, quoteAsset string) *Symbol {
+	s.ex.mu.Lock()
+	defer s.ex.mu.Unlock()
+	sym := &Symbol{Name: symbol, BaseAsset: baseAsset, QuoteAsset: quoteAsset}
+	s.ex.symbols[symbol] = sym
+	return sym
+}
+
+// AddAccount creates an account authenticated by apiKey and secretKey
+func (s *Server) AddAccount(apiKey, secretKey string) *Account {
+	s.ex.mu.Lock()
+	defer s.ex.mu.Unlock()
+	acct := &Account{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		free:      make(map[string]*big.Rat),
+		locked:    make(map[string]*big.Rat),
+	}
+	s.ex.accounts[apiKey] = acct
+	return acct
+}
+
+// SetBalance sets the free balance of asset. Call it before the account
+// starts trading; it panics if amount is not a decimal number.
+func (a *Account) SetBalance(asset, amount string) *Account {
+	v, ok := parseRat(amount)
+	if !ok || v == nil {
+		panic(fmt.Sprintf("binancetest: invalid amount %q", amount))
+	}
+	a.free[asset] = v
+	return a
+}
+
+// Balance returns the free and locked balance of asset, formatted like the API
+func (s *Server) Balance(apiKey, asset string) (free, locked string) {
+	s.ex.mu.Lock()
+	defer s.ex.mu.Unlock()
+	acct, ok := s.ex.accounts[apiKey]
+	if !ok {
+		return formatRat(nil), formatRat(nil)
+	}
+	return formatRat(acct.bal(acct.free, asset)), formatRat(acct.bal(acct.locked, asset))
+}
+
+type apiError struct {
+	status  int
+	Code    int64  `json:"code"`
+	Message string `json:"msg"`
+}
+
+func newAPIError(code int64, msg string) *apiError {
+	return &apiError{status: http.StatusBadRequest, Code: code, Message: msg}
+}
+
+var (
+	errMandatoryParam      = newAPIError(-1102, "Mandatory parameter was not sent, was empty/null, or malformed.")
+	errInvalidSymbol       = newAPIError(-1121, "Invalid symbol.")
+	errUnknownOrder        = newAPIError(-2011, "Unknown order sent.")
+	errInsufficientBalance = newAPIError(-2010, "Account has insufficient balance for requested action.")
+	errInvalidSignature    = &apiError{status: http.StatusUnauthorized, Code: -1022, Message: "Signature for this request is not valid."}
+	errInvalidAPIKey       = &apiError{status: http.StatusUnauthorized, Code: -2015, Message: "Invalid API-key, IP, or permissions for action."}
+	errOutsideRecvWindow   = newAPIError(-1021, "Timestamp for this request is outside of the recvWindow.")
+	errUnknownListenKey    = newAPIError(-1125, "This listenKey does not exist.")
+)
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, err)
+}
+
+// params gathers query and form parameters, the way Binance accepts both
+func params(r *http.Request) (url.Values, string, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	v, err := url.ParseQuery(r.URL.RawQuery)
+	if err != nil {
+		return nil, "", err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, "", err
+	}
+	for k, vs := range form {
+		v[k] = append(v[k], vs...)
+	}
+	return v, string(body), nil
+}
+
+// apiKeyAccount resolves the account of the X-MBX-APIKEY header
+func (s *Server) apiKeyAccount(r *http.Request) (*Account, *apiError) {
+	s.ex.mu.Lock()
+	defer s.ex.mu.Unlock()
+	acct, ok := s.ex.accounts[r.Header.Get("X-MBX-APIKEY")]
+	if !ok {
+		return nil, errInvalidAPIKey
+	}
+	return acct, nil
+}
+
+// signed authenticates a SIGNED request and returns its parameters
+func (s *Server) signed(r *http.Request) (*Account, url.Values, *apiError) {
+	acct, apiErr := s.apiKeyAccount(r)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+	v, body, err := params(r)
+	if err != nil {
+		return nil, nil, errMandatoryParam
+	}
+	signature := v.Get("signature")
+	if signature == "" {
+		return nil, nil, errMandatoryParam
+	}
+	// The signature covers the query string, without the signature itself,
+	// followed by the request body
+	var parts []string
+	for _, p := range strings.Split(r.URL.RawQuery, "&") {
+		if !strings.HasPrefix(p, "signature=") && p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if !validSignature(acct.SecretKey, strings.Join(parts, "&")+body, signature) {
+		return nil, nil, errInvalidSignature
+	}
+	if apiErr = s.checkTimestamp(v.Get("timestamp"), v.Get("recvWindow")); apiErr != nil {
+		return nil, nil, apiErr
+	}
+	return acct, v, nil
+}
+
+func (s *Server) checkTimestamp(timestamp, recvWindow string) *apiError {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errMandatoryParam
+	}
+	window := int64(defaultRecvWindow)
+	if recvWindow != "" {
+		if window, err = strconv.ParseInt(recvWindow, 10, 64); err != nil {
+			return errMandatoryParam
+		}
+	}
+	now := s.ex.timestamp()
+	if ts > now+1000 || now-ts > window {
+		return errOutsideRecvWindow
+	}
+	return nil
+}
+
+func validSignature(secret, payload, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func newClientOrderId() string {
+	b := make([]byte, 11)
+	_, _ = rand.Read(b)
+	return "test" + hex.EncodeToString(b)
+}
+
+func (s *Server) handlePing(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (s *Server) handleTime(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]int64{"serverTime": s.ex.timestamp()})
+}
+
+func (s *Server) handleDepth(w http.ResponseWriter, r *http.Request) {
+	symbol := r.URL.Query().Get("symbol")
+	s.ex.mu.Lock()
+	_, ok := s.ex.symbols[symbol]
+	s.ex.mu.Unlock()
+	if !ok {
+		writeError(w, errInvalidSymbol)
+		return
+	}
+	bids, asks := s.ex.depth(symbol)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"lastUpdateId": s.ex.timestamp(),
+		"bids":         formatLevels(bids),
+		"asks":         formatLevels(asks),
+	})
+}
+
+func formatLevels(levels [][2]*big.Rat) [][]json.Number {
+	res := make([][]json.Number, 0, len(levels))
+	for _, l := range levels {
+		res = append(res, []json.Number{json.Number(formatRat(l[0])), json.Number(formatRat(l[1]))})
+	}
+	return res
+}
+
+func (s *Server) handleOrder(w http.ResponseWriter, r *http.Request) {
+	acct, v, apiErr := s.signed(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		req, apiErr := parsePlaceRequest(v)
+		if apiErr != nil {
+			writeError(w, apiErr)
+			return
+		}
+		o, apiErr := s.ex.place(acct, req)
+		if apiErr != nil {
+			writeError(w, apiErr)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.placeResult(o, v.Get("newOrderRespType")))
+	case http.MethodGet:
+		orderId, _ := strconv.ParseInt(v.Get("orderId"), 10, 64)
+		o, apiErr := s.ex.get(acct, v.Get("symbol"), orderId, v.Get("origClientOrderId"))
+		if apiErr != nil {
+			writeError(w, apiErr)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.orderJSON(o))
+	case http.MethodDelete:
+		orderId, _ := strconv.ParseInt(v.Get("orderId"), 10, 64)
+		o, apiErr := s.ex.cancel(acct, v.Get("symbol"), orderId, v.Get("origClientOrderId"))
+		if apiErr != nil {
+			writeError(w, apiErr)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.cancelJSON(o))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleOrderTest(w http.ResponseWriter, r *http.Request) {
+	_, v, apiErr := s.signed(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	if _, apiErr = parsePlaceRequest(v); apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (s *Server) handleOpenOrders(w http.ResponseWriter, r *http.Request) {
+	acct, v, apiErr := s.signed(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.ordersJSON(s.ex.openOrders(acct, v.Get("symbol"))))
+	case http.MethodDelete:
+		orders, apiErr := s.ex.cancelAll(acct, v.Get("symbol"))
+		if apiErr != nil {
+			writeError(w, apiErr)
+			return
+		}
+		res := make([]map[string]interface{}, 0, len(orders))
+		for _, o := range orders {
+			res = append(res, s.cancelJSON(o))
+		}
+		writeJSON(w, http.StatusOK, res)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleAllOrders(w http.ResponseWriter, r *http.Request) {
+	acct, v, apiErr := s.signed(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.ordersJSON(s.ex.allOrders(acct, v.Get("symbol"))))
+}
+
+func (s *Server) handleAccount(w http.ResponseWriter, r *http.Request) {
+	acct, _, apiErr := s.signed(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.accountJSON(acct))
+}
+
+func (s *Server) handleMyTrades(w http.ResponseWriter, r *http.Request) {
+	acct, v, apiErr := s.signed(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	trades := s.ex.myTrades(acct, v.Get("symbol"))
+	res := make([]map[string]interface{}, 0, len(trades))
+	for _, t := range trades {
+		res = append(res, map[string]interface{}{
+			"symbol":          t.symbol,
+			"id":              t.id,
+			"orderId":         t.orderId,
+			"orderListId":     -1,
+			"price":           formatRat(t.price),
+			"qty":             formatRat(t.qty),
+			"quoteQty":        formatRat(t.quoteQty),
+			"commission":      formatRat(nil),
+			"commissionAsset": t.asset,
+			"time":            t.time,
+			"isBuyer":         t.isBuyer,
+			"isMaker":         t.isMaker,
+			"isBestMatch":     true,
+		})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) handleUserDataStream(w http.ResponseWriter, r *http.Request) {
+	acct, apiErr := s.apiKeyAccount(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+	v, _, err := params(r)
+	if err != nil {
+		writeError(w, errMandatoryParam)
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		writeJSON(w, http.StatusOK, map[string]string{"listenKey": s.streams.listenKey(acct)})
+	case http.MethodPut:
+		if !s.streams.has(v.Get("listenKey")) {
+			writeError(w, errUnknownListenKey)
+			return
+		}
+		writeJSON(w, http.StatusOK, struct{}{})
+	case http.MethodDelete:
+		s.streams.closeKey(v.Get("listenKey"))
+		writeJSON(w, http.StatusOK, struct{}{})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func parsePlaceRequest(v url.Values) (*placeRequest, *apiError) {
+	req := &placeRequest{
+		symbol:        v.Get("symbol"),
+		side:          v.Get("side"),
+		orderType:     v.Get("type"),
+		timeInForce:   v.Get("timeInForce"),
+		clientOrderId: v.Get("newClientOrderId"),
+	}
+	if req.symbol == "" || req.side == "" || req.orderType == "" {
+		return nil, errMandatoryParam
+	}
+	var ok bool
+	if req.price, ok = parseRat(v.Get("price")); !ok {
+		return nil, errMandatoryParam
+	}
+	if req.quantity, ok = parseRat(v.Get("quantity")); !ok {
+		return nil, errMandatoryParam
+	}
+	if req.quoteOrderQty, ok = parseRat(v.Get("quoteOrderQty")); !ok {
+		return nil, errMandatoryParam
+	}
+	return req, nil
+}
+
+// placeResult renders a new order response in the requested verbosity.
+// LIMIT and MARKET orders default to FULL, like on Binance.
+func (s *Server) placeResult(o *order, respType string) map[string]interface{} {
+	s.ex.mu.Lock()
+	defer s.ex.mu.Unlock()
+	if respType == "" {
+		respType = "ACK"
+		if o.orderType == typeLimit || o.orderType == typeMarket {
+			respType = "FULL"
+		}
+	}
+	res := map[string]interface{}{
+		"symbol":        o.symbol.Name,
+		"orderId":       o.id,
+		"orderListId":   -1,
+		"clientOrderId": o.clientOrderId,
+		"transactTime":  o.time,
+	}
+	if respType == "ACK" {
+		return res
+	}
+	for k, v := range orderFields(o) {
+		switch k {
+		case "time", "updateTime", "isWorking", "origQuoteOrderQty":
+		default:
+			res[k] = v
+		}
+	}
+	res["cummulativeQuoteQty"] = formatRat(o.cumQuote)
+	delete(res, "cumulativeQuoteQty")
+	if respType == "FULL" {
+		fills := make([]map[string]interface{}, 0, len(o.fills))
+		for _, f := range o.fills {
+			fills = append(fills, map[string]interface{}{
+				"price":           formatRat(f.price),
+				"qty":             formatRat(f.qty),
+				"commission":      formatRat(nil),
+				"commissionAsset": f.commissionAsset,
+				"tradeId":         f.tradeId,
+			})
+		}
+		res["fills"] = fills
+	}
+	return res
+}
+
+func (s *Server) orderJSON(o *order) map[string]interface{} {
+	s.ex.mu.Lock()
+	defer s.ex.mu.Unlock()
+	return orderFields(o)
+}
+
+func orderFields(o *order) map[string]interface{} {
+	return map[string]interface{}{
+		"symbol":                  o.symbol.Name,
+		"orderId":                 o.id,
+		"orderListId":             -1,
+		"clientOrderId":           o.clientOrderId,
+		"price":                   formatRat(o.price),
+		"origQty":                 formatRat(o.origQty),
+		"executedQty":             formatRat(o.executedQty),
+		"cumulativeQuoteQty":      formatRat(o.cumQuote),
+		"status":                  o.status,
+		"timeInForce":             o.timeInForce,
+		"type":                    o.orderType,
+		"side":                    o.side,
+		"stopPrice":               formatRat(nil),
+		"time":                    o.time,
+		"updateTime":              o.updateTime,
+		"isWorking":               true,
+		"workingTime":             o.time,
+		"origQuoteOrderQty":       formatRat(o.quoteOrderQty),
+		"selfTradePreventionMode": "NONE",
+	}
+}
+
+func (s *Server) ordersJSON(orders []*order) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(orders))
+	for _, o := range orders {
+		res = append(res, s.orderJSON(o))
+	}
+	return res
+}
+
+func (s *Server) cancelJSON(o *order) map[string]interface{} {
+	res := s.orderJSON(o)
+	res["origClientOrderId"] = o.clientOrderId
+	return res
+}
+
+func (s *Server) accountJSON(acct *Account) map[string]interface{} {
+	s.ex.mu.Lock()
+	defer s.ex.mu.Unlock()
+	balances := make([]map[string]string, 0)
+	for _, b := range acct.balances() {
+		balances = append(balances, map[string]string{
+			"asset":  b.Asset,
+			"free":   formatRat(b.Free),
+			"locked": formatRat(b.Locked),
+		})
+	}
+	return map[string]interface{}{
+		"makerCommission":  0,
+		"takerCommission":  0,
+		"buyerCommission":  0,
+		"sellerCommission": 0,
+		"canTrade":         true,
+		"canWithdraw":      true,
+		"canDeposit":       true,
+		"updateTime":       s.ex.timestamp(),
+		"accountType":      "SPOT",
+		"balances":         balances,
+		"permissions":      []string{"SPOT"},
+	}
+}
+
+// streamHub fans user data events out to the websocket connections of each
+// listen key
+type streamHub struct {
+	mu    sync.Mutex
+	keys  map[string]*Account
+	conns map[string][]*wsConn
+}
+
+func newStreamHub() *streamHub {
+	return &streamHub{
+		keys:  make(map[string]*Account),
+		conns: make(map[string][]*wsConn),
+	}
+}
+
+func (h *streamHub) listenKey(acct *Account) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for k, a := range h.keys {
+		if a == acct {
+			return k
+		}
+	}
+	b := make([]byte, 30)
+	_, _ = rand.Read(b)
+	key := hex.EncodeToString(b)
+	h.keys[key] = acct
+	return key
+}
+
+func (h *streamHub) has(key string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, ok := h.keys[key]
+	return ok
+}
+
+func (h *streamHub) closeKey(key string) {
+	h.mu.Lock()
+	conns := h.conns[key]
+	delete(h.keys, key)
+	delete(h.conns, key)
+	h.mu.Unlock()
+	for _, c := range conns {
+		c.close()
+	}
+}
+
+func (h *streamHub) subscribe(key string, c *wsConn) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.keys[key]; !ok {
+		return false
+	}
+	h.conns[key] = append(h.conns[key], c)
+	return true
+}
+
+func (h *streamHub) unsubscribe(key string, c *wsConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	conns := h.conns[key]
+	for i, x := range conns {
+		if x == c {
+			h.conns[key] = append(conns[:i], conns[i+1:]...)
+			return
+		}
+	}
+}
+
+// publish is called by the exchange, with its lock held, for every batch of
+// order updates of an account. It does not wait on the connections.
+func (h *streamHub) publish(acct *Account, updates []orderUpdate, assets []string) {
+	h.mu.Lock()
+	var conns []*wsConn
+	for k, a := range h.keys {
+		if a == acct {
+			conns = append(conns, h.conns[k]...)
+		}
+	}
+	h.mu.Unlock()
+	if len(conns) == 0 {
+		return
+	}
+	var events [][]byte
+	for _, u := range updates {
+		events = append(events, executionReport(u))
+	}
+	events = append(events, accountPosition(acct, assets, updates[len(updates)-1].time))
+	for _, c := range conns {
+		for _, e := range events {
+			c.send(e)
+		}
+	}
+}
+
+func executionReport(u orderUpdate) []byte {
+	o := u.order
+	lastQty, lastPrice, lastQuote, tradeId, isMaker := formatRat(nil), formatRat(nil), formatRat(nil), int64(-1), false
+	if f := u.lastFill; f != nil {
+		lastQty, lastPrice = formatRat(f.qty), formatRat(f.price)
+		lastQuote = formatRat(new(big.Rat).Mul(f.qty, f.price))
+		tradeId, isMaker = f.tradeId, f.isMaker
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"e": "executionReport",
+		"E": u.time,
+		"s": o.symbol.Name,
+		"c": o.clientOrderId,
+		"S": o.side,
+		"o": o.orderType,
+		"f": o.timeInForce,
+		"q": formatRat(o.origQty),
+		"p": formatRat(o.price),
+		"P": formatRat(nil),
+		"F": formatRat(nil),
+		"g": -1,
+		"C": "",
+		"x": u.executionType,
+		"X": u.status,
+		"r": "NONE",
+		"i": o.id,
+		"l": lastQty,
+		"z": formatRat(u.executedQty),
+		"L": lastPrice,
+		"n": formatRat(nil),
+		"N": nil,
+		"T": u.time,
+		"t": tradeId,
+		"w": u.status == statusNew || u.status == statusPartiallyFilled,
+		"m": isMaker,
+		"O": o.time,
+		"Z": formatRat(u.cumQuote),
+		"Y": lastQuote,
+		"Q": formatRat(o.quoteOrderQty),
+		"W": o.time,
+		"V": "NONE",
+	})
+	return data
+}
+
+func accountPosition(acct *Account, assets []string, eventTime int64) []byte {
+	balances := make([]map[string]string, 0, len(assets))
+	for _, asset := range assets {
+		balances = append(balances, map[string]string{
+			"a": asset,
+			"f": formatRat(acct.bal(acct.free, asset)),
+			"l": formatRat(acct.bal(acct.locked, asset)),
+		})
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"e": "outboundAccountPosition",
+		"E": eventTime,
+		"u": eventTime,
+		"B": balances,
+	})
+	return data
+}
@@ -0,0 +1,154 @@
+package binancetest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+	"github.com/binance/binance-connector-go/binancetest"
+	"github.com/binance/binance-connector-go/handlers"
+	"github.com/stretchr/testify/suite"
+)
+
+type serverTestSuite struct {
+	suite.Suite
+	srv   *binancetest.Server
+	maker *binance_connector.Client
+	taker *binance_connector.Client
+}
+
+func TestServer(t *testing.T) {
+	suite.Run(t, new(serverTestSuite))
+}
+
+func (s *serverTestSuite) SetupTest() {
+	s.srv = binancetest.NewServer()
+	s.srv.AddSymbol("BTCUSDT", "BTC", "USDT")
+	s.srv.AddAccount("makerKey", "makerSecret").SetBalance("BTC", "2")
+	s.srv.AddAccount("takerKey", "takerSecret").SetBalance("USDT", "100000")
+	s.maker = binance_connector.NewClient("makerKey", "makerSecret", s.srv.URL)
+	s.taker = binance_connector.NewClient("takerKey", "takerSecret", s.srv.URL)
+}
+
+func (s *serverTestSuite) TearDownTest() {
+	s.srv.Close()
+}
+
+func (s *serverTestSuite) TestLimitOrderMatching() {
+	r := s.Require()
+	ctx := context.Background()
+
+	res, err := s.maker.NewCreateOrderService().Symbol("BTCUSDT").Side("SELL").Type("LIMIT").
+		TimeInForce("GTC").Price(30000).Quantity(1.5).Do(ctx)
+	r.NoError(err)
+	ask := res.(*binance_connector.CreateOrderResponseFULL)
+	r.Equal("NEW", ask.Status)
+
+	res, err = s.taker.NewCreateOrderService().Symbol("BTCUSDT").Side("BUY").Type("LIMIT").
+		TimeInForce("GTC").Price(31000).Quantity(1).Do(ctx)
+	r.NoError(err)
+	bid := res.(*binance_connector.CreateOrderResponseFULL)
+	r.Equal("FILLED", bid.Status)
+	r.Len(bid.Fills, 1)
+	r.Equal("30000.00000000", bid.Fills[0].Price)
+	r.Equal("30000.00000000", bid.CumulativeQuoteQty)
+
+	open, err := s.maker.NewGetOpenOrdersService().Symbol("BTCUSDT").Do(ctx)
+	r.NoError(err)
+	r.Len(open, 1)
+	r.Equal("PARTIALLY_FILLED", open[0].Status)
+
+	free, locked := s.srv.Balance("takerKey", "USDT")
+	r.Equal("70000.00000000", free)
+	r.Equal("0.00000000", locked)
+
+	cancel, err := s.maker.NewCancelOrderService().Symbol("BTCUSDT").OrderId(ask.OrderId).Do(ctx)
+	r.NoError(err)
+	r.Equal("CANCELED", cancel.Status)
+
+	account, err := s.maker.NewGetAccountService().Do(ctx)
+	r.NoError(err)
+	balances := make(map[string]string)
+	for _, b := range account.Balances {
+		balances[b.Asset] = b.Free
+	}
+	r.Equal("1.00000000", balances["BTC"])
+	r.Equal("30000.00000000", balances["USDT"])
+}
+
+func (s *serverTestSuite) TestAuthentication() {
+	r := s.Require()
+	ctx := context.Background()
+
+	_, err := binance_connector.NewClient("makerKey", "wrong", s.srv.URL).NewGetAccountService().Do(ctx)
+	r.Error(err)
+	apiErr, ok := err.(*handlers.APIError)
+	r.True(ok)
+	r.Equal(int64(-1022), apiErr.Code)
+
+	s.srv.Now = func() time.Time { return time.Now().Add(time.Minute) }
+	_, err = s.maker.NewGetAccountService().Do(ctx)
+	r.Error(err)
+	apiErr, ok = err.(*handlers.APIError)
+	r.True(ok)
+	r.Equal(int64(-1021), apiErr.Code)
+
+	res, err := http.Get(s.srv.URL + "/api/v3/ping")
+	r.NoError(err)
+	res.Body.Close()
+	r.Equal(http.StatusOK, res.StatusCode)
+}
+
+func (s *serverTestSuite) TestWebsocketAPIAndUserData() {
+	r := s.Require()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	listenKey, err := s.maker.NewCreateListenKeyService().Do(ctx)
+	r.NoError(err)
+	events := make(chan *binance_connector.WsUserDataEvent, 16)
+	_, stopCh, err := binance_connector.NewWebsocketStreamClient(false, s.srv.StreamURL()).
+		WsUserDataServe(listenKey, func(event *binance_connector.WsUserDataEvent) {
+			events <- event
+		}, func(err error) {})
+	r.NoError(err)
+	defer close(stopCh)
+
+	_, err = s.maker.NewCreateOrderService().Symbol("BTCUSDT").Side("SELL").Type("LIMIT").
+		TimeInForce("GTC").Price(30000).Quantity(1).Do(ctx)
+	r.NoError(err)
+
+	wsAPI := binance_connector.NewWebsocketAPIClient("takerKey", "takerSecret", s.srv.WebsocketAPIURL())
+	r.NoError(wsAPI.Connect())
+	defer wsAPI.Close()
+	placed, err := wsAPI.NewPlaceNewOrderService().Symbol("BTCUSDT").Side("BUY").OrderType("MARKET").
+		Quantity(0.4).Do(ctx)
+	r.NoError(err)
+	r.Nil(placed.Error)
+	r.Equal("FILLED", placed.Result.Status)
+	r.Equal("12000.00000000", placed.Result.CummulativeQuoteQty)
+
+	var reports []binance_connector.WsOrderUpdate
+	var positions []binance_connector.WsAccountUpdate
+	for len(reports) < 2 {
+		select {
+		case event := <-events:
+			switch event.Event {
+			case binance_connector.UserDataEventTypeExecutionReport:
+				reports = append(reports, event.OrderUpdate)
+			case binance_connector.UserDataEventTypeOutboundAccountPosition:
+				positions = append(positions, event.AccountUpdate.WsAccountUpdates...)
+			}
+		case <-ctx.Done():
+			r.FailNow("timed out waiting for user data events")
+		}
+	}
+	r.Equal("NEW", reports[0].ExecutionType)
+	r.Equal("TRADE", reports[1].ExecutionType)
+	r.Equal("PARTIALLY_FILLED", reports[1].Status)
+	r.Equal("0.40000000", reports[1].LatestVolume)
+	r.True(reports[1].IsMaker)
+	r.NotEmpty(positions)
+}
@@ -0,0 +1,272 @@
+package binancetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// wsConn serialises writes to a websocket connection. Messages are queued so
+// that the exchange can publish events without waiting on the network, and a
+// connection whose queue is full is closed, as Binance disconnects the
+// clients that do not keep up with their stream.
+type wsConn struct {
+	conn *websocket.Conn
+	out  chan []byte
+	once sync.Once
+	done chan struct{}
+}
+
+func newWsConn(conn *websocket.Conn) *wsConn {
+	c := &wsConn{
+		conn: conn,
+		out:  make(chan []byte, 256),
+		done: make(chan struct{}),
+	}
+	go c.writeLoop()
+	return c
+}
+
+func (c *wsConn) writeLoop() {
+	for {
+		select {
+		case msg := <-c.out:
+			if err := c.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				c.close()
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// send queues msg without blocking, closing the connection when its queue is
+// full
+func (c *wsConn) send(msg []byte) {
+	select {
+	case c.out <- msg:
+	case <-c.done:
+	default:
+		c.close()
+	}
+}
+
+func (c *wsConn) close() {
+	c.once.Do(func() {
+		close(c.done)
+		c.conn.Close()
+	})
+}
+
+// handleUserDataWebsocket serves /ws/<listenKey>
+func (s *Server) handleUserDataWebsocket(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/ws/")
+	if !s.streams.has(key) {
+		writeError(w, errUnknownListenKey)
+		return
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := newWsConn(conn)
+	if !s.streams.subscribe(key, c) {
+		c.close()
+		return
+	}
+	defer s.streams.unsubscribe(key, c)
+	defer c.close()
+	// drain control frames until the client goes away
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+type wsRequest struct {
+	ID     string                     `json:"id"`
+	Method string                     `json:"method"`
+	Params map[string]json.RawMessage `json:"params"`
+}
+
+type wsResponse struct {
+	ID         string        `json:"id"`
+	Status     int           `json:"status"`
+	Result     interface{}   `json:"result,omitempty"`
+	Error      *apiError     `json:"error,omitempty"`
+	RateLimits []interface{} `json:"rateLimits"`
+}
+
+// handleWebsocketAPI serves the Websocket API at /ws-api/v3
+func (s *Server) handleWebsocketAPI(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := newWsConn(conn)
+	defer c.close()
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req wsRequest
+		if err = json.Unmarshal(data, &req); err != nil {
+			s.reply(c, "", nil, newAPIError(-1100, "Illegal characters found in parameter."))
+			continue
+		}
+		result, apiErr := s.dispatch(&req)
+		s.reply(c, req.ID, result, apiErr)
+	}
+}
+
+func (s *Server) reply(c *wsConn, id string, result interface{}, apiErr *apiError) {
+	res := wsResponse{ID: id, Status: http.StatusOK, Result: result, RateLimits: []interface{}{}}
+	if apiErr != nil {
+		res.Status, res.Result, res.Error = apiErr.status, nil, apiErr
+	}
+	data, _ := json.Marshal(res)
+	c.send(data)
+}
+
+func (s *Server) dispatch(req *wsRequest) (interface{}, *apiError) {
+	v, apiErr := wsParams(req.Params)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	switch req.Method {
+	case "ping":
+		return struct{}{}, nil
+	case "time":
+		return map[string]int64{"serverTime": s.ex.timestamp()}, nil
+	case "userDataStream.start", "userDataStream.ping", "userDataStream.stop", "userDataStream.close":
+		return s.wsUserDataStream(req.Method, v)
+	}
+
+	acct, apiErr := s.wsSigned(v)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	switch req.Method {
+	case "order.place":
+		preq, apiErr := parsePlaceRequest(v)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		o, apiErr := s.ex.place(acct, preq)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		return s.placeResult(o, v.Get("newOrderRespType")), nil
+	case "order.test":
+		if _, apiErr = parsePlaceRequest(v); apiErr != nil {
+			return nil, apiErr
+		}
+		return struct{}{}, nil
+	case "order.status":
+		orderId, _ := strconv.ParseInt(v.Get("orderId"), 10, 64)
+		o, apiErr := s.ex.get(acct, v.Get("symbol"), orderId, v.Get("origClientOrderId"))
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		return s.orderJSON(o), nil
+	case "order.cancel":
+		orderId, _ := strconv.ParseInt(v.Get("orderId"), 10, 64)
+		o, apiErr := s.ex.cancel(acct, v.Get("symbol"), orderId, v.Get("origClientOrderId"))
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		return s.cancelJSON(o), nil
+	case "openOrders.status":
+		return s.ordersJSON(s.ex.openOrders(acct, v.Get("symbol"))), nil
+	case "allOrders":
+		return s.ordersJSON(s.ex.allOrders(acct, v.Get("symbol"))), nil
+	case "account.status":
+		return s.accountJSON(acct), nil
+	}
+	return nil, newAPIError(-1100, fmt.Sprintf("Unknown method %q.", req.Method))
+}
+
+// wsParams flattens request parameters to strings, the form they are signed in
+func wsParams(params map[string]json.RawMessage) (url.Values, *apiError) {
+	v := url.Values{}
+	for k, raw := range params {
+		var str string
+		if err := json.Unmarshal(raw, &str); err == nil {
+			v.Set(k, str)
+			continue
+		}
+		var num json.Number
+		if err := json.Unmarshal(raw, &num); err != nil {
+			return nil, errMandatoryParam
+		}
+		v.Set(k, num.String())
+	}
+	return v, nil
+}
+
+// wsSigned authenticates a signed Websocket API request. The signature covers
+// every other parameter, sorted by name.
+func (s *Server) wsSigned(v url.Values) (*Account, *apiError) {
+	s.ex.mu.Lock()
+	acct, ok := s.ex.accounts[v.Get("apiKey")]
+	s.ex.mu.Unlock()
+	if !ok {
+		return nil, errInvalidAPIKey
+	}
+	signature := v.Get("signature")
+	if signature == "" {
+		return nil, errMandatoryParam
+	}
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		if k != "signature" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+v.Get(k))
+	}
+	if !validSignature(acct.SecretKey, strings.Join(parts, "&"), signature) {
+		return nil, errInvalidSignature
+	}
+	if apiErr := s.checkTimestamp(v.Get("timestamp"), v.Get("recvWindow")); apiErr != nil {
+		return nil, apiErr
+	}
+	return acct, nil
+}
+
+func (s *Server) wsUserDataStream(method string, v url.Values) (interface{}, *apiError) {
+	s.ex.mu.Lock()
+	acct, ok := s.ex.accounts[v.Get("apiKey")]
+	s.ex.mu.Unlock()
+	if !ok {
+		return nil, errInvalidAPIKey
+	}
+	switch method {
+	case "userDataStream.start":
+		return map[string]string{"listenKey": s.streams.listenKey(acct)}, nil
+	case "userDataStream.ping":
+		if !s.streams.has(v.Get("listenKey")) {
+			return nil, errUnknownListenKey
+		}
+	default:
+		s.streams.closeKey(v.Get("listenKey"))
+	}
+	return struct{}{}, nil
+}
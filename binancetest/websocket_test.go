@@ -0,0 +1,57 @@
+package binancetest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type wsConnTestSuite struct {
+	suite.Suite
+}
+
+func TestWsConn(t *testing.T) {
+	suite.Run(t, new(wsConnTestSuite))
+}
+
+func (s *wsConnTestSuite) TestSendDoesNotBlock() {
+	r := s.Require()
+	conns := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		conns <- conn
+	}))
+	defer srv.Close()
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	r.NoError(err)
+	defer client.Close()
+
+	// a client that does not read, with the queue of the connection full
+	c := &wsConn{conn: <-conns, out: make(chan []byte, 2), done: make(chan struct{})}
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 3; i++ {
+			c.send([]byte(`{}`))
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		r.FailNow("send blocked on a full queue")
+	}
+	select {
+	case <-c.done:
+	default:
+		r.Fail("the connection of the slow client is not closed")
+	}
+	c.send([]byte(`{}`))
+}
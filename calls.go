@@ -0,0 +1,1561 @@
+package binance_connector
+
+import "context"
+
+// The methods below send a request, a service built with new and its setters
+// such as new(CreateOrderService).Symbol("BTCUSDT"), with the client and
+// return the response. They implement the domain interfaces of interfaces.go.
+// The client a service was created with, if any, is not used.
+
+// Calls of SpotTradingAPI:
+func (c *Client) TestNewOrder(ctx context.Context, req *TestNewOrder, opts ...RequestOption) (*AccountOrderBookResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CreateOrder(ctx context.Context, req *CreateOrderService, opts ...RequestOption) (interface{}, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CancelOrder(ctx context.Context, req *CancelOrderService, opts ...RequestOption) (*CancelOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CancelOpenOrders(ctx context.Context, req *CancelOpenOrdersService, opts ...RequestOption) ([]*CancelOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetOrder(ctx context.Context, req *GetOrderService, opts ...RequestOption) (*GetOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CancelReplace(ctx context.Context, req *CancelReplaceService, opts ...RequestOption) (*CancelReplaceResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetOpenOrders(ctx context.Context, req *GetOpenOrdersService, opts ...RequestOption) ([]*NewOpenOrdersResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetAllOrders(ctx context.Context, req *GetAllOrdersService, opts ...RequestOption) ([]*NewAllOrdersResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) NewOCO(ctx context.Context, req *NewOCOService, opts ...RequestOption) (*OrderOCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CancelOCO(ctx context.Context, req *CancelOCOService, opts ...RequestOption) (*OrderOCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryOCO(ctx context.Context, req *QueryOCOService, opts ...RequestOption) (*OCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryAllOCO(ctx context.Context, req *QueryAllOCOService, opts ...RequestOption) ([]*OCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryOpenOCO(ctx context.Context, req *QueryOpenOCOService, opts ...RequestOption) (*OCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetAccount(ctx context.Context, req *GetAccountService, opts ...RequestOption) (*AccountResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetMyTrades(ctx context.Context, req *GetMyTradesService, opts ...RequestOption) ([]*AccountTradeListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetQueryCurrentOrderCountUsage(ctx context.Context, req *GetQueryCurrentOrderCountUsageService, opts ...RequestOption) ([]*QueryCurrentOrderCountUsageResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetQueryPreventedMatches(ctx context.Context, req *GetQueryPreventedMatchesService, opts ...RequestOption) (*QueryPreventedMatchesResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SOROrder(ctx context.Context, req *SOROrderService, opts ...RequestOption) (*SOROrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) TestSOROrder(ctx context.Context, req *TestSOROrderService, opts ...RequestOption) (*TestSOROrderResponse, error) {
+	s := *req
+	s.order.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MyAllocations(ctx context.Context, req *MyAllocationsService, opts ...RequestOption) ([]*AllocationResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) OrderListOCO(ctx context.Context, req *OrderListOCOService, opts ...RequestOption) (*OrderListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) OrderListOTO(ctx context.Context, req *OrderListOTOService, opts ...RequestOption) (*OrderListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) OrderListOTOCO(ctx context.Context, req *OrderListOTOCOService, opts ...RequestOption) (*OrderListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AmendOrderKeepPriority(ctx context.Context, req *AmendOrderKeepPriorityService, opts ...RequestOption) (*AmendOrderKeepPriorityResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryOrderAmendments(ctx context.Context, req *QueryOrderAmendmentsService, opts ...RequestOption) ([]*OrderAmendment, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetAccountCommission(ctx context.Context, req *GetAccountCommissionService, opts ...RequestOption) (*AccountCommissionResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+// Calls of MarketDataAPI:
+func (c *Client) Ping(ctx context.Context, req *Ping, opts ...RequestOption) error {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ServerTime(ctx context.Context, req *ServerTime, opts ...RequestOption) (*ServerTimeResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ExchangeInfo(ctx context.Context, req *ExchangeInfo, opts ...RequestOption) (*ExchangeInfoResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) OrderBook(ctx context.Context, req *OrderBook, opts ...RequestOption) (*OrderBookResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) RecentTradesList(ctx context.Context, req *RecentTradesList, opts ...RequestOption) ([]*RecentTradesListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) HistoricalTradeLookup(ctx context.Context, req *HistoricalTradeLookup, opts ...RequestOption) ([]*RecentTradesListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AggTradesList(ctx context.Context, req *AggTradesList, opts ...RequestOption) ([]*AggTradesListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) Klines(ctx context.Context, req *Klines, opts ...RequestOption) ([]*KlinesResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) UIKlines(ctx context.Context, req *UiKlines, opts ...RequestOption) ([]*UiKlinesResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AvgPrice(ctx context.Context, req *AvgPrice, opts ...RequestOption) (*AvgPriceResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) Ticker24hr(ctx context.Context, req *Ticker24hr, opts ...RequestOption) (*Ticker24hrResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) TickerPrice(ctx context.Context, req *TickerPrice, opts ...RequestOption) (*TickerPriceResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) TickerPrices(ctx context.Context, req *TickerPrices, opts ...RequestOption) ([]*TickerPriceResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) TickerBookTicker(ctx context.Context, req *TickerBookTicker, opts ...RequestOption) ([]*TickerBookTickerResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) Ticker(ctx context.Context, req *Ticker, opts ...RequestOption) (*TickerResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+// Calls of WalletAPI:
+func (c *Client) GetSystemStatus(ctx context.Context, req *GetSystemStatusService, opts ...RequestOption) ([]*SystemStatusResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetAllCoinsInfo(ctx context.Context, req *GetAllCoinsInfoService) ([]*CoinInfo, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) GetAccountSnapshot(ctx context.Context, req *GetAccountSnapshotService) (*AccountSnapshotResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) DisableFastWithdrawSwitch(ctx context.Context, req *DisableFastWithdrawSwitchService) (*DisableFastWithdrawSwitchResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) EnableFastWithdrawSwitch(ctx context.Context, req *EnableFastWithdrawSwitchService) (*EnableFastWithdrawSwitchResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) Withdraw(ctx context.Context, req *WithdrawService) (*WithdrawResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) DepositHistory(ctx context.Context, req *DepositHistoryService) ([]*DepositHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) WithdrawHistory(ctx context.Context, req *WithdrawHistoryService) ([]*WithdrawHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) DepositAddress(ctx context.Context, req *DepositAddressService) (*DepositAddressResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) AccountStatus(ctx context.Context, req *AccountStatusService) (*AccountStatusResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) AccountApiTradingStatus(ctx context.Context, req *AccountApiTradingStatusService) (*AccountApiTradingStatusResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) DustLog(ctx context.Context, req *DustLogService) (*DustLogResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) AssetDetail(ctx context.Context, req *AssetDetailService) (*AssetDetailResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) DustTransfer(ctx context.Context, req *DustTransferService) (*DustTransferResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) AssetDividendRecord(ctx context.Context, req *AssetDividendRecordService) (*AssetDividendRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) AssetDetailV2(ctx context.Context, req *AssetDetailV2Service) (*AssetDetailV2Response, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) TradeFee(ctx context.Context, req *TradeFeeService) ([]*TradeFeeResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) UserUniversalTransfer(ctx context.Context, req *UserUniversalTransferService) (*UserUniversalTransferResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) UserUniversalTransferHistory(ctx context.Context, req *UserUniversalTransferHistoryService) (*UserUniversalTransferHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) FundingWallet(ctx context.Context, req *FundingWalletService) ([]*FundingWalletResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) UserAsset(ctx context.Context, req *UserAssetService) ([]*UserAssetResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) BUSDConvert(ctx context.Context, req *BUSDConvertService) (*BUSDConvertResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) BUSDConvertHistory(ctx context.Context, req *BUSDConvertHistoryService) (*BUSDConvertHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) CloudMiningPaymentHistory(ctx context.Context, req *CloudMiningPaymentHistoryService) (*CloudMiningPaymentHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) APIKeyPermission(ctx context.Context, req *APIKeyPermissionService) (*APIKeyPermissionResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+func (c *Client) AutoConvertStableCoin(ctx context.Context, req *AutoConvertStableCoinService) (*AutoConvertStableCoinResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+// Calls of MarginAPI:
+func (c *Client) GetAllMarginAssets(ctx context.Context, req *GetAllMarginAssetsService, opts ...RequestOption) ([]*GetAllMarginAssetsResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetAllMarginPairs(ctx context.Context, req *GetAllMarginPairsService, opts ...RequestOption) ([]*GetAllMarginPairsResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryMarginPriceIndex(ctx context.Context, req *QueryMarginPriceIndexService, opts ...RequestOption) (*QueryMarginPriceIndexResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountNewOrder(ctx context.Context, req *MarginAccountNewOrderService, opts ...RequestOption) (interface{}, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountCancelOrder(ctx context.Context, req *MarginAccountCancelOrderService, opts ...RequestOption) (*MarginAccountCancelOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountCancelAllOrders(ctx context.Context, req *MarginAccountCancelAllOrdersService, opts ...RequestOption) (*MarginAccountCancelAllOrdersResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CrossMarginTransferHistory(ctx context.Context, req *CrossMarginTransferHistoryService, opts ...RequestOption) (*CrossMarginTransferHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) InterestHistory(ctx context.Context, req *InterestHistoryService, opts ...RequestOption) (*InterestHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ForceLiquidationRecord(ctx context.Context, req *ForceLiquidationRecordService, opts ...RequestOption) (*ForceLiquidationRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CrossMarginAccountDetail(ctx context.Context, req *CrossMarginAccountDetailService, opts ...RequestOption) (*CrossMarginAccountDetailResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountOrder(ctx context.Context, req *MarginAccountOrderService, opts ...RequestOption) (*MarginAccountOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountOpenOrder(ctx context.Context, req *MarginAccountOpenOrderService, opts ...RequestOption) ([]*MarginAccountOpenOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountAllOrder(ctx context.Context, req *MarginAccountAllOrderService, opts ...RequestOption) ([]*MarginAccountAllOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountNewOCO(ctx context.Context, req *MarginAccountNewOCOService, opts ...RequestOption) (*MarginAccountNewOCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountCancelOCO(ctx context.Context, req *MarginAccountCancelOCOService, opts ...RequestOption) (*MarginAccountCancelOCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountQueryOCO(ctx context.Context, req *MarginAccountQueryOCOService, opts ...RequestOption) (*MarginAccountQueryOCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountQueryAllOCO(ctx context.Context, req *MarginAccountQueryAllOCOService, opts ...RequestOption) ([]*MarginAccountQueryAllOCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountQueryOpenOCO(ctx context.Context, req *MarginAccountQueryOpenOCOService, opts ...RequestOption) ([]*MarginAccountQueryOpenOCOResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountQueryTradeList(ctx context.Context, req *MarginAccountQueryTradeListService, opts ...RequestOption) ([]*MarginAccountQueryTradeListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountQueryMaxBorrow(ctx context.Context, req *MarginAccountQueryMaxBorrowService, opts ...RequestOption) (*MarginAccountQueryMaxBorrowResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountQueryMaxTransferOutAmount(ctx context.Context, req *MarginAccountQueryMaxTransferOutAmountService, opts ...RequestOption) (*MarginAccountQueryMaxTransferOutAmountResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginAccountSummary(ctx context.Context, req *MarginAccountSummaryService, opts ...RequestOption) (*MarginAccountSummaryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginIsolatedAccountInfo(ctx context.Context, req *MarginIsolatedAccountInfoService, opts ...RequestOption) (interface{}, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginIsolatedAccountDisable(ctx context.Context, req *MarginIsolatedAccountDisableService, opts ...RequestOption) (*MarginIsolatedAccountDisableResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginIsolatedAccountEnable(ctx context.Context, req *MarginIsolatedAccountEnableService, opts ...RequestOption) (*MarginIsolatedAccountEnableResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginIsolatedAccountLimit(ctx context.Context, req *MarginIsolatedAccountLimitService, opts ...RequestOption) (*MarginIsolatedAccountLimitResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AllIsolatedMarginSymbol(ctx context.Context, req *AllIsolatedMarginSymbolService, opts ...RequestOption) ([]*MarginIsolatedSymbolResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginToggleBnbBurn(ctx context.Context, req *MarginToggleBnbBurnService, opts ...RequestOption) (*MarginToggleBnbBurnResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginBnbBurnStatus(ctx context.Context, req *MarginBnbBurnStatusService, opts ...RequestOption) (*MarginBnbBurnStatusResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginInterestRateHistory(ctx context.Context, req *MarginInterestRateHistoryService, opts ...RequestOption) ([]*MarginInterestRateHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginCrossMarginFee(ctx context.Context, req *MarginCrossMarginFeeService, opts ...RequestOption) ([]*MarginCrossMarginFeeResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginIsolatedMarginFee(ctx context.Context, req *MarginIsolatedMarginFeeService, opts ...RequestOption) ([]*MarginIsolatedMarginFeeResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginIsolatedMarginTier(ctx context.Context, req *MarginIsolatedMarginTierService, opts ...RequestOption) ([]*MarginIsolatedMarginTierResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginCurrentOrderCount(ctx context.Context, req *MarginCurrentOrderCountService, opts ...RequestOption) ([]*MarginCurrentOrderCountResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginCrossCollateralRatio(ctx context.Context, req *MarginCrossCollateralRatioService, opts ...RequestOption) ([]*MarginCrossCollateralRatioResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginSmallLiabilityExchangeCoinList(ctx context.Context, req *MarginSmallLiabilityExchangeCoinListService, opts ...RequestOption) ([]*MarginSmallLiabilityExchangeCoinListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginSmallLiabilityExchange(ctx context.Context, req *MarginSmallLiabilityExchangeService, opts ...RequestOption) ([]*MarginSmallLiabilityExchangeResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginSmallLiabilityExchangeHistory(ctx context.Context, req *MarginSmallLiabilityExchangeHistoryService, opts ...RequestOption) ([]*MarginSmallLiabilityExchangeHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginBorrowRepay(ctx context.Context, req *MarginBorrowRepayService, opts ...RequestOption) (*MarginBorrowRepayResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginBorrowRepayRecord(ctx context.Context, req *MarginBorrowRepayRecordService, opts ...RequestOption) (*MarginBorrowRepayRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CrossMarginTransfer(ctx context.Context, req *CrossMarginTransferService, opts ...RequestOption) (*CrossMarginTransferResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) IsolatedMarginTransfer(ctx context.Context, req *IsolatedMarginTransferService) (*UserUniversalTransferResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx)
+}
+
+// Calls of SubAccountAPI:
+func (c *Client) CreateSubAccount(ctx context.Context, req *CreateSubAccountService, opts ...RequestOption) (*CreateSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QuerySubAccountList(ctx context.Context, req *QuerySubAccountListService, opts ...RequestOption) (*SubAccountListResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QuerySubAccountSpotAssetTransferHistory(ctx context.Context, req *QuerySubAccountSpotAssetTransferHistoryService, opts ...RequestOption) (*QuerySubAccountSpotAssetTransferHistoryResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QuerySubAccountFuturesAssetTransferHistory(ctx context.Context, req *QuerySubAccountFuturesAssetTransferHistoryService, opts ...RequestOption) (*QuerySubAccountFuturesAssetTransferHistoryResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SubAccountFuturesAssetTransfer(ctx context.Context, req *SubAccountFuturesAssetTransferService, opts ...RequestOption) (*SubAccountFuturesAssetTransferResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QuerySubAccountAssets(ctx context.Context, req *QuerySubAccountAssetsService, opts ...RequestOption) (*QuerySubAccountAssetsResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QuerySubAccountSpotAssetsSummary(ctx context.Context, req *QuerySubAccountSpotAssetsSummaryService, opts ...RequestOption) (*QuerySubAccountSpotAssetsSummaryResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetSubAccountDepositAddress(ctx context.Context, req *GetSubAccountDepositAddressService, opts ...RequestOption) (*GetSubAccountDepositAddressResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetSubAccountDepositHistory(ctx context.Context, req *GetSubAccountDepositHistoryService, opts ...RequestOption) (*GetSubAccountDepositHistoryResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetSubAccountStatus(ctx context.Context, req *GetSubAccountStatusService, opts ...RequestOption) (*GetSubAccountStatusResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) EnableMarginForSubAccount(ctx context.Context, req *EnableMarginForSubAccountService, opts ...RequestOption) (*EnableMarginForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetDetailOnSubAccountMarginAccount(ctx context.Context, req *GetDetailOnSubAccountMarginAccountService, opts ...RequestOption) (*GetDetailOnSubAccountMarginAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetSummaryOfSubAccountMarginAccount(ctx context.Context, req *GetSummaryOfSubAccountMarginAccountService, opts ...RequestOption) (*GetSummaryOfSubAccountMarginAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) EnableFuturesForSubAccount(ctx context.Context, req *EnableFuturesForSubAccountService, opts ...RequestOption) (*EnableFuturesForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetDetailOnSubAccountFuturesAccount(ctx context.Context, req *GetDetailOnSubAccountFuturesAccountService, opts ...RequestOption) (*GetDetailOnSubAccountFuturesAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetSummaryOfSubAccountFuturesAccount(ctx context.Context, req *GetSummaryOfSubAccountFuturesAccountService, opts ...RequestOption) (*GetSummaryOfSubAccountFuturesAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetFuturesPositionRiskOfSubAccount(ctx context.Context, req *GetFuturesPositionRiskOfSubAccountService, opts ...RequestOption) (*GetFuturesPositionRiskOfSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) FuturesTransferForSubAccount(ctx context.Context, req *FuturesTransferForSubAccountService, opts ...RequestOption) (*FuturesTransferForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MarginTransferForSubAccount(ctx context.Context, req *MarginTransferForSubAccountService, opts ...RequestOption) (*MarginTransferForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) TransferToSubAccountOfSameMaster(ctx context.Context, req *TransferToSubAccountOfSameMasterService, opts ...RequestOption) (*TransferToSubAccountOfSameMasterResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) TransferToMaster(ctx context.Context, req *TransferToMasterService, opts ...RequestOption) (*TransferToMasterResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SubAccountTransferHistory(ctx context.Context, req *SubAccountTransferHistoryService, opts ...RequestOption) (*SubAccountTransferHistoryResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) UniversalTransfer(ctx context.Context, req *UniversalTransferService, opts ...RequestOption) (*UniversalTransferResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryUniversalTransferHistory(ctx context.Context, req *QueryUniversalTransferHistoryService, opts ...RequestOption) (QueryUniversalTransferHistoryResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetDetailOnSubAccountFuturesAccountV2(ctx context.Context, req *GetDetailOnSubAccountFuturesAccountV2Service, opts ...RequestOption) (interface{}, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetSummaryOfSubAccountFuturesAccountV2(ctx context.Context, req *GetSummaryOfSubAccountFuturesAccountV2Service, opts ...RequestOption) (interface{}, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetFuturesPositionRiskOfSubAccountV2(ctx context.Context, req *GetFuturesPositionRiskOfSubAccountV2Service, opts ...RequestOption) (interface{}, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) EnableLeverageTokenForSubAccount(ctx context.Context, req *EnableLeverageTokenForSubAccountService, opts ...RequestOption) (*EnableLeverageTokenForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetIPRestrictionForSubAccountAPIKey(ctx context.Context, req *GetIPRestrictionForSubAccountAPIKeyService, opts ...RequestOption) (*GetIPRestrictionForSubAccountAPIKeyResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) DeleteIPListForSubAccountAPIKey(ctx context.Context, req *DeleteIPListForSubAccountAPIKeyService, opts ...RequestOption) (*DeleteIPListForSubAccountAPIKeyResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) UpdateIPRestrictionForSubAccountAPIKey(ctx context.Context, req *UpdateIPRestrictionForSubAccountAPIKeyService, opts ...RequestOption) (*UpdateIPRestrictionForSubAccountAPIKeyResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) DepositAssetsIntoManagedSubAccount(ctx context.Context, req *DepositAssetsIntoTheManagedSubAccountService, opts ...RequestOption) (*DepositAssetsIntoTheManagedSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryManagedSubAccountAssetDetails(ctx context.Context, req *QueryManagedSubAccountAssetDetailsService, opts ...RequestOption) (*QueryManagedSubAccountAssetDetailsResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) WithdrawAssetsFromTheManagedSubAccount(ctx context.Context, req *WithdrawAssetsFromTheManagedSubAccountService, opts ...RequestOption) (*WithdrawAssetsFromTheManagedSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryManagedSubAccountSnapshot(ctx context.Context, req *QueryManagedSubAccountSnapshotService, opts ...RequestOption) (*QueryManagedSubAccountSnapshotResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryManagedSubAccountTransferLog(ctx context.Context, req *QueryManagedSubAccountTransferLogService, opts ...RequestOption) (*QueryManagedSubAccountTransferLogResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryManagedSubAccountFuturesAssetDetails(ctx context.Context, req *QueryManagedSubAccountFuturesAssetDetailsService, opts ...RequestOption) (*QueryManagedSubAccountFuturesAssetDetailsResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryManagedSubAccountMarginAssetDetails(ctx context.Context, req *QueryManagedSubAccountMarginAssetDetailsService, opts ...RequestOption) (*QueryManagedSubAccountMarginAssetDetailsResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryManagedSubAccountTransferLogForTradingTeam(ctx context.Context, req *QueryManagedSubAccountTransferLogForTradingTeamService, opts ...RequestOption) (*QueryManagedSubAccountTransferLogForTradingTeamResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QuerySubAccountAssetsForMasterAccount(ctx context.Context, req *QuerySubAccountAssetsForMasterAccountService, opts ...RequestOption) (*QuerySubAccountAssetsForMasterAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryManagedSubAccountList(ctx context.Context, req *QueryManagedSubAccountList, opts ...RequestOption) (*QueryManagedSubAccountListResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QuerySubAccountTransactionTatistics(ctx context.Context, req *QuerySubAccountTransactionTatistics, opts ...RequestOption) (*QuerySubAccountTransactionTatisticsResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetManagedSubAccountDepositAddress(ctx context.Context, req *GetManagedSubAccountDepositAddressService, opts ...RequestOption) (*GetManagedSubAccountDepositAddressResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) EnableOptionsForSubAccount(ctx context.Context, req *EnableOptionsForSubAccountService, opts ...RequestOption) (*EnableOptionsForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) MoveFuturesPositionForSubAccount(ctx context.Context, req *MoveFuturesPositionForSubAccountService, opts ...RequestOption) (*MoveFuturesPositionForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) QueryMoveFuturesPositionHistoryForSubAccount(ctx context.Context, req *QueryMoveFuturesPositionHistoryForSubAccountService, opts ...RequestOption) (*QueryMoveFuturesPositionHistoryForSubAccountResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) GetIPRestrictionThirdPartyListForSubAccountAPIKey(ctx context.Context, req *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService, opts ...RequestOption) ([]*GetIPRestrictionThirdPartyListForSubAccountAPIKeyResp, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+// Calls of UserStreamAPI:
+func (c *Client) CreateListenKey(ctx context.Context, req *CreateListenKey, opts ...RequestOption) (string, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) PingUserStream(ctx context.Context, req *PingUserStream, opts ...RequestOption) error {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) CloseUserStream(ctx context.Context, req *CloseUserStream, opts ...RequestOption) error {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+// Calls of ConvertAPI:
+func (c *Client) ConvertExchangeInfo(ctx context.Context, req *ConvertExchangeInfoService, opts ...RequestOption) ([]*ConvertExchangeInfoResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertAssetInfo(ctx context.Context, req *ConvertAssetInfoService, opts ...RequestOption) ([]*ConvertAssetInfoResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertGetQuote(ctx context.Context, req *ConvertGetQuoteService, opts ...RequestOption) (*ConvertQuoteResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertAcceptQuote(ctx context.Context, req *ConvertAcceptQuoteService, opts ...RequestOption) (*ConvertAcceptQuoteResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertOrderStatus(ctx context.Context, req *ConvertOrderStatusService, opts ...RequestOption) (*ConvertOrderStatusResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertTradeFlow(ctx context.Context, req *ConvertTradeFlowService, opts ...RequestOption) (*ConvertTradeFlowResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertLimitPlaceOrder(ctx context.Context, req *ConvertLimitPlaceOrderService, opts ...RequestOption) (*ConvertLimitOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertLimitCancelOrder(ctx context.Context, req *ConvertLimitCancelOrderService, opts ...RequestOption) (*ConvertLimitOrderResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) ConvertLimitQueryOpenOrders(ctx context.Context, req *ConvertLimitQueryOpenOrdersService, opts ...RequestOption) (*ConvertLimitOpenOrdersResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) Convert(ctx context.Context, req *ConvertService, opts ...RequestOption) (*ConvertResult, error) {
+	s := c.NewConvertService()
+	s.quote = req.quote
+	s.quote.c = c
+	// settings left at zero keep the defaults of NewConvertService
+	if req.maxQuotes > 0 {
+		s.maxQuotes = req.maxQuotes
+	}
+	if req.expiryMargin > 0 {
+		s.expiryMargin = req.expiryMargin
+	}
+	if req.pollInterval > 0 {
+		s.pollInterval = req.pollInterval
+	}
+	return s.Do(ctx, opts...)
+}
+
+// Calls of SimpleEarnAPI:
+func (c *Client) SimpleEarnFlexibleProductList(ctx context.Context, req *SimpleEarnFlexibleProductListService, opts ...RequestOption) (*SimpleEarnFlexibleProductListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedProductList(ctx context.Context, req *SimpleEarnLockedProductListService, opts ...RequestOption) (*SimpleEarnLockedProductListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnFlexibleSubscribe(ctx context.Context, req *SimpleEarnFlexibleSubscribeService, opts ...RequestOption) (*SimpleEarnSubscribeResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedSubscribe(ctx context.Context, req *SimpleEarnLockedSubscribeService, opts ...RequestOption) (*SimpleEarnSubscribeResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnFlexibleRedeem(ctx context.Context, req *SimpleEarnFlexibleRedeemService, opts ...RequestOption) (*SimpleEarnRedeemResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedRedeem(ctx context.Context, req *SimpleEarnLockedRedeemService, opts ...RequestOption) (*SimpleEarnRedeemResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnFlexiblePosition(ctx context.Context, req *SimpleEarnFlexiblePositionService, opts ...RequestOption) (*SimpleEarnFlexiblePositionResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedPosition(ctx context.Context, req *SimpleEarnLockedPositionService, opts ...RequestOption) (*SimpleEarnLockedPositionResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnAccount(ctx context.Context, req *SimpleEarnAccountService, opts ...RequestOption) (*SimpleEarnAccountResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnFlexibleSubscriptionRecord(ctx context.Context, req *SimpleEarnFlexibleSubscriptionRecordService, opts ...RequestOption) (*SimpleEarnFlexibleSubscriptionRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedSubscriptionRecord(ctx context.Context, req *SimpleEarnLockedSubscriptionRecordService, opts ...RequestOption) (*SimpleEarnLockedSubscriptionRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnFlexibleRedemptionRecord(ctx context.Context, req *SimpleEarnFlexibleRedemptionRecordService, opts ...RequestOption) (*SimpleEarnFlexibleRedemptionRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedRedemptionRecord(ctx context.Context, req *SimpleEarnLockedRedemptionRecordService, opts ...RequestOption) (*SimpleEarnLockedRedemptionRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnFlexibleRewardsRecord(ctx context.Context, req *SimpleEarnFlexibleRewardsRecordService, opts ...RequestOption) (*SimpleEarnFlexibleRewardsRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedRewardsRecord(ctx context.Context, req *SimpleEarnLockedRewardsRecordService, opts ...RequestOption) (*SimpleEarnLockedRewardsRecordResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnFlexiblePersonalLeftQuota(ctx context.Context, req *SimpleEarnFlexiblePersonalLeftQuotaService, opts ...RequestOption) (*SimpleEarnPersonalLeftQuotaResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) SimpleEarnLockedPersonalLeftQuota(ctx context.Context, req *SimpleEarnLockedPersonalLeftQuotaService, opts ...RequestOption) (*SimpleEarnPersonalLeftQuotaResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+// Calls of AutoInvestAPI:
+func (c *Client) AutoInvestTargetAssetList(ctx context.Context, req *AutoInvestTargetAssetListService, opts ...RequestOption) (*AutoInvestTargetAssetListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestSourceAssetList(ctx context.Context, req *AutoInvestSourceAssetListService, opts ...RequestOption) (*AutoInvestSourceAssetListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestPlanAdd(ctx context.Context, req *AutoInvestPlanAddService, opts ...RequestOption) (*AutoInvestPlanResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestPlanEdit(ctx context.Context, req *AutoInvestPlanEditService, opts ...RequestOption) (*AutoInvestPlanResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestPlanEditStatus(ctx context.Context, req *AutoInvestPlanEditStatusService, opts ...RequestOption) (*AutoInvestPlanResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestPlanList(ctx context.Context, req *AutoInvestPlanListService, opts ...RequestOption) (*AutoInvestPlanListResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestHoldingDetails(ctx context.Context, req *AutoInvestHoldingDetailsService, opts ...RequestOption) (*AutoInvestHoldingDetailsResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestSubscriptionHistory(ctx context.Context, req *AutoInvestSubscriptionHistoryService, opts ...RequestOption) (*AutoInvestSubscriptionHistoryResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestIndexInfo(ctx context.Context, req *AutoInvestIndexInfoService, opts ...RequestOption) (*AutoInvestIndexInfoResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestOneTimeTransaction(ctx context.Context, req *AutoInvestOneTimeTransactionService, opts ...RequestOption) (*AutoInvestOneTimeTransactionResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+func (c *Client) AutoInvestOneTimeTransactionStatus(ctx context.Context, req *AutoInvestOneTimeTransactionStatusService, opts ...RequestOption) (*AutoInvestOneTimeTransactionStatusResponse, error) {
+	s := *req
+	s.c = c
+	return s.Do(ctx, opts...)
+}
+
+// Calls of PortfolioMarginAPI:
+func (p *PortfolioMarginClient) PortfolioMarginPing(ctx context.Context, req *PortfolioMarginPingService, opts ...RequestOption) error {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginAccount(ctx context.Context, req *PortfolioMarginAccountService, opts ...RequestOption) (*PortfolioMarginAccountResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginBalance(ctx context.Context, req *PortfolioMarginBalanceService, opts ...RequestOption) ([]*PortfolioMarginBalance, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginUMPositionRisk(ctx context.Context, req *PortfolioMarginUMPositionRiskService, opts ...RequestOption) ([]*PositionRisk, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginCMPositionRisk(ctx context.Context, req *PortfolioMarginCMPositionRiskService, opts ...RequestOption) ([]*PortfolioMarginCMPositionRisk, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginLoan(ctx context.Context, req *PortfolioMarginLoanService, opts ...RequestOption) (*PortfolioMarginTransactionResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginRepayLoan(ctx context.Context, req *PortfolioMarginRepayLoanService, opts ...RequestOption) (*PortfolioMarginTransactionResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginRepayFuturesNegativeBalance(ctx context.Context, req *PortfolioMarginRepayFuturesNegativeBalanceService, opts ...RequestOption) (*PortfolioMarginMsgResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginAutoCollection(ctx context.Context, req *PortfolioMarginAutoCollectionService, opts ...RequestOption) (*PortfolioMarginMsgResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginAssetCollection(ctx context.Context, req *PortfolioMarginAssetCollectionService, opts ...RequestOption) (*PortfolioMarginMsgResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginUMNewOrder(ctx context.Context, req *PortfolioMarginUMNewOrderService, opts ...RequestOption) (*CreateOrderResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginUMCancelOrder(ctx context.Context, req *PortfolioMarginUMCancelOrderService, opts ...RequestOption) (*Order, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginUMQueryOrder(ctx context.Context, req *PortfolioMarginUMQueryOrderService, opts ...RequestOption) (*Order, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginUMOpenOrders(ctx context.Context, req *PortfolioMarginUMOpenOrdersService, opts ...RequestOption) ([]*Order, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginCMNewOrder(ctx context.Context, req *PortfolioMarginCMNewOrderService, opts ...RequestOption) (*PortfolioMarginCMOrder, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginCMCancelOrder(ctx context.Context, req *PortfolioMarginCMCancelOrderService, opts ...RequestOption) (*PortfolioMarginCMOrder, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginCMQueryOrder(ctx context.Context, req *PortfolioMarginCMQueryOrderService, opts ...RequestOption) (*PortfolioMarginCMOrder, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginCMOpenOrders(ctx context.Context, req *PortfolioMarginCMOpenOrdersService, opts ...RequestOption) ([]*PortfolioMarginCMOrder, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginMarginNewOrder(ctx context.Context, req *PortfolioMarginMarginNewOrderService, opts ...RequestOption) (*MarginAccountNewOrderResponseFULL, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginMarginCancelOrder(ctx context.Context, req *PortfolioMarginMarginCancelOrderService, opts ...RequestOption) (*MarginAccountCancelOrderResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginMarginQueryOrder(ctx context.Context, req *PortfolioMarginMarginQueryOrderService, opts ...RequestOption) (*MarginAccountOrderResponse, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginCreateListenKey(ctx context.Context, req *PortfolioMarginCreateListenKeyService, opts ...RequestOption) (string, error) {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginPingListenKey(ctx context.Context, req *PortfolioMarginPingListenKeyService, opts ...RequestOption) error {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+func (p *PortfolioMarginClient) PortfolioMarginCloseListenKey(ctx context.Context, req *PortfolioMarginCloseListenKeyService, opts ...RequestOption) error {
+	s := *req
+	s.c = p.Client
+	return s.Do(ctx, opts...)
+}
+
+// Calls of OptionsAPI:
+func (o *OptionsClient) OptionsPing(ctx context.Context, req *OptionsPingService, opts ...RequestOption) error {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsExchangeInfo(ctx context.Context, req *OptionsExchangeInfoService, opts ...RequestOption) (*OptionsExchangeInfoResponse, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsIndexPrice(ctx context.Context, req *OptionsIndexPriceService, opts ...RequestOption) (*OptionsIndexPriceResponse, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsMarkPrice(ctx context.Context, req *OptionsMarkPriceService, opts ...RequestOption) ([]*OptionsMarkPrice, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsDepth(ctx context.Context, req *OptionsDepthService, opts ...RequestOption) (*OptionsDepthResponse, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsKlines(ctx context.Context, req *OptionsKlinesService, opts ...RequestOption) ([]*OptionsKline, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsExerciseHistory(ctx context.Context, req *OptionsExerciseHistoryService, opts ...RequestOption) ([]*OptionsExerciseHistory, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsNewOrder(ctx context.Context, req *OptionsNewOrderService, opts ...RequestOption) (*OptionsOrder, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsBatchOrders(ctx context.Context, req *OptionsBatchOrdersService, opts ...RequestOption) ([]*OptionsBatchOrderResponse, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsCancelOrder(ctx context.Context, req *OptionsCancelOrderService, opts ...RequestOption) (*OptionsOrder, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsBatchCancelOrders(ctx context.Context, req *OptionsBatchCancelOrdersService, opts ...RequestOption) ([]*OptionsBatchOrderResponse, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsPosition(ctx context.Context, req *OptionsPositionService, opts ...RequestOption) ([]*OptionsPosition, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsAccount(ctx context.Context, req *OptionsAccountService, opts ...RequestOption) (*OptionsAccountResponse, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsUserExerciseRecord(ctx context.Context, req *OptionsUserExerciseRecordService, opts ...RequestOption) ([]*OptionsUserExerciseRecord, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsCreateListenKey(ctx context.Context, req *OptionsCreateListenKeyService, opts ...RequestOption) (string, error) {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsPingListenKey(ctx context.Context, req *OptionsPingListenKeyService, opts ...RequestOption) error {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+func (o *OptionsClient) OptionsCloseListenKey(ctx context.Context, req *OptionsCloseListenKeyService, opts ...RequestOption) error {
+	s := *req
+	s.c = o.Client
+	return s.Do(ctx, opts...)
+}
+
+// Calls of WebsocketTradingAPI:
+func (w *WebsocketAPIClient) PlaceNewOrder(ctx context.Context, req *OrderPlacementService) (*OrderPlacementResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) TestPlaceOrder(ctx context.Context, req *TestOrderPlacementService) (*OrderPlacementResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) QueryOrder(ctx context.Context, req *OrderStatusService) (*OrderStatusResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) CancelOrder(ctx context.Context, req *OrderCancelService) (*OrderCancelResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) CancelReplaceOrder(ctx context.Context, req *OrderCancelReplaceService) (*OrderCancelReplaceResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) CurrentOpenOrders(ctx context.Context, req *OpenOrdersStatusService) (*OpenOrdersStatusResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) CancelOpenOrders(ctx context.Context, req *OpenOrdersCancelAllService) (*OpenOrdersCancelAllResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) PlaceOCO(ctx context.Context, req *OrderListPlaceService) (*OrderListPlaceResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) QueryOCO(ctx context.Context, req *OrderListStatusService) (*OrderListStatusResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) CurrentOpenOCO(ctx context.Context, req *OpenOrderListsStatusService) (*OpenOrderListsStatusResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) CancelOCO(ctx context.Context, req *OrderListCancelService) (*OrderListCancelResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) PlaceSOROrder(ctx context.Context, req *SOROrderPlacementService) (*SOROrderPlacementResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) TestPlaceSOROrder(ctx context.Context, req *TestSOROrderPlacementService) (*TestSOROrderPlacementResponse, error) {
+	s := *req
+	s.order.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) PlaceOrderListOCO(ctx context.Context, req *OrderListPlaceOCOService) (*OrderListPlaceResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) PlaceOrderListOTO(ctx context.Context, req *OrderListPlaceOTOService) (*OrderListPlaceResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) PlaceOrderListOTOCO(ctx context.Context, req *OrderListPlaceOTOCOService) (*OrderListPlaceResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
+
+func (w *WebsocketAPIClient) AmendOrderKeepPriority(ctx context.Context, req *OrderAmendKeepPriorityService) (*OrderAmendKeepPriorityResponse, error) {
+	s := *req
+	s.websocketAPI = w
+	return s.Do(ctx)
+}
@@ -0,0 +1,37 @@
+package binance_connector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type callsTestSuite struct {
+	baseTestSuite
+}
+
+func TestCalls(t *testing.T) {
+	suite.Run(t, new(callsTestSuite))
+}
+
+func (s *callsTestSuite) TestCancelOrder() {
+	data := []byte(`{"symbol":"BTCUSDT","origClientOrderId":"my_order_id_123","orderId":123456789,"status":"CANCELED"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		e.setParam("symbol", "BTCUSDT")
+		e.setParam("origClientOrderId", "my_order_id_123")
+		s.assertRequestEqual(e, r)
+	})
+	req := new(CancelOrderService).Symbol("BTCUSDT").OrigClientOrderId("my_order_id_123")
+	var spot SpotTradingAPI = s.client
+	res, err := spot.CancelOrder(context.Background(), req)
+	s.r().NoError(err)
+	s.r().Equal(int64(123456789), res.OrderId)
+	s.r().Equal("CANCELED", res.Status)
+	// the request is left as it was built
+	s.r().Nil(req.c)
+}
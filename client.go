@@ -268,6 +268,42 @@ func (c *Client) NewGetQueryPreventedMatchesService() *GetQueryPreventedMatchesS
 	return &GetQueryPreventedMatchesService{c: c}
 }
 
+func (c *Client) NewSOROrderService() *SOROrderService {
+	return &SOROrderService{c: c}
+}
+
+func (c *Client) NewTestSOROrderService() *TestSOROrderService {
+	return &TestSOROrderService{order: SOROrderService{c: c}}
+}
+
+func (c *Client) NewMyAllocationsService() *MyAllocationsService {
+	return &MyAllocationsService{c: c}
+}
+
+func (c *Client) NewOrderListOCOService() *OrderListOCOService {
+	return &OrderListOCOService{c: c}
+}
+
+func (c *Client) NewOrderListOTOService() *OrderListOTOService {
+	return &OrderListOTOService{c: c}
+}
+
+func (c *Client) NewOrderListOTOCOService() *OrderListOTOCOService {
+	return &OrderListOTOCOService{c: c}
+}
+
+func (c *Client) NewAmendOrderKeepPriorityService() *AmendOrderKeepPriorityService {
+	return &AmendOrderKeepPriorityService{c: c}
+}
+
+func (c *Client) NewQueryOrderAmendmentsService() *QueryOrderAmendmentsService {
+	return &QueryOrderAmendmentsService{c: c}
+}
+
+func (c *Client) NewGetAccountCommissionService() *GetAccountCommissionService {
+	return &GetAccountCommissionService{c: c}
+}
+
 // Market Endpoints:
 func (c *Client) NewPingService() *Ping {
 	return &Ping{c: c}
@@ -317,6 +353,10 @@ func (c *Client) NewTickerPriceService() *TickerPrice {
 	return &TickerPrice{c: c}
 }
 
+func (c *Client) NewTickerPricesService() *TickerPrices {
+	return &TickerPrices{c: c}
+}
+
 func (c *Client) NewTickerBookTickerService() *TickerBookTicker {
 	return &TickerBookTicker{c: c}
 }
@@ -477,6 +517,141 @@ func (c *Client) NewMarginSmallLiabilityExchangeHistoryService() *MarginSmallLia
 	return &MarginSmallLiabilityExchangeHistoryService{c: c}
 }
 
+func (c *Client) NewMarginBorrowRepayService() *MarginBorrowRepayService {
+	return &MarginBorrowRepayService{c: c}
+}
+
+func (c *Client) NewMarginBorrowRepayRecordService() *MarginBorrowRepayRecordService {
+	return &MarginBorrowRepayRecordService{c: c}
+}
+
+func (c *Client) NewCrossMarginTransferService() *CrossMarginTransferService {
+	return &CrossMarginTransferService{c: c}
+}
+
+func (c *Client) NewIsolatedMarginTransferService() *IsolatedMarginTransferService {
+	return &IsolatedMarginTransferService{c: c}
+}
+
+// Convert Endpoints:
+func (c *Client) NewConvertExchangeInfoService() *ConvertExchangeInfoService {
+	return &ConvertExchangeInfoService{c: c}
+}
+
+func (c *Client) NewConvertAssetInfoService() *ConvertAssetInfoService {
+	return &ConvertAssetInfoService{c: c}
+}
+
+func (c *Client) NewConvertGetQuoteService() *ConvertGetQuoteService {
+	return &ConvertGetQuoteService{c: c}
+}
+
+func (c *Client) NewConvertAcceptQuoteService() *ConvertAcceptQuoteService {
+	return &ConvertAcceptQuoteService{c: c}
+}
+
+func (c *Client) NewConvertOrderStatusService() *ConvertOrderStatusService {
+	return &ConvertOrderStatusService{c: c}
+}
+
+func (c *Client) NewConvertTradeFlowService() *ConvertTradeFlowService {
+	return &ConvertTradeFlowService{c: c}
+}
+
+func (c *Client) NewConvertLimitPlaceOrderService() *ConvertLimitPlaceOrderService {
+	return &ConvertLimitPlaceOrderService{c: c}
+}
+
+func (c *Client) NewConvertLimitCancelOrderService() *ConvertLimitCancelOrderService {
+	return &ConvertLimitCancelOrderService{c: c}
+}
+
+func (c *Client) NewConvertLimitQueryOpenOrdersService() *ConvertLimitQueryOpenOrdersService {
+	return &ConvertLimitQueryOpenOrdersService{c: c}
+}
+
+func (c *Client) NewConvertService() *ConvertService {
+	return &ConvertService{
+		c:            c,
+		quote:        ConvertGetQuoteService{c: c},
+		maxQuotes:    3,
+		expiryMargin: time.Second,
+		pollInterval: time.Second,
+		now: func() int64 {
+			return currentTimestamp() - c.TimeOffset
+		},
+	}
+}
+
+// Simple Earn Endpoints:
+func (c *Client) NewSimpleEarnFlexibleProductListService() *SimpleEarnFlexibleProductListService {
+	return &SimpleEarnFlexibleProductListService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedProductListService() *SimpleEarnLockedProductListService {
+	return &SimpleEarnLockedProductListService{c: c}
+}
+
+func (c *Client) NewSimpleEarnFlexibleSubscribeService() *SimpleEarnFlexibleSubscribeService {
+	return &SimpleEarnFlexibleSubscribeService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedSubscribeService() *SimpleEarnLockedSubscribeService {
+	return &SimpleEarnLockedSubscribeService{c: c}
+}
+
+func (c *Client) NewSimpleEarnFlexibleRedeemService() *SimpleEarnFlexibleRedeemService {
+	return &SimpleEarnFlexibleRedeemService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedRedeemService() *SimpleEarnLockedRedeemService {
+	return &SimpleEarnLockedRedeemService{c: c}
+}
+
+func (c *Client) NewSimpleEarnFlexiblePositionService() *SimpleEarnFlexiblePositionService {
+	return &SimpleEarnFlexiblePositionService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedPositionService() *SimpleEarnLockedPositionService {
+	return &SimpleEarnLockedPositionService{c: c}
+}
+
+func (c *Client) NewSimpleEarnAccountService() *SimpleEarnAccountService {
+	return &SimpleEarnAccountService{c: c}
+}
+
+func (c *Client) NewSimpleEarnFlexibleSubscriptionRecordService() *SimpleEarnFlexibleSubscriptionRecordService {
+	return &SimpleEarnFlexibleSubscriptionRecordService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedSubscriptionRecordService() *SimpleEarnLockedSubscriptionRecordService {
+	return &SimpleEarnLockedSubscriptionRecordService{c: c}
+}
+
+func (c *Client) NewSimpleEarnFlexibleRedemptionRecordService() *SimpleEarnFlexibleRedemptionRecordService {
+	return &SimpleEarnFlexibleRedemptionRecordService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedRedemptionRecordService() *SimpleEarnLockedRedemptionRecordService {
+	return &SimpleEarnLockedRedemptionRecordService{c: c}
+}
+
+func (c *Client) NewSimpleEarnFlexibleRewardsRecordService() *SimpleEarnFlexibleRewardsRecordService {
+	return &SimpleEarnFlexibleRewardsRecordService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedRewardsRecordService() *SimpleEarnLockedRewardsRecordService {
+	return &SimpleEarnLockedRewardsRecordService{c: c}
+}
+
+func (c *Client) NewSimpleEarnFlexiblePersonalLeftQuotaService() *SimpleEarnFlexiblePersonalLeftQuotaService {
+	return &SimpleEarnFlexiblePersonalLeftQuotaService{c: c}
+}
+
+func (c *Client) NewSimpleEarnLockedPersonalLeftQuotaService() *SimpleEarnLockedPersonalLeftQuotaService {
+	return &SimpleEarnLockedPersonalLeftQuotaService{c: c}
+}
+
 // Sub-Account Endpoints:
 func (c *Client) NewCreateSubAccountService() *CreateSubAccountService {
 	return &CreateSubAccountService{c: c}
@@ -650,6 +825,22 @@ func (c *Client) NewGetManagedSubAccountDepositAddressService() *GetManagedSubAc
 	return &GetManagedSubAccountDepositAddressService{c: c}
 }
 
+func (c *Client) NewEnableOptionsForSubAccountService() *EnableOptionsForSubAccountService {
+	return &EnableOptionsForSubAccountService{c: c}
+}
+
+func (c *Client) NewMoveFuturesPositionForSubAccountService() *MoveFuturesPositionForSubAccountService {
+	return &MoveFuturesPositionForSubAccountService{c: c}
+}
+
+func (c *Client) NewQueryMoveFuturesPositionHistoryForSubAccountService() *QueryMoveFuturesPositionHistoryForSubAccountService {
+	return &QueryMoveFuturesPositionHistoryForSubAccountService{c: c}
+}
+
+func (c *Client) NewGetIPRestrictionThirdPartyListForSubAccountAPIKeyService() *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService {
+	return &GetIPRestrictionThirdPartyListForSubAccountAPIKeyService{c: c}
+}
+
 // Wallet Endpoints:
 func (c *Client) NewGetSystemStatusService() *GetSystemStatusService {
 	return &GetSystemStatusService{c: c}
@@ -755,6 +946,51 @@ func (c *Client) NewAutoConvertStableCoinService() *AutoConvertStableCoinService
 	return &AutoConvertStableCoinService{c: c}
 }
 
+// Auto-Invest Endpoints:
+func (c *Client) NewAutoInvestTargetAssetListService() *AutoInvestTargetAssetListService {
+	return &AutoInvestTargetAssetListService{c: c}
+}
+
+func (c *Client) NewAutoInvestSourceAssetListService() *AutoInvestSourceAssetListService {
+	return &AutoInvestSourceAssetListService{c: c}
+}
+
+func (c *Client) NewAutoInvestPlanAddService() *AutoInvestPlanAddService {
+	return &AutoInvestPlanAddService{c: c}
+}
+
+func (c *Client) NewAutoInvestPlanEditService() *AutoInvestPlanEditService {
+	return &AutoInvestPlanEditService{c: c}
+}
+
+func (c *Client) NewAutoInvestPlanEditStatusService() *AutoInvestPlanEditStatusService {
+	return &AutoInvestPlanEditStatusService{c: c}
+}
+
+func (c *Client) NewAutoInvestPlanListService() *AutoInvestPlanListService {
+	return &AutoInvestPlanListService{c: c}
+}
+
+func (c *Client) NewAutoInvestHoldingDetailsService() *AutoInvestHoldingDetailsService {
+	return &AutoInvestHoldingDetailsService{c: c}
+}
+
+func (c *Client) NewAutoInvestSubscriptionHistoryService() *AutoInvestSubscriptionHistoryService {
+	return &AutoInvestSubscriptionHistoryService{c: c}
+}
+
+func (c *Client) NewAutoInvestIndexInfoService() *AutoInvestIndexInfoService {
+	return &AutoInvestIndexInfoService{c: c}
+}
+
+func (c *Client) NewAutoInvestOneTimeTransactionService() *AutoInvestOneTimeTransactionService {
+	return &AutoInvestOneTimeTransactionService{c: c}
+}
+
+func (c *Client) NewAutoInvestOneTimeTransactionStatusService() *AutoInvestOneTimeTransactionStatusService {
+	return &AutoInvestOneTimeTransactionStatusService{c: c}
+}
+
 // User Data Streams:
 func (c *Client) NewCreateListenKeyService() *CreateListenKey {
 	return &CreateListenKey{c: c}
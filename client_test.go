@@ -3,12 +3,16 @@ package binance_connector
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/binance/binance-connector-go/handlers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -95,6 +99,53 @@ func newHTTPResponse(data []byte, statusCode int) *http.Response {
 	}
 }
 
+// fakeHandler answers a request of a client made by newFakeClient. A string
+// or []byte result is the body of the response, any other result is encoded
+// as JSON. A *handlers.APIError is answered with status 400, any other error
+// fails the request without a response.
+type fakeHandler func(req *http.Request) (interface{}, error)
+
+// fakeRoutes answers requests with the fakeHandler of their path
+type fakeRoutes map[string]fakeHandler
+
+func (routes fakeRoutes) serve(req *http.Request) (interface{}, error) {
+	h, ok := routes[req.URL.Path]
+	if !ok {
+		return nil, fmt.Errorf("no fake route for %s %s", req.Method, req.URL.Path)
+	}
+	return h(req)
+}
+
+// newFakeClient returns a client whose requests are answered by handle,
+// for the tests of workflows calling several endpoints
+func newFakeClient(handle fakeHandler) *Client {
+	c := NewClient("key", "secret", "https://api.binance.com")
+	c.HTTPClient = &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := handle(req)
+		status := http.StatusOK
+		var apiErr *handlers.APIError
+		switch {
+		case errors.As(err, &apiErr):
+			status, res = http.StatusBadRequest, apiErr
+		case err != nil:
+			return nil, err
+		}
+		var body []byte
+		switch v := res.(type) {
+		case string:
+			body = []byte(v)
+		case []byte:
+			body = v
+		default:
+			if body, err = json.Marshal(v); err != nil {
+				return nil, err
+			}
+		}
+		return newHTTPResponse(body, status), nil
+	})}
+	return c
+}
+
 func newRequest() *request {
 	r := &request{
 		query: url.Values{},
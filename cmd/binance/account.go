@@ -0,0 +1,101 @@
+package main
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+var accountCommands = []*command{
+	{name: "balances", help: "spot balances", run: balances},
+	{name: "orders", args: "[SYMBOL]", help: "open orders, of every symbol when none is given", run: openOrders},
+	{name: "trades", args: "SYMBOL", help: "latest trades of a symbol", run: trades},
+}
+
+func balances(a *app, args []string) error {
+	fs := a.flags()
+	all := fs.Bool("all", false, "include zero balances")
+	if _, err := parse(fs, args, 0); err != nil {
+		return err
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+	account, err := client.NewGetAccountService().Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	balances := []binance_connector.Balance{}
+	for _, b := range account.Balances {
+		if *all || !isZero(b.Free) || !isZero(b.Locked) {
+			balances = append(balances, b)
+		}
+	}
+	return a.print(balances, []string{"ASSET", "FREE", "LOCKED"}, func() (rows [][]string) {
+		for _, b := range balances {
+			rows = append(rows, []string{b.Asset, b.Free, b.Locked})
+		}
+		return rows
+	})
+}
+
+func openOrders(a *app, args []string) error {
+	args, err := parse(a.flags(), args, 0)
+	if err != nil {
+		return err
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+	svc := client.NewGetOpenOrdersService()
+	if len(args) > 0 {
+		svc.Symbol(strings.ToUpper(args[0]))
+	}
+	orders, err := svc.Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.print(orders, []string{"TIME", "SYMBOL", "ORDER ID", "SIDE", "TYPE", "PRICE", "QUANTITY", "EXECUTED", "STATUS"},
+		func() (rows [][]string) {
+			for _, o := range orders {
+				rows = append(rows, []string{formatTime(o.Time), o.Symbol, strconv.FormatInt(o.OrderId, 10), o.Side, o.Type,
+					o.Price, o.OrigQty, o.ExecutedQty, o.Status})
+			}
+			return rows
+		})
+}
+
+func trades(a *app, args []string) error {
+	fs := a.flags()
+	limit := fs.Int("limit", 20, "number of trades")
+	args, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+	trades, err := client.NewGetMyTradesService().Symbol(strings.ToUpper(args[0])).Limit(*limit).Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.print(trades, []string{"TIME", "TRADE ID", "ORDER ID", "SIDE", "PRICE", "QUANTITY", "QUOTE QUANTITY", "COMMISSION"},
+		func() (rows [][]string) {
+			for _, t := range trades {
+				rows = append(rows, []string{formatTime(t.Time), strconv.FormatInt(t.Id, 10), strconv.FormatInt(t.OrderId, 10),
+					side(t.IsBuyer), t.Price, t.Quantity, t.QuoteQuantity, t.Commission + " " + t.CommissionAsset})
+			}
+			return rows
+		})
+}
+
+// isZero reports whether a decimal amount is zero
+func isZero(amount string) bool {
+	r, ok := new(big.Rat).SetString(amount)
+	return ok && r.Sign() == 0
+}
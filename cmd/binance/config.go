@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+const (
+	defaultBaseURL   = "https://api.binance.com"
+	defaultStreamURL = "wss://stream.binance.com:9443"
+)
+
+// config define the credentials and endpoints of the CLI
+type config struct {
+	APIKey    string `json:"api_key"`
+	SecretKey string `json:"secret_key"`
+	BaseURL   string `json:"base_url"`
+	StreamURL string `json:"stream_url"`
+}
+
+// loadConfig reads the config file at path, or at the default path when it
+// exists, and applies the environment on top of it. A config file readable by
+// other users is reported on warnings.
+func loadConfig(path string, getenv func(string) string, warnings io.Writer) (*config, error) {
+	cfg := &config{}
+	explicit := path != ""
+	if !explicit {
+		if dir, err := os.UserConfigDir(); err == nil {
+			path = filepath.Join(dir, "binance", "config.json")
+		}
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(data, cfg); err != nil {
+				return nil, fmt.Errorf("config %s: %w", path, err)
+			}
+			if info, err := os.Stat(path); err == nil && info.Mode().Perm()&0o077 != 0 && runtime.GOOS != "windows" {
+				fmt.Fprintf(warnings, "warning: %s is readable by other users, restrict it with chmod 600\n", path)
+			}
+		case explicit || !errors.Is(err, fs.ErrNotExist):
+			return nil, err
+		}
+	}
+
+	for key, field := range map[string]*string{
+		"BINANCE_API_KEY":    &cfg.APIKey,
+		"BINANCE_SECRET_KEY": &cfg.SecretKey,
+		"BINANCE_BASE_URL":   &cfg.BaseURL,
+		"BINANCE_STREAM_URL": &cfg.StreamURL,
+	} {
+		if value := getenv(key); value != "" {
+			*field = value
+		}
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultBaseURL
+	}
+	if cfg.StreamURL == "" {
+		cfg.StreamURL = defaultStreamURL
+	}
+	return cfg, nil
+}
@@ -0,0 +1,206 @@
+// Command binance inspects and trades a Binance account from the command line.
+//
+// Usage:
+//
+//	binance [-o table|json] [-config file] <group> <command> [flags] [arguments]
+//
+// The groups are:
+//
+//	market   ticker, depth and klines
+//	account  balances, open orders and trades
+//	order    place and cancel orders, after a confirmation prompt
+//	wallet   deposit address, deposit and withdrawal history
+//	stream   tail the depth, trade, kline and book ticker streams
+//
+// Run a group without a command to list its commands. Credentials and
+// endpoints are read from the config file, by default binance/config.json in
+// the user configuration directory:
+//
+//	{"api_key": "...", "secret_key": "...", "base_url": "https://api.binance.com", "stream_url": "wss://stream.binance.com:9443"}
+//
+// and overridden by the BINANCE_API_KEY, BINANCE_SECRET_KEY, BINANCE_BASE_URL
+// and BINANCE_STREAM_URL environment variables. BINANCE_CONFIG sets the path
+// of the config file.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+var (
+	// errUsage is returned once the usage of a command has been printed
+	errUsage = errors.New("usage")
+	// errAborted is returned when an order is not confirmed
+	errAborted = errors.New("aborted")
+)
+
+// command define a command of a group
+type command struct {
+	name string
+	args string
+	help string
+	run  func(a *app, args []string) error
+}
+
+// group define a group of commands
+type group struct {
+	name     string
+	help     string
+	commands []*command
+}
+
+var groups = []*group{
+	{name: "market", help: "market data", commands: marketCommands},
+	{name: "account", help: "account balances, orders and trades", commands: accountCommands},
+	{name: "order", help: "place and cancel orders", commands: orderCommands},
+	{name: "wallet", help: "deposit address and history", commands: walletCommands},
+	{name: "stream", help: "tail market streams", commands: streamCommands},
+}
+
+// app define the state shared by the commands
+type app struct {
+	ctx    context.Context
+	cfg    *config
+	format string
+	in     *bufio.Reader
+	out    io.Writer
+	errOut io.Writer
+	cmd    *command
+	name   string // group and command, for usage
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr, os.Getenv)
+	stop()
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	default:
+		fmt.Fprintln(os.Stderr, "binance:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer, getenv func(string) string) error {
+	fs := flag.NewFlagSet("binance", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("o", formatTable, "output format, table or json")
+	configPath := fs.String("config", getenv("BINANCE_CONFIG"), "config file")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: binance [flags] <group> <command> [flags] [arguments]")
+		fmt.Fprintln(stderr, "\ngroups:")
+		for _, g := range groups {
+			fmt.Fprintf(stderr, "  %-8s %s\n", g.name, g.help)
+		}
+		fmt.Fprintln(stderr, "\nflags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != formatTable && *format != formatJSON {
+		return fmt.Errorf("unknown output format %q", *format)
+	}
+
+	var g *group
+	for _, candidate := range groups {
+		if candidate.name == fs.Arg(0) {
+			g = candidate
+		}
+	}
+	if g == nil {
+		fs.Usage()
+		return errUsage
+	}
+	var cmd *command
+	for _, candidate := range g.commands {
+		if candidate.name == fs.Arg(1) {
+			cmd = candidate
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "usage: binance %s <command> [flags] [arguments]\n\ncommands:\n", g.name)
+		for _, c := range g.commands {
+			fmt.Fprintf(stderr, "  %-12s %-22s %s\n", c.name, c.args, c.help)
+		}
+		return errUsage
+	}
+
+	cfg, err := loadConfig(*configPath, getenv, stderr)
+	if err != nil {
+		return err
+	}
+	a := &app{ctx: ctx, cfg: cfg, format: *format, in: bufio.NewReader(stdin), out: stdout, errOut: stderr,
+		cmd: cmd, name: g.name + " " + cmd.name}
+	return cmd.run(a, fs.Args()[2:])
+}
+
+// flags returns the flag set of the running command
+func (a *app) flags() *flag.FlagSet {
+	fs := flag.NewFlagSet(a.name, flag.ContinueOnError)
+	fs.SetOutput(a.errOut)
+	fs.Usage = func() {
+		fmt.Fprintf(a.errOut, "usage: binance %s [flags] %s\n", a.name, a.cmd.args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses the flags placed before, between or after the arguments and
+// returns the arguments, of which at least min are required
+func parse(fs *flag.FlagSet, args []string, min int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(positional) < min {
+		fs.Usage()
+		return nil, errUsage
+	}
+	return positional, nil
+}
+
+// client returns a REST client, failing when signed is set and the
+// credentials are missing
+func (a *app) client(signed bool) (*binance_connector.Client, error) {
+	if signed && (a.cfg.APIKey == "" || a.cfg.SecretKey == "") {
+		return nil, errors.New("missing credentials, set BINANCE_API_KEY and BINANCE_SECRET_KEY or api_key and secret_key in the config file")
+	}
+	return binance_connector.NewClient(a.cfg.APIKey, a.cfg.SecretKey, a.cfg.BaseURL), nil
+}
+
+// confirm asks prompt on the error output and returns errAborted unless the
+// answer is yes
+func (a *app) confirm(prompt string) error {
+	fmt.Fprintf(a.errOut, "%s [y/N] ", prompt)
+	answer, err := a.in.ReadString('\n')
+	if errors.Is(err, io.EOF) {
+		fmt.Fprintln(a.errOut)
+	} else if err != nil {
+		return err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	}
+	return errAborted
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type cliTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []*http.Request
+	env      map[string]string
+	stdout   *bytes.Buffer
+	stderr   *bytes.Buffer
+}
+
+func TestCLI(t *testing.T) {
+	suite.Run(t, new(cliTestSuite))
+}
+
+func (s *cliTestSuite) SetupTest() {
+	s.requests = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s.requests = append(s.requests, req)
+		switch req.URL.Path {
+		case "/api/v3/ticker/24hr":
+			w.Write([]byte(`{"symbol":"` + req.URL.Query().Get("symbol") + `","lastPrice":"30000.01","priceChangePercent":"1.5",
+				"highPrice":"30500","lowPrice":"29000","volume":"100","quoteVolume":"3000000"}`))
+		case "/api/v3/account":
+			w.Write([]byte(`{"balances":[{"asset":"BTC","free":"0.5","locked":"0.1"},{"asset":"LTC","free":"0.00000000","locked":"0.00000000"}]}`))
+		case "/api/v3/order":
+			w.Write([]byte(`{"symbol":"SHIBUSDT","orderId":7,"clientOrderId":"abc","status":"NEW","executedQty":"0","cummulativeQuoteQty":"0"}`))
+		case "/ws/btcusdt@trade":
+			conn, err := (&websocket.Upgrader{}).Upgrade(w, req, nil)
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"e":"trade","E":1672515782136,"s":"BTCUSDT","t":12345,"p":"30000.01",
+				"q":"0.1","b":88,"a":50,"T":1672515782136,"m":true,"M":true}`))
+			conn.Close()
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	// an empty config file in place of the one of the user
+	path := filepath.Join(s.T().TempDir(), "config.json")
+	s.Require().NoError(os.WriteFile(path, []byte(`{}`), 0o600))
+	s.env = map[string]string{
+		"BINANCE_CONFIG":     path,
+		"BINANCE_API_KEY":    "key",
+		"BINANCE_SECRET_KEY": "secret",
+		"BINANCE_BASE_URL":   s.server.URL,
+		"BINANCE_STREAM_URL": "ws" + strings.TrimPrefix(s.server.URL, "http"),
+	}
+	s.stdout, s.stderr = new(bytes.Buffer), new(bytes.Buffer)
+}
+
+func (s *cliTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *cliTestSuite) run(stdin string, args ...string) error {
+	return run(context.Background(), args, strings.NewReader(stdin), s.stdout, s.stderr, func(key string) string { return s.env[key] })
+}
+
+func (s *cliTestSuite) TestTicker() {
+	r := s.Require()
+	r.NoError(s.run("", "market", "ticker", "btcusdt"))
+	r.Equal("BTCUSDT", s.requests[0].URL.Query().Get("symbol"))
+	r.Equal("SYMBOL   LAST      CHANGE %  HIGH   LOW    VOLUME  QUOTE VOLUME\n"+
+		"BTCUSDT  30000.01  1.5       30500  29000  100     3000000\n", s.stdout.String())
+}
+
+func (s *cliTestSuite) TestBalances() {
+	r := s.Require()
+	r.NoError(s.run("", "-o", "json", "account", "balances"))
+	r.NotEmpty(s.requests[0].URL.Query().Get("signature"))
+	var balances []map[string]string
+	r.NoError(json.Unmarshal(s.stdout.Bytes(), &balances))
+	// the zero LTC balance is left out
+	r.Equal([]map[string]string{{"asset": "BTC", "free": "0.5", "locked": "0.1"}}, balances)
+
+	delete(s.env, "BINANCE_SECRET_KEY")
+	r.ErrorContains(s.run("", "account", "balances"), "missing credentials")
+	r.Len(s.requests, 1)
+}
+
+func (s *cliTestSuite) TestPlaceOrder() {
+	r := s.Require()
+	args := []string{"order", "place", "shibusdt", "buy", "limit", "-qty", "1000000", "-price", "0.00001"}
+	r.ErrorIs(s.run("n\n", args...), errAborted)
+	r.Contains(s.stderr.String(), "Place BUY LIMIT order of 1000000 SHIBUSDT at 0.00001 GTC on "+s.server.URL+"? [y/N]")
+	r.ErrorIs(s.run("", args...), errAborted)
+	r.Empty(s.requests)
+
+	r.NoError(s.run("y\n", args...))
+	r.Len(s.requests, 1)
+	query := s.requests[0].URL.Query()
+	r.Equal(http.MethodPost, s.requests[0].Method)
+	r.Equal("0.00001", query.Get("price"))
+	r.Equal("1000000", query.Get("quantity"))
+	r.Equal("GTC", query.Get("timeInForce"))
+	r.Contains(s.stdout.String(), "SHIBUSDT  7         abc              NEW     0         0")
+
+	// -yes skips the prompt
+	r.NoError(s.run("", append(args, "-yes")...))
+	r.Len(s.requests, 2)
+
+	r.Error(s.run("y\n", "order", "place", "shibusdt", "hold", "limit", "-qty", "1"))
+	r.ErrorIs(s.run("", "order", "place", "shibusdt"), errUsage)
+}
+
+func (s *cliTestSuite) TestStream() {
+	r := s.Require()
+	// the server closes the stream after one trade
+	r.Error(s.run("", "stream", "trade", "BTCUSDT"))
+	r.Equal("2022-12-31T19:43:02Z BTCUSDT SELL 30000.01 0.1\n", s.stdout.String())
+}
+
+func (s *cliTestSuite) TestConfig() {
+	r := s.Require()
+	path := filepath.Join(s.T().TempDir(), "config.json")
+	r.NoError(os.WriteFile(path, []byte(`{"api_key":"file key","secret_key":"file secret"}`), 0o644))
+
+	cfg, err := loadConfig(path, func(key string) string {
+		return map[string]string{"BINANCE_SECRET_KEY": "env secret"}[key]
+	}, s.stderr)
+	r.NoError(err)
+	r.Equal(&config{APIKey: "file key", SecretKey: "env secret", BaseURL: defaultBaseURL, StreamURL: defaultStreamURL}, cfg)
+	r.Contains(s.stderr.String(), "readable by other users")
+
+	_, err = loadConfig(filepath.Join(s.T().TempDir(), "missing.json"), func(string) string { return "" }, s.stderr)
+	r.Error(err)
+}
+
+func (s *cliTestSuite) TestUsage() {
+	r := s.Require()
+	r.ErrorIs(s.run("", "futures"), errUsage)
+	r.ErrorIs(s.run("", "market", "trades"), errUsage)
+	r.Contains(s.stderr.String(), "klines       SYMBOL")
+	r.Error(s.run("", "-o", "xml", "market", "ticker"))
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+var marketCommands = []*command{
+	{name: "ticker", args: "[SYMBOL...]", help: "24 hour statistics of symbols, or the price of every symbol", run: ticker},
+	{name: "depth", args: "SYMBOL", help: "order book of a symbol", run: depth},
+	{name: "klines", args: "SYMBOL", help: "candlesticks of a symbol", run: klines},
+}
+
+func ticker(a *app, args []string) error {
+	symbols, err := parse(a.flags(), args, 0)
+	if err != nil {
+		return err
+	}
+	client, err := a.client(false)
+	if err != nil {
+		return err
+	}
+
+	if len(symbols) == 0 {
+		prices, err := client.NewTickerPricesService().Do(a.ctx)
+		if err != nil {
+			return err
+		}
+		return a.print(prices, []string{"SYMBOL", "PRICE"}, func() (rows [][]string) {
+			for _, p := range prices {
+				rows = append(rows, []string{p.Symbol, p.Price})
+			}
+			return rows
+		})
+	}
+
+	var tickers []*binance_connector.Ticker24hrResponse
+	for _, symbol := range symbols {
+		t, err := client.NewTicker24hrService().Symbol(strings.ToUpper(symbol)).Do(a.ctx)
+		if err != nil {
+			return err
+		}
+		tickers = append(tickers, t)
+	}
+	return a.print(tickers, []string{"SYMBOL", "LAST", "CHANGE %", "HIGH", "LOW", "VOLUME", "QUOTE VOLUME"}, func() (rows [][]string) {
+		for _, t := range tickers {
+			rows = append(rows, []string{t.Symbol, t.LastPrice, t.PriceChangePercent, t.HighPrice, t.LowPrice, t.Volume, t.QuoteVolume})
+		}
+		return rows
+	})
+}
+
+func depth(a *app, args []string) error {
+	fs := a.flags()
+	limit := fs.Int("limit", 10, "number of price levels of each side")
+	args, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	client, err := a.client(false)
+	if err != nil {
+		return err
+	}
+	book, err := client.NewOrderBookService().Symbol(strings.ToUpper(args[0])).Limit(*limit).Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	// asks from the highest price down to the spread, then bids
+	return a.print(book, []string{"SIDE", "PRICE", "QUANTITY"}, func() (rows [][]string) {
+		for i := len(book.Asks) - 1; i >= 0; i-- {
+			if len(book.Asks[i]) == 2 {
+				rows = append(rows, []string{"ASK", formatFloat(book.Asks[i][0]), formatFloat(book.Asks[i][1])})
+			}
+		}
+		for _, bid := range book.Bids {
+			if len(bid) == 2 {
+				rows = append(rows, []string{"BID", formatFloat(bid[0]), formatFloat(bid[1])})
+			}
+		}
+		return rows
+	})
+}
+
+func klines(a *app, args []string) error {
+	fs := a.flags()
+	interval := fs.String("interval", "1h", "kline interval, such as 1m, 1h or 1d")
+	limit := fs.Int("limit", 24, "number of klines")
+	args, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	client, err := a.client(false)
+	if err != nil {
+		return err
+	}
+	klines, err := client.NewKlinesService().Symbol(strings.ToUpper(args[0])).Interval(*interval).Limit(*limit).Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.print(klines, []string{"OPEN TIME", "OPEN", "HIGH", "LOW", "CLOSE", "VOLUME", "TRADES"}, func() (rows [][]string) {
+		for _, k := range klines {
+			rows = append(rows, []string{formatTime(k.OpenTime), k.Open, k.High, k.Low, k.Close, k.Volume,
+				strconv.FormatUint(k.NumberOfTrades, 10)})
+		}
+		return rows
+	})
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+var orderCommands = []*command{
+	{name: "place", args: "SYMBOL BUY|SELL TYPE", help: "place an order", run: placeOrder},
+	{name: "cancel", args: "SYMBOL", help: "cancel an order", run: cancelOrder},
+}
+
+func placeOrder(a *app, args []string) error {
+	fs := a.flags()
+	qty := fs.Float64("qty", 0, "quantity")
+	quoteQty := fs.Float64("quote-qty", 0, "quote quantity, for MARKET orders")
+	price := fs.Float64("price", 0, "price")
+	stopPrice := fs.Float64("stop-price", 0, "stop price")
+	timeInForce := fs.String("tif", "", "time in force, GTC by default for limit orders")
+	clientOrderId := fs.String("client-id", "", "client order id")
+	yes := fs.Bool("yes", false, "place the order without confirmation")
+	args, err := parse(fs, args, 3)
+	if err != nil {
+		return err
+	}
+	symbol, side, orderType := strings.ToUpper(args[0]), strings.ToUpper(args[1]), strings.ToUpper(args[2])
+	if side != "BUY" && side != "SELL" {
+		return fmt.Errorf("unknown side %q", args[1])
+	}
+	if (*qty > 0) == (*quoteQty > 0) {
+		return fmt.Errorf("set one of -qty and -quote-qty")
+	}
+	if *timeInForce == "" && strings.HasSuffix(orderType, "LIMIT") && orderType != "LIMIT_MAKER" {
+		*timeInForce = "GTC"
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+
+	svc := client.NewCreateOrderService().Symbol(symbol).Side(side).Type(orderType)
+	description := fmt.Sprintf("%s %s order of", side, orderType)
+	if *qty > 0 {
+		svc.Quantity(*qty)
+		description += " " + formatAmount(*qty) + " " + symbol
+	} else {
+		svc.QuoteOrderQty(*quoteQty)
+		description += " " + symbol + " for " + formatAmount(*quoteQty) + " quote"
+	}
+	if *price > 0 {
+		svc.Price(*price)
+		description += " at " + formatAmount(*price)
+	}
+	if *stopPrice > 0 {
+		svc.StopPrice(*stopPrice)
+		description += " stop " + formatAmount(*stopPrice)
+	}
+	if *timeInForce != "" {
+		svc.TimeInForce(strings.ToUpper(*timeInForce))
+		description += " " + strings.ToUpper(*timeInForce)
+	}
+	if *clientOrderId != "" {
+		svc.NewClientOrderId(*clientOrderId)
+	}
+	if !*yes {
+		if err := a.confirm(fmt.Sprintf("Place %s on %s?", description, a.cfg.BaseURL)); err != nil {
+			return err
+		}
+	}
+
+	res, err := svc.Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.print(res, []string{"SYMBOL", "ORDER ID", "CLIENT ORDER ID", "STATUS", "EXECUTED", "QUOTE EXECUTED"},
+		func() [][]string {
+			switch o := res.(type) {
+			case *binance_connector.CreateOrderResponseACK:
+				return [][]string{{o.Symbol, strconv.FormatInt(o.OrderId, 10), o.ClientOrderId, "", "", ""}}
+			case *binance_connector.CreateOrderResponseRESULT:
+				return [][]string{{o.Symbol, strconv.FormatInt(o.OrderId, 10), o.ClientOrderId, o.Status, o.ExecutedQty,
+					o.CumulativeQuoteQty}}
+			case *binance_connector.CreateOrderResponseFULL:
+				return [][]string{{o.Symbol, strconv.FormatInt(o.OrderId, 10), o.ClientOrderId, o.Status, o.ExecutedQty,
+					o.CumulativeQuoteQty}}
+			}
+			return nil
+		})
+}
+
+func cancelOrder(a *app, args []string) error {
+	fs := a.flags()
+	orderId := fs.Int64("id", 0, "order id")
+	clientOrderId := fs.String("client-id", "", "client order id")
+	yes := fs.Bool("yes", false, "cancel the order without confirmation")
+	args, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if (*orderId > 0) == (*clientOrderId != "") {
+		return fmt.Errorf("set one of -id and -client-id")
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+
+	symbol := strings.ToUpper(args[0])
+	svc := client.NewCancelOrderService().Symbol(symbol)
+	order := *clientOrderId
+	if *orderId > 0 {
+		svc.OrderId(*orderId)
+		order = strconv.FormatInt(*orderId, 10)
+	} else {
+		svc.OrigClientOrderId(*clientOrderId)
+	}
+	if !*yes {
+		if err := a.confirm(fmt.Sprintf("Cancel %s order %s on %s?", symbol, order, a.cfg.BaseURL)); err != nil {
+			return err
+		}
+	}
+
+	res, err := svc.Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.print(res, []string{"SYMBOL", "ORDER ID", "CLIENT ORDER ID", "STATUS", "EXECUTED"}, func() [][]string {
+		return [][]string{{res.Symbol, strconv.FormatInt(res.OrderId, 10), res.OrigClientOrderId, res.Status, res.ExecutedQty}}
+	})
+}
+
+// formatAmount formats an amount as it is sent to the API
+func formatAmount(x float64) string {
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
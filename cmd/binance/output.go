@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+// Output formats
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// print writes v as indented JSON, or as a table of header and the rows
+// returned by rows
+func (a *app) print(v any, header []string, rows func() [][]string) error {
+	if a.format == formatJSON {
+		_, err := fmt.Fprintln(a.out, binance_connector.PrettyPrint(v))
+		return err
+	}
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows() {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// printEvent writes a stream event as a line of JSON, or as the line
+// returned by line
+func (a *app) printEvent(v any, line func() string) {
+	if a.format == formatJSON {
+		data, err := json.Marshal(v)
+		if err != nil {
+			fmt.Fprintln(a.errOut, err)
+			return
+		}
+		fmt.Fprintln(a.out, string(data))
+		return
+	}
+	fmt.Fprintln(a.out, line())
+}
+
+// formatTime formats a timestamp in milliseconds
+func formatTime[T int64 | uint64](ms T) string {
+	return time.UnixMilli(int64(ms)).UTC().Format(time.RFC3339)
+}
+
+// formatFloat formats a price level of OrderBookResponse
+func formatFloat(f *big.Float) string {
+	if f == nil {
+		return ""
+	}
+	return f.Text('f', -1)
+}
+
+// side returns the side of a trade of the account
+func side(isBuyer bool) string {
+	if isBuyer {
+		return "BUY"
+	}
+	return "SELL"
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+var streamCommands = []*command{
+	{name: "depth", args: "SYMBOL", help: "order book updates, or the top levels with -levels", run: streamDepth},
+	{name: "trade", args: "SYMBOL", help: "trades", run: streamTrade},
+	{name: "kline", args: "SYMBOL", help: "kline updates", run: streamKline},
+	{name: "book", args: "SYMBOL", help: "best bid and ask", run: streamBookTicker},
+}
+
+// serveFunc starts a stream of client
+type serveFunc func(client *binance_connector.WebsocketStreamClient, errHandler binance_connector.ErrHandler) (doneCh, stopCh chan struct{}, err error)
+
+// tail prints the events of a stream until the context is done or the
+// stream fails
+func (a *app) tail(serve serveFunc) error {
+	var (
+		mu        sync.Mutex
+		streamErr error
+	)
+	errHandler := func(err error) {
+		mu.Lock()
+		streamErr = err
+		mu.Unlock()
+	}
+	doneCh, stopCh, err := serve(binance_connector.NewWebsocketStreamClient(false, a.cfg.StreamURL), errHandler)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-a.ctx.Done():
+		// the connection is left to the exit of the process, as the stream
+		// only stops on its next message
+		close(stopCh)
+		return nil
+	case <-doneCh:
+		mu.Lock()
+		defer mu.Unlock()
+		if streamErr == nil {
+			return errors.New("stream closed")
+		}
+		return streamErr
+	}
+}
+
+func streamDepth(a *app, args []string) error {
+	fs := a.flags()
+	levels := fs.Int("levels", 0, "print the top 5, 10 or 20 levels instead of the updates")
+	fast := fs.Bool("100ms", false, "update every 100ms instead of every second")
+	args, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	symbol := strings.ToLower(args[0])
+
+	if *levels == 0 {
+		handler := func(event *binance_connector.WsDepthEvent) {
+			a.printEvent(event, func() string {
+				var b strings.Builder
+				for _, bid := range event.Bids {
+					fmt.Fprintf(&b, "%s %s BID %s %s\n", formatTime(event.Time), event.Symbol, bid.Price, bid.Quantity)
+				}
+				for _, ask := range event.Asks {
+					fmt.Fprintf(&b, "%s %s ASK %s %s\n", formatTime(event.Time), event.Symbol, ask.Price, ask.Quantity)
+				}
+				return strings.TrimSuffix(b.String(), "\n")
+			})
+		}
+		return a.tail(func(client *binance_connector.WebsocketStreamClient, errHandler binance_connector.ErrHandler) (chan struct{}, chan struct{}, error) {
+			if *fast {
+				return client.WsDepthServe100Ms(symbol, handler, errHandler)
+			}
+			return client.WsDepthServe(symbol, handler, errHandler)
+		})
+	}
+
+	if *levels != 5 && *levels != 10 && *levels != 20 {
+		return fmt.Errorf("levels must be 5, 10 or 20")
+	}
+	handler := func(event *binance_connector.WsPartialDepthEvent) {
+		a.printEvent(event, func() string {
+			var b strings.Builder
+			fmt.Fprintf(&b, "%s %d\n", event.Symbol, event.LastUpdateID)
+			for i := len(event.Asks) - 1; i >= 0; i-- {
+				fmt.Fprintf(&b, "  ASK %s %s\n", event.Asks[i].Price, event.Asks[i].Quantity)
+			}
+			for _, bid := range event.Bids {
+				fmt.Fprintf(&b, "  BID %s %s\n", bid.Price, bid.Quantity)
+			}
+			return b.String()
+		})
+	}
+	return a.tail(func(client *binance_connector.WebsocketStreamClient, errHandler binance_connector.ErrHandler) (chan struct{}, chan struct{}, error) {
+		if *fast {
+			return client.WsPartialDepthServe100Ms(symbol, strconv.Itoa(*levels), handler, errHandler)
+		}
+		return client.WsPartialDepthServe(symbol, strconv.Itoa(*levels), handler, errHandler)
+	})
+}
+
+func streamTrade(a *app, args []string) error {
+	args, err := parse(a.flags(), args, 1)
+	if err != nil {
+		return err
+	}
+	handler := func(event *binance_connector.WsTradeEvent) {
+		a.printEvent(event, func() string {
+			// the taker sells when the buyer is the maker
+			return fmt.Sprintf("%s %s %s %s %s", formatTime(event.TradeTime), event.Symbol, side(!event.IsBuyerMaker),
+				event.Price, event.Quantity)
+		})
+	}
+	return a.tail(func(client *binance_connector.WebsocketStreamClient, errHandler binance_connector.ErrHandler) (chan struct{}, chan struct{}, error) {
+		return client.WsTradeServe(strings.ToLower(args[0]), handler, errHandler)
+	})
+}
+
+func streamKline(a *app, args []string) error {
+	fs := a.flags()
+	interval := fs.String("interval", "1m", "kline interval, such as 1m, 1h or 1d")
+	args, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	handler := func(event *binance_connector.WsKlineEvent) {
+		a.printEvent(event, func() string {
+			k := event.Kline
+			line := fmt.Sprintf("%s %s %s open %s high %s low %s close %s volume %s", formatTime(k.StartTime), event.Symbol,
+				k.Interval, k.Open, k.High, k.Low, k.Close, k.Volume)
+			if k.IsFinal {
+				line += " final"
+			}
+			return line
+		})
+	}
+	return a.tail(func(client *binance_connector.WebsocketStreamClient, errHandler binance_connector.ErrHandler) (chan struct{}, chan struct{}, error) {
+		return client.WsKlineServe(strings.ToLower(args[0]), *interval, handler, errHandler)
+	})
+}
+
+func streamBookTicker(a *app, args []string) error {
+	args, err := parse(a.flags(), args, 1)
+	if err != nil {
+		return err
+	}
+	handler := func(event *binance_connector.WsBookTickerEvent) {
+		a.printEvent(event, func() string {
+			// book ticker events carry no time
+			return fmt.Sprintf("%s %s BID %s %s ASK %s %s", time.Now().UTC().Format(time.RFC3339), event.Symbol,
+				event.BestBidPrice, event.BestBidQty, event.BestAskPrice, event.BestAskQty)
+		})
+	}
+	return a.tail(func(client *binance_connector.WebsocketStreamClient, errHandler binance_connector.ErrHandler) (chan struct{}, chan struct{}, error) {
+		return client.WsBookTickerServe(strings.ToLower(args[0]), handler, errHandler)
+	})
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+var walletCommands = []*command{
+	{name: "address", args: "COIN", help: "deposit address of a coin", run: depositAddress},
+	{name: "deposits", help: "deposit history", run: deposits},
+	{name: "withdrawals", help: "withdrawal history", run: withdrawals},
+}
+
+func depositAddress(a *app, args []string) error {
+	fs := a.flags()
+	network := fs.String("network", "", "network, the default network of the coin when empty")
+	args, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+	svc := client.NewDepositAddressService().Coin(strings.ToUpper(args[0]))
+	if *network != "" {
+		svc.Network(strings.ToUpper(*network))
+	}
+	address, err := svc.Do(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.print(address, []string{"COIN", "ADDRESS", "TAG"}, func() [][]string {
+		return [][]string{{address.Coin, address.Address, address.Tag}}
+	})
+}
+
+func deposits(a *app, args []string) error {
+	fs := a.flags()
+	coin := fs.String("coin", "", "coin, every coin when empty")
+	days := fs.Int("days", 30, "number of days of history")
+	if _, err := parse(fs, args, 0); err != nil {
+		return err
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+	svc := client.NewDepositHistoryService()
+	if *coin != "" {
+		svc.Coin(strings.ToUpper(*coin))
+	}
+	to := time.Now()
+	deposits, err := svc.Range(a.ctx, to.AddDate(0, 0, -*days), to).All()
+	if err != nil {
+		return err
+	}
+	if deposits == nil {
+		deposits = []*binance_connector.DepositHistoryResponse{}
+	}
+	return a.print(deposits, []string{"TIME", "COIN", "NETWORK", "AMOUNT", "STATUS", "TX ID"}, func() (rows [][]string) {
+		for _, d := range deposits {
+			rows = append(rows, []string{formatTime(d.InsertTime), d.Coin, d.Network, d.Amount,
+				binance_connector.DepositStatus(d.Status).String(), d.TxId})
+		}
+		return rows
+	})
+}
+
+func withdrawals(a *app, args []string) error {
+	fs := a.flags()
+	coin := fs.String("coin", "", "coin, every coin when empty")
+	days := fs.Int("days", 30, "number of days of history")
+	if _, err := parse(fs, args, 0); err != nil {
+		return err
+	}
+	client, err := a.client(true)
+	if err != nil {
+		return err
+	}
+	svc := client.NewWithdrawHistoryService()
+	if *coin != "" {
+		svc.Coin(strings.ToUpper(*coin))
+	}
+	to := time.Now()
+	withdrawals, err := svc.Range(a.ctx, to.AddDate(0, 0, -*days), to).All()
+	if err != nil {
+		return err
+	}
+	if withdrawals == nil {
+		withdrawals = []*binance_connector.WithdrawHistoryResponse{}
+	}
+	return a.print(withdrawals, []string{"TIME", "COIN", "NETWORK", "AMOUNT", "FEE", "STATUS", "ADDRESS", "TX ID"},
+		func() (rows [][]string) {
+			for _, w := range withdrawals {
+				rows = append(rows, []string{w.ApplyTime, w.Coin, w.Network, w.Amount, w.TransactionFee,
+					binance_connector.WithdrawStatus(w.Status).String(), w.Address, w.TxId})
+			}
+			return rows
+		})
+}
@@ -0,0 +1,709 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+)
+
+// List All Convert Pairs (MARKET_DATA) API Endpoint
+const (
+	convertExchangeInfoEndpoint = "/sapi/v1/convert/exchangeInfo"
+)
+
+// ConvertExchangeInfoService list the pairs and amount limits available to convert
+type ConvertExchangeInfoService struct {
+	c         *Client
+	fromAsset *string
+	toAsset   *string
+}
+
+// FromAsset set fromAsset
+func (s *ConvertExchangeInfoService) FromAsset(fromAsset string) *ConvertExchangeInfoService {
+	s.fromAsset = &fromAsset
+	return s
+}
+
+// ToAsset set toAsset
+func (s *ConvertExchangeInfoService) ToAsset(toAsset string) *ConvertExchangeInfoService {
+	s.toAsset = &toAsset
+	return s
+}
+
+// Do send request
+func (s *ConvertExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*ConvertExchangeInfoResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: convertExchangeInfoEndpoint,
+		secType:  secTypeNone,
+	}
+	if s.fromAsset != nil {
+		r.setParam("fromAsset", *s.fromAsset)
+	}
+	if s.toAsset != nil {
+		r.setParam("toAsset", *s.toAsset)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ConvertExchangeInfoResponse{}, err
+	}
+	res = make([]*ConvertExchangeInfoResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ConvertExchangeInfoResponse{}, err
+	}
+	return res, nil
+}
+
+// ConvertExchangeInfoResponse define convert exchange info response
+type ConvertExchangeInfoResponse struct {
+	FromAsset          string `json:"fromAsset"`
+	ToAsset            string `json:"toAsset"`
+	FromAssetMinAmount string `json:"fromAssetMinAmount"`
+	FromAssetMaxAmount string `json:"fromAssetMaxAmount"`
+	ToAssetMinAmount   string `json:"toAssetMinAmount"`
+	ToAssetMaxAmount   string `json:"toAssetMaxAmount"`
+}
+
+// Query order quantity precision per asset (USER_DATA) API Endpoint
+const (
+	convertAssetInfoEndpoint = "/sapi/v1/convert/assetInfo"
+)
+
+// ConvertAssetInfoService query the amount precision of every convertible asset
+type ConvertAssetInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ConvertAssetInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*ConvertAssetInfoResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: convertAssetInfoEndpoint,
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ConvertAssetInfoResponse{}, err
+	}
+	res = make([]*ConvertAssetInfoResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ConvertAssetInfoResponse{}, err
+	}
+	return res, nil
+}
+
+// ConvertAssetInfoResponse define convert asset info response
+type ConvertAssetInfoResponse struct {
+	Asset    string `json:"asset"`
+	Fraction int    `json:"fraction"`
+}
+
+// Send Quote Request (USER_DATA) API Endpoint
+const (
+	convertGetQuoteEndpoint = "/sapi/v1/convert/getQuote"
+)
+
+// ConvertGetQuoteService request a quote for a from/to asset pair
+type ConvertGetQuoteService struct {
+	c          *Client
+	fromAsset  string
+	toAsset    string
+	fromAmount *float64
+	toAmount   *float64
+	walletType *string
+	validTime  *string
+}
+
+// FromAsset set fromAsset
+func (s *ConvertGetQuoteService) FromAsset(fromAsset string) *ConvertGetQuoteService {
+	s.fromAsset = fromAsset
+	return s
+}
+
+// ToAsset set toAsset
+func (s *ConvertGetQuoteService) ToAsset(toAsset string) *ConvertGetQuoteService {
+	s.toAsset = toAsset
+	return s
+}
+
+// FromAmount set fromAmount, the amount spent. Either fromAmount or toAmount must be set
+func (s *ConvertGetQuoteService) FromAmount(fromAmount float64) *ConvertGetQuoteService {
+	s.fromAmount = &fromAmount
+	return s
+}
+
+// ToAmount set toAmount, the amount received. Either fromAmount or toAmount must be set
+func (s *ConvertGetQuoteService) ToAmount(toAmount float64) *ConvertGetQuoteService {
+	s.toAmount = &toAmount
+	return s
+}
+
+// WalletType set walletType, SPOT, FUNDING or SPOT_FUNDING
+func (s *ConvertGetQuoteService) WalletType(walletType string) *ConvertGetQuoteService {
+	s.walletType = &walletType
+	return s
+}
+
+// ValidTime set validTime, 10s, 30s, 1m or 2m
+func (s *ConvertGetQuoteService) ValidTime(validTime string) *ConvertGetQuoteService {
+	s.validTime = &validTime
+	return s
+}
+
+// Do send request
+func (s *ConvertGetQuoteService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertQuoteResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: convertGetQuoteEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("fromAsset", s.fromAsset)
+	r.setParam("toAsset", s.toAsset)
+	if s.fromAmount != nil {
+		r.setParam("fromAmount", strconv.FormatFloat(*s.fromAmount, 'f', -1, 64))
+	}
+	if s.toAmount != nil {
+		r.setParam("toAmount", strconv.FormatFloat(*s.toAmount, 'f', -1, 64))
+	}
+	if s.walletType != nil {
+		r.setParam("walletType", *s.walletType)
+	}
+	if s.validTime != nil {
+		r.setParam("validTime", *s.validTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertQuoteResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertQuoteResponse define convert quote response
+type ConvertQuoteResponse struct {
+	QuoteId        string `json:"quoteId"`
+	Ratio          string `json:"ratio"`
+	InverseRatio   string `json:"inverseRatio"`
+	ValidTimestamp int64  `json:"validTimestamp"`
+	ToAmount       string `json:"toAmount"`
+	FromAmount     string `json:"fromAmount"`
+}
+
+// Accept Quote (TRADE) API Endpoint
+const (
+	convertAcceptQuoteEndpoint = "/sapi/v1/convert/acceptQuote"
+)
+
+// ConvertAcceptQuoteService accept a quote returned by ConvertGetQuoteService
+type ConvertAcceptQuoteService struct {
+	c       *Client
+	quoteId string
+}
+
+// QuoteId set quoteId
+func (s *ConvertAcceptQuoteService) QuoteId(quoteId string) *ConvertAcceptQuoteService {
+	s.quoteId = quoteId
+	return s
+}
+
+// Do send request
+func (s *ConvertAcceptQuoteService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertAcceptQuoteResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: convertAcceptQuoteEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("quoteId", s.quoteId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertAcceptQuoteResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertAcceptQuoteResponse define convert accept quote response
+type ConvertAcceptQuoteResponse struct {
+	OrderId     string `json:"orderId"`
+	CreateTime  int64  `json:"createTime"`
+	OrderStatus string `json:"orderStatus"`
+}
+
+// Order status (USER_DATA) API Endpoint
+const (
+	convertOrderStatusEndpoint = "/sapi/v1/convert/orderStatus"
+)
+
+// ConvertOrderStatusService query the status of a convert order by orderId or quoteId
+type ConvertOrderStatusService struct {
+	c       *Client
+	orderId *string
+	quoteId *string
+}
+
+// OrderId set orderId
+func (s *ConvertOrderStatusService) OrderId(orderId string) *ConvertOrderStatusService {
+	s.orderId = &orderId
+	return s
+}
+
+// QuoteId set quoteId
+func (s *ConvertOrderStatusService) QuoteId(quoteId string) *ConvertOrderStatusService {
+	s.quoteId = &quoteId
+	return s
+}
+
+// Do send request
+func (s *ConvertOrderStatusService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertOrderStatusResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: convertOrderStatusEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.quoteId != nil {
+		r.setParam("quoteId", *s.quoteId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertOrderStatusResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertOrderStatusResponse define convert order status response
+type ConvertOrderStatusResponse struct {
+	OrderId      int64  `json:"orderId"`
+	OrderStatus  string `json:"orderStatus"`
+	FromAsset    string `json:"fromAsset"`
+	FromAmount   string `json:"fromAmount"`
+	ToAsset      string `json:"toAsset"`
+	ToAmount     string `json:"toAmount"`
+	Ratio        string `json:"ratio"`
+	InverseRatio string `json:"inverseRatio"`
+	CreateTime   int64  `json:"createTime"`
+}
+
+// Get Convert Trade History (USER_DATA) API Endpoint
+const (
+	convertTradeFlowEndpoint = "/sapi/v1/convert/tradeFlow"
+)
+
+// ConvertTradeFlowService get convert trade history, startTime and endTime at most 30 days apart
+type ConvertTradeFlowService struct {
+	c         *Client
+	startTime uint64
+	endTime   uint64
+	limit     *int
+}
+
+// StartTime set startTime
+func (s *ConvertTradeFlowService) StartTime(startTime uint64) *ConvertTradeFlowService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ConvertTradeFlowService) EndTime(endTime uint64) *ConvertTradeFlowService {
+	s.endTime = endTime
+	return s
+}
+
+// Limit set limit
+func (s *ConvertTradeFlowService) Limit(limit int) *ConvertTradeFlowService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ConvertTradeFlowService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertTradeFlowResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: convertTradeFlowEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("startTime", s.startTime)
+	r.setParam("endTime", s.endTime)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertTradeFlowResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertTradeFlowResponse define convert trade flow response
+type ConvertTradeFlowResponse struct {
+	List      []*ConvertTrade `json:"list"`
+	StartTime uint64          `json:"startTime"`
+	EndTime   uint64          `json:"endTime"`
+	Limit     int             `json:"limit"`
+	MoreData  bool            `json:"moreData"`
+}
+
+// ConvertTrade define a convert trade
+type ConvertTrade struct {
+	QuoteId      string `json:"quoteId"`
+	OrderId      int64  `json:"orderId"`
+	OrderStatus  string `json:"orderStatus"`
+	FromAsset    string `json:"fromAsset"`
+	FromAmount   string `json:"fromAmount"`
+	ToAsset      string `json:"toAsset"`
+	ToAmount     string `json:"toAmount"`
+	Ratio        string `json:"ratio"`
+	InverseRatio string `json:"inverseRatio"`
+	CreateTime   int64  `json:"createTime"`
+}
+
+// Place limit order (USER_DATA) API Endpoint
+const (
+	convertLimitPlaceOrderEndpoint = "/sapi/v1/convert/limit/placeOrder"
+)
+
+// ConvertLimitPlaceOrderService place a convert limit order
+type ConvertLimitPlaceOrderService struct {
+	c           *Client
+	baseAsset   string
+	quoteAsset  string
+	limitPrice  float64
+	baseAmount  *float64
+	quoteAmount *float64
+	side        string
+	walletType  *string
+	expiredType string
+}
+
+// BaseAsset set baseAsset
+func (s *ConvertLimitPlaceOrderService) BaseAsset(baseAsset string) *ConvertLimitPlaceOrderService {
+	s.baseAsset = baseAsset
+	return s
+}
+
+// QuoteAsset set quoteAsset
+func (s *ConvertLimitPlaceOrderService) QuoteAsset(quoteAsset string) *ConvertLimitPlaceOrderService {
+	s.quoteAsset = quoteAsset
+	return s
+}
+
+// LimitPrice set limitPrice
+func (s *ConvertLimitPlaceOrderService) LimitPrice(limitPrice float64) *ConvertLimitPlaceOrderService {
+	s.limitPrice = limitPrice
+	return s
+}
+
+// BaseAmount set baseAmount. Either baseAmount or quoteAmount must be set
+func (s *ConvertLimitPlaceOrderService) BaseAmount(baseAmount float64) *ConvertLimitPlaceOrderService {
+	s.baseAmount = &baseAmount
+	return s
+}
+
+// QuoteAmount set quoteAmount. Either baseAmount or quoteAmount must be set
+func (s *ConvertLimitPlaceOrderService) QuoteAmount(quoteAmount float64) *ConvertLimitPlaceOrderService {
+	s.quoteAmount = &quoteAmount
+	return s
+}
+
+// Side set side, BUY or SELL
+func (s *ConvertLimitPlaceOrderService) Side(side string) *ConvertLimitPlaceOrderService {
+	s.side = side
+	return s
+}
+
+// WalletType set walletType, SPOT, FUNDING or SPOT_FUNDING
+func (s *ConvertLimitPlaceOrderService) WalletType(walletType string) *ConvertLimitPlaceOrderService {
+	s.walletType = &walletType
+	return s
+}
+
+// ExpiredType set expiredType, 1_D, 3_D, 7_D or 30_D
+func (s *ConvertLimitPlaceOrderService) ExpiredType(expiredType string) *ConvertLimitPlaceOrderService {
+	s.expiredType = expiredType
+	return s
+}
+
+// Do send request
+func (s *ConvertLimitPlaceOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertLimitOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: convertLimitPlaceOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("baseAsset", s.baseAsset)
+	r.setParam("quoteAsset", s.quoteAsset)
+	r.setParam("limitPrice", strconv.FormatFloat(s.limitPrice, 'f', -1, 64))
+	r.setParam("side", s.side)
+	r.setParam("expiredType", s.expiredType)
+	if s.baseAmount != nil {
+		r.setParam("baseAmount", strconv.FormatFloat(*s.baseAmount, 'f', -1, 64))
+	}
+	if s.quoteAmount != nil {
+		r.setParam("quoteAmount", strconv.FormatFloat(*s.quoteAmount, 'f', -1, 64))
+	}
+	if s.walletType != nil {
+		r.setParam("walletType", *s.walletType)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertLimitOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertLimitOrderResponse define convert limit order response
+type ConvertLimitOrderResponse struct {
+	OrderId int64  `json:"orderId"`
+	Status  string `json:"status"`
+}
+
+// Cancel limit order (USER_DATA) API Endpoint
+const (
+	convertLimitCancelOrderEndpoint = "/sapi/v1/convert/limit/cancelOrder"
+)
+
+// ConvertLimitCancelOrderService cancel a convert limit order
+type ConvertLimitCancelOrderService struct {
+	c       *Client
+	orderId int64
+}
+
+// OrderId set orderId
+func (s *ConvertLimitCancelOrderService) OrderId(orderId int64) *ConvertLimitCancelOrderService {
+	s.orderId = orderId
+	return s
+}
+
+// Do send request
+func (s *ConvertLimitCancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertLimitOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: convertLimitCancelOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("orderId", s.orderId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertLimitOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Query limit open orders (USER_DATA) API Endpoint
+const (
+	convertLimitQueryOpenOrdersEndpoint = "/sapi/v1/convert/limit/queryOpenOrders"
+)
+
+// ConvertLimitQueryOpenOrdersService query the open convert limit orders
+type ConvertLimitQueryOpenOrdersService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ConvertLimitQueryOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertLimitOpenOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: convertLimitQueryOpenOrdersEndpoint,
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertLimitOpenOrdersResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertLimitOpenOrdersResponse define convert limit open orders response
+type ConvertLimitOpenOrdersResponse struct {
+	List []*ConvertLimitOpenOrder `json:"list"`
+}
+
+// ConvertLimitOpenOrder define an open convert limit order
+type ConvertLimitOpenOrder struct {
+	QuoteId          string `json:"quoteId"`
+	OrderId          int64  `json:"orderId"`
+	OrderStatus      string `json:"orderStatus"`
+	FromAsset        string `json:"fromAsset"`
+	FromAmount       string `json:"fromAmount"`
+	ToAsset          string `json:"toAsset"`
+	ToAmount         string `json:"toAmount"`
+	Ratio            string `json:"ratio"`
+	InverseRatio     string `json:"inverseRatio"`
+	CreateTime       int64  `json:"createTime"`
+	ExpiredTimestamp int64  `json:"expiredTimestamp"`
+}
+
+// ErrConvertQuoteExpired is returned by ConvertService when every quote it requested expired before it was accepted
+var ErrConvertQuoteExpired = errors.New("convert quote expired")
+
+// convertQuoteExpiredCode is the error code of an accept of an expired quote
+const convertQuoteExpiredCode = -23000
+
+// ConvertService convert between two assets: it requests a quote, accepts it and
+// waits for the order to settle. A quote about to expire, or one whose accept
+// is rejected as expired, is replaced with a fresh quote, up to MaxQuotes
+// times. Other accept errors are returned, as the quote may have been accepted.
+type ConvertService struct {
+	c            *Client
+	quote        ConvertGetQuoteService
+	maxQuotes    int
+	expiryMargin time.Duration
+	pollInterval time.Duration
+	now          func() int64
+}
+
+// FromAsset set fromAsset
+func (s *ConvertService) FromAsset(fromAsset string) *ConvertService {
+	s.quote.FromAsset(fromAsset)
+	return s
+}
+
+// ToAsset set toAsset
+func (s *ConvertService) ToAsset(toAsset string) *ConvertService {
+	s.quote.ToAsset(toAsset)
+	return s
+}
+
+// FromAmount set fromAmount, the amount spent. Either fromAmount or toAmount must be set
+func (s *ConvertService) FromAmount(fromAmount float64) *ConvertService {
+	s.quote.FromAmount(fromAmount)
+	return s
+}
+
+// ToAmount set toAmount, the amount received. Either fromAmount or toAmount must be set
+func (s *ConvertService) ToAmount(toAmount float64) *ConvertService {
+	s.quote.ToAmount(toAmount)
+	return s
+}
+
+// WalletType set walletType, SPOT, FUNDING or SPOT_FUNDING
+func (s *ConvertService) WalletType(walletType string) *ConvertService {
+	s.quote.WalletType(walletType)
+	return s
+}
+
+// ValidTime set validTime of the quotes, 10s, 30s, 1m or 2m
+func (s *ConvertService) ValidTime(validTime string) *ConvertService {
+	s.quote.ValidTime(validTime)
+	return s
+}
+
+// MaxQuotes set the number of quotes requested before giving up, 3 by default
+func (s *ConvertService) MaxQuotes(maxQuotes int) *ConvertService {
+	s.maxQuotes = maxQuotes
+	return s
+}
+
+// ExpiryMargin set how long before its validTimestamp a quote is considered expired, 1s by default
+func (s *ConvertService) ExpiryMargin(expiryMargin time.Duration) *ConvertService {
+	s.expiryMargin = expiryMargin
+	return s
+}
+
+// PollInterval set the interval between order status queries, 1s by default
+func (s *ConvertService) PollInterval(pollInterval time.Duration) *ConvertService {
+	s.pollInterval = pollInterval
+	return s
+}
+
+// Do convert. The returned order is in status SUCCESS or FAIL.
+func (s *ConvertService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertResult, err error) {
+	res = new(ConvertResult)
+	for res.Quotes < s.maxQuotes {
+		quote, err := s.quote.Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		res.Quotes++
+		res.Quote = quote
+		if s.expired(quote) {
+			continue
+		}
+		accepted, err := s.c.NewConvertAcceptQuoteService().QuoteId(quote.QuoteId).Do(ctx, opts...)
+		if err != nil {
+			var apiErr *handlers.APIError
+			if errors.As(err, &apiErr) && apiErr.Code == convertQuoteExpiredCode {
+				continue
+			}
+			return nil, err
+		}
+		res.Accepted = accepted
+		res.Order, err = s.wait(ctx, accepted.OrderId, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	return nil, ErrConvertQuoteExpired
+}
+
+func (s *ConvertService) expired(quote *ConvertQuoteResponse) bool {
+	return s.now()+s.expiryMargin.Milliseconds() >= quote.ValidTimestamp
+}
+
+func (s *ConvertService) wait(ctx context.Context, orderId string, opts ...RequestOption) (*ConvertOrderStatusResponse, error) {
+	for {
+		order, err := s.c.NewConvertOrderStatusService().OrderId(orderId).Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if order.OrderStatus == "SUCCESS" || order.OrderStatus == "FAIL" {
+			return order, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// ConvertResult define the result of ConvertService
+type ConvertResult struct {
+	Quote    *ConvertQuoteResponse // the accepted quote
+	Quotes   int                   // number of quotes requested
+	Accepted *ConvertAcceptQuoteResponse
+	Order    *ConvertOrderStatusResponse
+}
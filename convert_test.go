@@ -0,0 +1,255 @@
+package binance_connector
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+	"github.com/stretchr/testify/suite"
+)
+
+type convertTestSuite struct {
+	baseTestSuite
+}
+
+func TestConvert(t *testing.T) {
+	suite.Run(t, new(convertTestSuite))
+}
+
+func (s *convertTestSuite) TestConvertExchangeInfo() {
+	data := []byte(`[
+		{
+			"fromAsset": "BTC",
+			"toAsset": "USDT",
+			"fromAssetMinAmount": "0.0004",
+			"fromAssetMaxAmount": "50",
+			"toAssetMinAmount": "20",
+			"toAssetMaxAmount": "2500000"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"fromAsset": "BTC",
+			"toAsset":   "USDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewConvertExchangeInfoService().FromAsset("BTC").ToAsset("USDT").Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp, 1)
+	s.Equal("0.0004", resp[0].FromAssetMinAmount)
+	s.Equal("2500000", resp[0].ToAssetMaxAmount)
+}
+
+func (s *convertTestSuite) TestConvertGetQuote() {
+	data := []byte(`{
+		"quoteId": "12415572564",
+		"ratio": "38163.7",
+		"inverseRatio": "0.0000262",
+		"validTimestamp": 1623319461670,
+		"toAmount": "3816.37",
+		"fromAmount": "0.1"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"fromAsset":  "BTC",
+			"toAsset":    "USDT",
+			"fromAmount": "0.1",
+			"validTime":  "30s",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewConvertGetQuoteService().FromAsset("BTC").ToAsset("USDT").FromAmount(0.1).
+		ValidTime("30s").Do(newContext())
+	s.r().NoError(err)
+	s.Equal("12415572564", resp.QuoteId)
+	s.Equal(int64(1623319461670), resp.ValidTimestamp)
+	s.Equal("3816.37", resp.ToAmount)
+}
+
+func (s *convertTestSuite) TestConvertTradeFlow() {
+	data := []byte(`{
+		"list": [
+			{
+				"quoteId": "f3b91c525b2644c7bc1e1cd31b6e1aa6",
+				"orderId": 940708407462087195,
+				"orderStatus": "SUCCESS",
+				"fromAsset": "USDT",
+				"fromAmount": "20",
+				"toAsset": "BNB",
+				"toAmount": "0.06154036",
+				"ratio": "0.00307702",
+				"inverseRatio": "324.99",
+				"createTime": 1624248872184
+			}
+		],
+		"startTime": 1623824139000,
+		"endTime": 1626416139000,
+		"limit": 100,
+		"moreData": false
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"startTime": 1623824139000,
+			"endTime":   1626416139000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewConvertTradeFlowService().StartTime(1623824139000).EndTime(1626416139000).
+		Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp.List, 1)
+	s.Equal(int64(940708407462087195), resp.List[0].OrderId)
+	s.Equal("SUCCESS", resp.List[0].OrderStatus)
+	s.False(resp.MoreData)
+}
+
+func (s *convertTestSuite) TestConvertLimitPlaceOrder() {
+	data := []byte(`{
+		"orderId": 1603680255057330400,
+		"status": "PROCESS"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"baseAsset":   "BTC",
+			"quoteAsset":  "USDT",
+			"limitPrice":  "60000",
+			"baseAmount":  "0.01",
+			"side":        "SELL",
+			"expiredType": "7_D",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewConvertLimitPlaceOrderService().BaseAsset("BTC").QuoteAsset("USDT").
+		LimitPrice(60000).BaseAmount(0.01).Side("SELL").ExpiredType("7_D").Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(1603680255057330400), resp.OrderId)
+	s.Equal("PROCESS", resp.Status)
+}
+
+// convertExchange answers the convert quote, accept and order status endpoints
+type convertExchange struct {
+	now             int64
+	validTimestamps []int64 // validTimestamp of each quote handed out, in order
+	statuses        []string
+	expireOnAccept  bool
+	quoted          int
+	accepted        []string
+}
+
+func (e *convertExchange) client() *Client {
+	return newFakeClient(fakeRoutes{
+		"/sapi/v1/convert/getQuote": func(req *http.Request) (interface{}, error) {
+			e.quoted++
+			return fmt.Sprintf(`{"quoteId":"q%d","ratio":"1","inverseRatio":"1","validTimestamp":%d}`,
+				e.quoted, e.validTimestamps[e.quoted-1]), nil
+		},
+		"/sapi/v1/convert/acceptQuote": func(req *http.Request) (interface{}, error) {
+			if e.expireOnAccept {
+				e.expireOnAccept = false
+				e.now = e.validTimestamps[e.quoted-1]
+				return nil, &handlers.APIError{Code: -23000, Message: "Quote expired"}
+			}
+			e.accepted = append(e.accepted, req.URL.Query().Get("quoteId"))
+			return `{"orderId":"933256278426274426","createTime":1623381330472,"orderStatus":"PROCESS"}`, nil
+		},
+		"/sapi/v1/convert/orderStatus": func(req *http.Request) (interface{}, error) {
+			status := e.statuses[0]
+			e.statuses = e.statuses[1:]
+			return fmt.Sprintf(`{"orderId":933256278426274426,"orderStatus":"%s"}`, status), nil
+		},
+	}.serve)
+}
+
+func (s *convertTestSuite) TestConvertRequotesExpiredQuotes() {
+	r := s.Require()
+	exchange := &convertExchange{
+		now: 1000000,
+		// the first quote is already inside the expiry margin, the second expires while being accepted
+		validTimestamps: []int64{1000500, 1005000, 1010000},
+		statuses:        []string{"PROCESS", "SUCCESS"},
+		expireOnAccept:  true,
+	}
+	service := exchange.client().NewConvertService().FromAsset("USDT").ToAsset("BTC").FromAmount(100).
+		PollInterval(time.Millisecond)
+	service.now = func() int64 { return exchange.now }
+
+	res, err := service.Do(newContext())
+	r.NoError(err)
+	r.Equal(3, res.Quotes)
+	r.Equal("q3", res.Quote.QuoteId)
+	r.Equal([]string{"q3"}, exchange.accepted)
+	r.Equal("SUCCESS", res.Order.OrderStatus)
+}
+
+func (s *convertTestSuite) TestConvertGivesUpAfterMaxQuotes() {
+	r := s.Require()
+	exchange := &convertExchange{now: 1000000, validTimestamps: []int64{999000, 1000200}}
+	service := exchange.client().NewConvertService().FromAsset("USDT").ToAsset("BTC").FromAmount(100).MaxQuotes(2)
+	service.now = func() int64 { return exchange.now }
+
+	_, err := service.Do(newContext())
+	r.ErrorIs(err, ErrConvertQuoteExpired)
+	r.Equal(2, exchange.quoted)
+	r.Empty(exchange.accepted)
+}
+
+func (s *convertTestSuite) TestConvertReturnsAcceptErrors() {
+	r := s.Require()
+	exchange := &convertExchange{now: 1000000, validTimestamps: []int64{1060000}, statuses: []string{"SUCCESS"}}
+	client := exchange.client()
+	next := client.HTTPClient.Transport
+	client.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/sapi/v1/convert/acceptQuote" {
+			return newHTTPResponse([]byte(`{"code":-1003,"msg":"Too many requests"}`), http.StatusTooManyRequests), nil
+		}
+		return next.RoundTrip(req)
+	})
+	service := client.NewConvertService().FromAsset("USDT").ToAsset("BTC").FromAmount(100)
+	service.now = func() int64 { return exchange.now }
+
+	_, err := service.Do(newContext())
+	r.Error(err)
+	r.Equal(1, exchange.quoted)
+}
+
+func (s *convertTestSuite) TestConvertDoesNotRequoteUnknownAccepts() {
+	r := s.Require()
+	exchange := &convertExchange{now: 1000000, validTimestamps: []int64{1060000, 1120000}}
+	client := exchange.client()
+	next := client.HTTPClient.Transport
+	client.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/sapi/v1/convert/acceptQuote" {
+			// the accept times out after the quote expired, it may have gone through
+			exchange.now = 1060000
+			return nil, errors.New("i/o timeout")
+		}
+		return next.RoundTrip(req)
+	})
+	service := client.NewConvertService().FromAsset("USDT").ToAsset("BTC").FromAmount(100)
+	service.now = func() int64 { return exchange.now }
+
+	_, err := service.Do(newContext())
+	r.Error(err)
+	r.NotErrorIs(err, ErrConvertQuoteExpired)
+	r.Equal(1, exchange.quoted)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	AccountCommission()
+}
+
+func AccountCommission() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// Query Commission Rates (USER_DATA) - GET /api/v3/account/commission
+	commission, err := client.NewGetAccountCommissionService().
+		Symbol("BTCUSDT").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(commission))
+
+	fees := binance_connector.NewFeeCalculator(commission)
+	estimate, err := fees.Estimate(binance_connector.FeeOrder{
+		Symbol:     "BTCUSDT",
+		Side:       "BUY",
+		Price:      "60000",
+		Quantity:   "0.001",
+		BaseAsset:  "BTC",
+		QuoteAsset: "USDT",
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(estimate.Commission.FloatString(8), estimate.CommissionAsset)
+}
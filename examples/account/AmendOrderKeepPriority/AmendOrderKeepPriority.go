@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	AmendOrderKeepPriority()
+}
+
+func AmendOrderKeepPriority() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// Order Amend Keep Priority (TRADE) - PUT /api/v3/order/amend/keepPriority
+	amended, err := client.NewAmendOrderKeepPriorityService().Symbol("BTCUSDT").
+		OrderId(12345).NewQty(0.0005).Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(amended))
+
+	// Query Order Amendments (USER_DATA) - GET /api/v3/order/amendments
+	amendments, err := client.NewQueryOrderAmendmentsService().Symbol("BTCUSDT").
+		OrderId(12345).Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(amendments))
+}
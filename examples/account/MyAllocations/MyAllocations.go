@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	MyAllocations()
+}
+
+func MyAllocations() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// Query Allocations (USER_DATA) - GET /api/v3/myAllocations
+	myAllocations, err := client.NewMyAllocationsService().
+		Symbol("BTCUSDT").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(myAllocations))
+}
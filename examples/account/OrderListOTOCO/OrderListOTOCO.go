@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	OrderListOTOCO()
+}
+
+func OrderListOTOCO() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// New Order list - OTOCO (TRADE) - POST /api/v3/orderList/otoco
+	orderList, err := client.NewOrderListOTOCOService().Symbol("BTCUSDT").
+		Working(binance_connector.NewOrderListLeg("LIMIT").Price(60000).TimeInForce("GTC")).
+		WorkingSide("BUY").WorkingQuantity(0.001).
+		PendingSide("SELL").PendingQuantity(0.001).
+		PendingAbove(binance_connector.NewOrderListLeg("LIMIT_MAKER").Price(66000)).
+		PendingBelow(binance_connector.NewOrderListLeg("STOP_LOSS_LIMIT").StopPrice(57000).Price(56900).TimeInForce("GTC")).
+		Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(orderList))
+}
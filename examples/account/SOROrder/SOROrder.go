@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	SOROrder()
+}
+
+func SOROrder() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// New order using SOR (TRADE) - POST /api/v3/sor/order
+	sorOrder, err := client.NewSOROrderService().Symbol("BTCUSDT").
+		Side("BUY").Type("LIMIT").TimeInForce("GTC").
+		Quantity(0.001).Price(20000).
+		Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(sorOrder))
+}
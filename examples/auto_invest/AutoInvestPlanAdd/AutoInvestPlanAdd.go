@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	AutoInvestPlanAdd()
+}
+
+func AutoInvestPlanAdd() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// AutoInvestPlanAddService - POST /sapi/v1/lending/auto-invest/plan/add
+	plan, err := client.NewAutoInvestPlanAddService().SourceType("MAIN_SITE").PlanType("PORTFOLIO").
+		SubscriptionAmount(50).SubscriptionCycle("WEEKLY").SubscriptionStartWeekday("MON").
+		SubscriptionStartTime(8).SourceAsset("USDT").
+		Details(
+			binance_connector.AutoInvestPlanDetail{TargetAsset: "BTC", Percentage: 60},
+			binance_connector.AutoInvestPlanDetail{TargetAsset: "ETH", Percentage: 40},
+		).Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(plan))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	Convert()
+}
+
+func Convert() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// ConvertService - POST /sapi/v1/convert/getQuote, POST /sapi/v1/convert/acceptQuote
+	convert, err := client.NewConvertService().FromAsset("USDT").ToAsset("USDC").
+		FromAmount(1000).ValidTime("10s").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(convert))
+}
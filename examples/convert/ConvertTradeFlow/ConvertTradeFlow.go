@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	ConvertTradeFlow()
+}
+
+func ConvertTradeFlow() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// ConvertTradeFlowService - GET /sapi/v1/convert/tradeFlow
+	convertTradeFlow, err := client.NewConvertTradeFlowService().
+		StartTime(1623824139000).EndTime(1626416139000).Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(convertTradeFlow))
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+	"github.com/binance/binance-connector-go/ledger"
+)
+
+func main() {
+	TradeLedger()
+}
+
+func TradeLedger() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// GetMyTradesService.Range - /api/v3/myTrades
+	to := time.Now()
+	trades, err := client.NewGetMyTradesService().Symbol("BTCUSDT").
+		Range(context.Background(), to.AddDate(0, 0, -30), to).All()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fills, err := ledger.FromMyTrades(trades)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	l := ledger.New(ledger.FIFO)
+	ledger.Sort(fills)
+	if err := l.Add(fills...); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// TickerPrices - /api/v3/ticker/price
+	tickers, err := client.NewTickerPricesService().Symbols([]string{"BTCUSDT"}).Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	prices := make(map[string]*big.Rat)
+	for _, ticker := range tickers {
+		if price, ok := new(big.Rat).SetString(ticker.Price); ok {
+			prices[ticker.Symbol] = price
+		}
+	}
+
+	summary := l.Summary(prices)
+	for _, p := range summary.Positions {
+		fmt.Println(p.Symbol, p.Qty.FloatString(8), "realized", p.RealizedPnl.FloatString(8), p.Quote)
+		if p.UnrealizedPnl != nil {
+			fmt.Println(p.Symbol, "unrealized", p.UnrealizedPnl.FloatString(8), p.Quote)
+		}
+	}
+}
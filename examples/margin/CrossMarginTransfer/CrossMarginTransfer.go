@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	CrossMarginTransfer()
+}
+
+func CrossMarginTransfer() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// CrossMarginTransferService - POST /sapi/v1/margin/transfer
+	crossMarginTransfer, err := client.NewCrossMarginTransferService().Asset("USDT").
+		Amount(100).Type(1).Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(crossMarginTransfer))
+}
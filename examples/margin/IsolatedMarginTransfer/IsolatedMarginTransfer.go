@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	IsolatedMarginTransfer()
+}
+
+func IsolatedMarginTransfer() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// IsolatedMarginTransferService - POST /sapi/v1/asset/transfer
+	isolatedMarginTransfer, err := client.NewIsolatedMarginTransferService().Asset("USDT").
+		Symbol("BTCUSDT").Amount(100).Direction(binance_connector.IsolatedMarginTransferIn).
+		Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(isolatedMarginTransfer))
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	MarginBorrowRepay()
+}
+
+func MarginBorrowRepay() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// MarginBorrowRepayService - POST /sapi/v1/margin/borrow-repay
+	marginBorrowRepay, err := client.NewMarginBorrowRepayService().Asset("USDT").
+		Amount(100).Type("BORROW").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(marginBorrowRepay))
+
+	// MarginBorrowRepayRecordService - GET /sapi/v1/margin/borrow-repay
+	marginBorrowRepayRecord, err := client.NewMarginBorrowRepayRecordService().Type("BORROW").
+		Asset("USDT").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(marginBorrowRepayRecord))
+}
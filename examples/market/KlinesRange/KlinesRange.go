@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	KlinesRange()
+}
+
+func KlinesRange() {
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient("", "", baseURL)
+
+	// KlinesRange - page through one day of 1m klines
+	to := time.Now()
+	from := to.Add(-24 * time.Hour)
+	it := client.KlinesRange(context.Background(), "BTCUSDT", "1m", from, to,
+		binance_connector.WithPageInterval(100*time.Millisecond))
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(count)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	OptionsMarkPrice()
+}
+
+func OptionsMarkPrice() {
+	baseURL := "https://eapi.binance.com"
+
+	client := binance_connector.NewOptionsClient("", "", baseURL)
+
+	// OptionsMarkPriceService - GET /eapi/v1/mark
+	markPrice, err := client.NewOptionsMarkPriceService().Symbol("BTC-240628-70000-C").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(markPrice))
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	OptionsUserData()
+}
+
+func OptionsUserData() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://eapi.binance.com"
+
+	client := binance_connector.NewOptionsClient(apiKey, secretKey, baseURL)
+
+	// OptionsCreateListenKeyService - POST /eapi/v1/listenKey
+	listenKey, err := client.NewOptionsCreateListenKeyService().Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	websocketStreamClient := binance_connector.NewWebsocketStreamClient(false, "wss://nbstream.binance.com/eoptions")
+
+	wsOptionsUserDataHandler := func(event *binance_connector.WsOptionsUserDataEvent) {
+		fmt.Println(binance_connector.PrettyPrint(event))
+	}
+
+	errHandler := func(err error) {
+		fmt.Println(err)
+	}
+
+	doneCh, stopCh, err := websocketStreamClient.WsOptionsUserDataServe(listenKey, wsOptionsUserDataHandler, errHandler)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	go func() {
+		time.Sleep(30 * time.Second)
+		stopCh <- struct{}{}
+	}()
+
+	<-doneCh
+}
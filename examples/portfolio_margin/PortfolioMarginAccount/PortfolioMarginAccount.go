@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	PortfolioMarginAccount()
+}
+
+func PortfolioMarginAccount() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://papi.binance.com"
+
+	client := binance_connector.NewPortfolioMarginClient(apiKey, secretKey, baseURL)
+
+	// PortfolioMarginAccountService - GET /papi/v1/account
+	account, err := client.NewPortfolioMarginAccountService().Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(account))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	PortfolioMarginUMNewOrder()
+}
+
+func PortfolioMarginUMNewOrder() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://papi.binance.com"
+
+	client := binance_connector.NewPortfolioMarginClient(apiKey, secretKey, baseURL)
+
+	// PortfolioMarginUMNewOrderService - POST /papi/v1/um/order
+	order, err := client.NewPortfolioMarginUMNewOrderService().Symbol("BTCUSDT").
+		Side(binance_connector.SideTypeBuy).Type(binance_connector.OrderTypeLimit).
+		TimeInForce(binance_connector.TimeInForceTypeGTC).Quantity("0.01").Price("30000").
+		Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(order))
+}
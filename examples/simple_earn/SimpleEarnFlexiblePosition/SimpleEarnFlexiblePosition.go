@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	SimpleEarnFlexiblePosition()
+}
+
+func SimpleEarnFlexiblePosition() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// SimpleEarnFlexiblePositionService - GET /sapi/v1/simple-earn/flexible/position
+	position, err := client.NewSimpleEarnFlexiblePositionService().
+		Asset("USDT").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(position))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	SimpleEarnFlexibleSubscribe()
+}
+
+func SimpleEarnFlexibleSubscribe() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// SimpleEarnFlexibleSubscribeService - POST /sapi/v1/simple-earn/flexible/subscribe
+	subscribe, err := client.NewSimpleEarnFlexibleSubscribeService().
+		ProductId("USDT001").Amount(100).Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(subscribe))
+}
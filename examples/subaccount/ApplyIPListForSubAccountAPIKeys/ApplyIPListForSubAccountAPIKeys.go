@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	ApplyIPListForSubAccountAPIKeys()
+}
+
+func ApplyIPListForSubAccountAPIKeys() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// SubAccountFleet.ApplyIPList - POST /sapi/v2/sub-account/subaccountApi/ipRestriction and DELETE /sapi/v1/sub-account/subaccountApi/ipRestriction/ipList
+	fleet := binance_connector.NewSubAccountFleet(client)
+	applied, err := fleet.ApplyIPList(context.Background(), []binance_connector.SubAccountAPIKey{
+		{Email: "alice@email.com", ApiKey: "alice api key"},
+		{Email: "bob@email.com", ApiKey: "bob api key"},
+	}, []string{"203.0.113.10", "203.0.113.11"})
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(binance_connector.PrettyPrint(applied))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	MoveFuturesPositionForSubAccount()
+}
+
+func MoveFuturesPositionForSubAccount() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// Move Position for Sub-account (For Master Account) - /sapi/v1/sub-account/futures/move-position
+	moveFuturesPositionForSubAccount, err := client.NewMoveFuturesPositionForSubAccountService().
+		FromUserEmail("alice@email.com").ToUserEmail("bob@email.com").ProductType("UM").
+		OrderArgs(binance_connector.MovePositionOrderArg{Symbol: "BTCUSDT", Quantity: 0.001, PositionSide: binance_connector.PositionSideTypeBoth}).
+		Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(moveFuturesPositionForSubAccount))
+}
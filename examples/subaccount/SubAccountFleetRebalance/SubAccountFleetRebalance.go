@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	SubAccountFleetRebalance()
+}
+
+func SubAccountFleetRebalance() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// SubAccountFleet - GET /sapi/v1/sub-account/list, GET /sapi/v3/sub-account/assets and POST /sapi/v1/asset/universalTransfer
+	fleet := binance_connector.NewSubAccountFleet(client)
+
+	emails, err := fleet.Emails(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	balances, err := fleet.Balances(context.Background(), emails)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(binance_connector.PrettyPrint(balances.Total))
+
+	// keep 1000 USDT in the futures wallet of every sub-account, printing the transfers without sending them
+	transfers, err := fleet.Rebalance(context.Background(), binance_connector.RebalancePlan{
+		Asset:       "USDT",
+		Amount:      1000,
+		AccountType: binance_connector.AccountTypeUSDTFuture,
+		Emails:      emails,
+		MinTransfer: 10,
+	}, true)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, transfer := range transfers {
+		fmt.Println(transfer)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	AccountSnapshotExport()
+}
+
+func AccountSnapshotExport() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// AccountSnapshotExporter.Export - /sapi/v1/accountSnapshot, /sapi/v1/asset/assetDividend, /sapi/v1/asset/dribblet and /fapi/v1/income
+	exporter := binance_connector.NewAccountSnapshotExporter(client)
+	exporter.Markets = []string{binance_connector.AccountSnapshotTypeSpot, binance_connector.AccountSnapshotTypeMargin,
+		binance_connector.AccountSnapshotTypeFutures}
+	exporter.FuturesClient = binance_connector.NewClient(apiKey, secretKey, "https://fapi.binance.com")
+
+	file, err := os.Create("ledger.csv")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer file.Close()
+
+	to := time.Now()
+	err = exporter.Export(context.Background(), file, binance_connector.LedgerFormatCSV, to.AddDate(0, -1, 0), to)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("ledger written to ledger.csv")
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	BalanceSnapshot()
+}
+
+func BalanceSnapshot() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// BalanceAggregator - spot, funding, margin, futures and Simple Earn balances valued with GET /api/v3/ticker/price
+	aggregator := binance_connector.NewBalanceAggregator(client, "USDT")
+	aggregator.FuturesClient = binance_connector.NewClient(apiKey, secretKey, "https://fapi.binance.com")
+
+	before, err := aggregator.Snapshot(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(before.ByAsset()))
+	fmt.Println("total:", before.Total, before.Quote)
+
+	time.Sleep(time.Minute)
+	after, err := aggregator.Snapshot(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(before.Diff(after)))
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	DepositHistoryRange()
+}
+
+func DepositHistoryRange() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// DepositHistoryService Range - walk a full year of deposits in 90 day windows
+	to := time.Now()
+	from := to.AddDate(-1, 0, 0)
+	deposits, err := client.NewDepositHistoryService().Coin("USDT").
+		Range(context.Background(), from, to).All()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(deposits))
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	FundingTracker()
+}
+
+func FundingTracker() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// FundingTracker - GET /sapi/v1/capital/deposit/hisrec, /sapi/v1/capital/withdraw/history and /sapi/v1/capital/deposit/subHisrec
+	tracker := binance_connector.NewFundingTracker(client, time.Now().Add(-24*time.Hour), func(event binance_connector.FundingEvent) {
+		transfer := event.Transfer
+		if event.Previous == nil {
+			fmt.Println(transfer.Kind, transfer.Id, transfer.Amount, transfer.Coin, transfer.Status())
+			return
+		}
+		fmt.Println(transfer.Kind, transfer.Id, event.Previous.Status(), "->", transfer.Status(), "balance updated:", transfer.BalanceUpdated)
+	})
+
+	// cross-check deposits and withdrawals against the balanceUpdate events of the user data stream
+	listenKey, err := client.NewCreateListenKeyService().Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	websocketStreamClient := binance_connector.NewWebsocketStreamClient(false)
+	_, stopCh, err := websocketStreamClient.WsUserDataServe(listenKey, tracker.BalanceUpdate, func(err error) {
+		fmt.Println(err)
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer close(stopCh)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if err := tracker.Run(ctx); err != nil {
+		fmt.Println(err)
+	}
+}
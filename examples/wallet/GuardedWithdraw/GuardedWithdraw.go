@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	GuardedWithdraw()
+}
+
+func GuardedWithdraw() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// WithdrawalGuard - POST /sapi/v1/capital/withdraw/apply after the allowlist, limits, daily cap and approval checks
+	guard := binance_connector.NewWithdrawalGuard(client, func(ctx context.Context, req *binance_connector.WithdrawalRequest) error {
+		fmt.Printf("withdraw %v %s to %s on %s? [y/N] ", req.Amount, req.Coin, req.Address, req.Network)
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		if strings.TrimSpace(answer) != "y" {
+			return fmt.Errorf("declined")
+		}
+		return nil
+	})
+	guard.Allow(binance_connector.WithdrawalAddress{Coin: "BTC", Network: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"})
+	guard.SetDailyCap("BTC", 0.1)
+
+	withdrawal, err := guard.Withdraw(context.Background(), binance_connector.WithdrawalRequest{
+		Coin:    "BTC",
+		Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+		Amount:  0.01,
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(withdrawal))
+}
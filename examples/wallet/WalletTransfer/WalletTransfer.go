@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	WalletTransfer()
+}
+
+func WalletTransfer() {
+	apiKey := "your api key"
+	secretKey := "your secret key"
+	baseURL := "https://api.binance.com"
+
+	client := binance_connector.NewClient(apiKey, secretKey, baseURL)
+
+	// TransferOrchestrator.Transfer - POST /sapi/v1/asset/transfer, followed with GET /sapi/v1/asset/transfer
+	orchestrator := binance_connector.NewTransferOrchestrator(client)
+	spot := binance_connector.Wallet{Kind: binance_connector.WalletKindSpot}
+	futures := binance_connector.Wallet{Kind: binance_connector.WalletKindFutures}
+	transfer, err := orchestrator.Transfer(context.Background(), spot, futures, "USDT", 10)
+	if errors.Is(err, binance_connector.ErrTransferUnconfirmed) {
+		// safe to retry: the transfer is looked up before being sent again
+		transfer, err = orchestrator.TransferWithId(context.Background(), transfer.ClientTranId, spot, futures, "USDT", 10)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(transfer))
+
+	// between accounts - POST /sapi/v1/asset/universalTransfer with a clientTranId
+	subFutures := binance_connector.Wallet{Kind: binance_connector.WalletKindFutures, Email: "sub@email.com"}
+	transfer, err = orchestrator.Transfer(context.Background(), spot, subFutures, "USDT", 10)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(transfer))
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	PaperTradingExample()
+}
+
+func PaperTradingExample() {
+	paper := binance_connector.NewPaperTrader()
+	paper.AddSymbol("BTCUSDT", "BTC", "USDT")
+	paper.SetBalance("USDT", "10000")
+	paper.SetTradeFee("BTCUSDT", "0.001", "0.001")
+	paper.OnUserData(func(event *binance_connector.WsUserDataEvent) {
+		fmt.Println(binance_connector.PrettyPrint(event))
+	})
+
+	websocketStreamClient := binance_connector.NewWebsocketStreamClient(false)
+	errHandler := func(err error) {
+		fmt.Println(err)
+	}
+	_, stopCh, err := websocketStreamClient.WsBookTickerServe("BTCUSDT", paper.BookTickerHandler(), errHandler)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer close(stopCh)
+	time.Sleep(2 * time.Second)
+
+	// orders placed through this client are simulated
+	client := binance_connector.NewClient("", "")
+	client.HTTPClient = &http.Client{Transport: paper.Transport(nil)}
+	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").
+		Side("BUY").Type("MARKET").QuoteOrderQty(100).
+		Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance_connector.PrettyPrint(order))
+}
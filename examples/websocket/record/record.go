@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	WsRecordExample()
+}
+
+func WsRecordExample() {
+	recorder, err := binance_connector.NewRecorder("depth.jsonl.gz")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer recorder.Close()
+	// record every stream started from now on
+	detach := recorder.Attach()
+	defer detach()
+
+	websocketStreamClient := binance_connector.NewWebsocketStreamClient(false)
+	wsDepthHandler := func(event *binance_connector.WsDepthEvent) {
+		fmt.Println(event.LastUpdateID)
+	}
+	errHandler := func(err error) {
+		fmt.Println(err)
+	}
+	doneCh, stopCh, err := websocketStreamClient.WsDepthServe("BTCUSDT", wsDepthHandler, errHandler)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	go func() {
+		time.Sleep(30 * time.Second)
+		stopCh <- struct{}{}
+	}()
+	<-doneCh
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	WsReplayExample()
+}
+
+func WsReplayExample() {
+	// replay ten times faster than recorded
+	replayer, err := binance_connector.NewReplayer("depth.jsonl.gz", 10)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	detach := replayer.Attach()
+	defer detach()
+
+	websocketStreamClient := binance_connector.NewWebsocketStreamClient(false)
+	wsDepthHandler := func(event *binance_connector.WsDepthEvent) {
+		fmt.Println(binance_connector.PrettyPrint(event))
+	}
+	errHandler := func(err error) {
+		fmt.Println(err)
+	}
+	doneCh, _, err := websocketStreamClient.WsDepthServe("BTCUSDT", wsDepthHandler, errHandler)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := replayer.Run(context.Background()); err != nil {
+		fmt.Println(err)
+	}
+	<-doneCh
+}
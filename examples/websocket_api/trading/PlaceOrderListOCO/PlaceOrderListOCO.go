@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+func main() {
+	PlaceOrderListOCOExample()
+}
+
+func PlaceOrderListOCOExample() {
+	client := binance_connector.NewWebsocketAPIClient("api_key", "secret_key", "wss://ws-api.testnet.binance.vision/ws-api/v3")
+	err := client.Connect()
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return
+	}
+	defer client.Close()
+
+	response, err := client.NewPlaceOrderListOCOService().Symbol("BTCUSDT").Side("SELL").Quantity(0.001).
+		Above(binance_connector.NewOrderListLeg("LIMIT_MAKER").Price(70000)).
+		Below(binance_connector.NewOrderListLeg("STOP_LOSS").StopPrice(55000)).
+		Do(context.Background())
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return
+	}
+
+	fmt.Println(binance_connector.PrettyPrint(response))
+
+	client.WaitForCloseSignal()
+}
@@ -0,0 +1,273 @@
+package binance_connector
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FeeCalculator estimates trade commissions from the rates returned by
+// GetAccountCommissionService and checks them against the fills of placed
+// orders. Amounts are exact decimals, like those of PaperTrader and the ledger
+// package.
+type FeeCalculator struct {
+	// Tolerance is the largest difference between an estimated and a charged
+	// commission still considered a match, as Binance rounds commissions to
+	// their precision. Defaults to 0.00000001.
+	Tolerance *big.Rat
+
+	mu    sync.RWMutex
+	rates map[string]*AccountCommissionResponse
+}
+
+// FeeOrder describes the trade to estimate the commission of. Amounts are
+// decimal strings, as in the API.
+type FeeOrder struct {
+	Symbol   string
+	Side     string // BUY or SELL
+	IsMaker  bool
+	Price    string
+	Quantity string
+	// BaseAsset and QuoteAsset of the symbol. The commission is charged on the
+	// asset received: the base asset for a BUY and the quote asset for a SELL.
+	BaseAsset  string
+	QuoteAsset string
+	// DiscountAssetPrice is the value of one unit of the received asset in the
+	// discount asset, e.g. BNB. When set and the discount is enabled for the
+	// account and the symbol, the commission is estimated in the discount asset.
+	DiscountAssetPrice string
+}
+
+// FeeEstimate define the estimated commission of a trade
+type FeeEstimate struct {
+	Rate            *big.Rat // combined standard and tax rate applied to the received amount
+	Commission      *big.Rat
+	CommissionAsset string
+}
+
+// FeeFillCheck compares the commission charged on one fill with its estimate
+type FeeFillCheck struct {
+	Price        *big.Rat
+	Qty          *big.Rat
+	Expected     *FeeEstimate
+	Charged      *big.Rat
+	ChargedAsset string
+	Matches      bool
+}
+
+// FeeReconciliation define the result of FeeCalculator.Reconcile
+type FeeReconciliation struct {
+	Fills    []*FeeFillCheck
+	Expected map[string]*big.Rat // estimated commission by asset
+	Charged  map[string]*big.Rat // charged commission by asset
+}
+
+// Matches reports whether every fill was charged its estimated commission
+func (r *FeeReconciliation) Matches() bool {
+	for _, fill := range r.Fills {
+		if !fill.Matches {
+			return false
+		}
+	}
+	return true
+}
+
+// NewFeeCalculator create a FeeCalculator using the given rates
+func NewFeeCalculator(rates ...*AccountCommissionResponse) *FeeCalculator {
+	f := &FeeCalculator{
+		Tolerance: big.NewRat(1, 100000000),
+		rates:     make(map[string]*AccountCommissionResponse),
+	}
+	for _, r := range rates {
+		f.SetRates(r)
+	}
+	return f
+}
+
+// SetRates set the commission rates of rates.Symbol
+func (f *FeeCalculator) SetRates(rates *AccountCommissionResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rates[rates.Symbol] = rates
+}
+
+// LoadRates fetch the commission rates of symbols with GetAccountCommissionService
+func (f *FeeCalculator) LoadRates(ctx context.Context, c *Client, symbols ...string) error {
+	for _, symbol := range symbols {
+		rates, err := c.NewGetAccountCommissionService().Symbol(symbol).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if rates.Symbol == "" {
+			rates.Symbol = symbol
+		}
+		f.SetRates(rates)
+	}
+	return nil
+}
+
+// Estimate the commission of order
+func (f *FeeCalculator) Estimate(order FeeOrder) (*FeeEstimate, error) {
+	f.mu.RLock()
+	rates, ok := f.rates[order.Symbol]
+	f.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no commission rates for %s", order.Symbol)
+	}
+
+	quantity, err := parseDecimal(order.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("quantity: %w", err)
+	}
+	var received *big.Rat
+	var asset string
+	switch order.Side {
+	case "BUY":
+		received, asset = quantity, order.BaseAsset
+	case "SELL":
+		price, err := parseDecimal(order.Price)
+		if err != nil {
+			return nil, fmt.Errorf("price: %w", err)
+		}
+		received, asset = quantity.Mul(quantity, price), order.QuoteAsset
+	default:
+		return nil, fmt.Errorf("unsupported side %q", order.Side)
+	}
+
+	standard, err := rates.StandardCommission.rate(order.Side, order.IsMaker)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := rates.TaxCommission.rate(order.Side, order.IsMaker)
+	if err != nil {
+		return nil, err
+	}
+	discountPrice, err := parseDecimal(order.DiscountAssetPrice)
+	if err != nil {
+		return nil, fmt.Errorf("discount asset price: %w", err)
+	}
+
+	if d := rates.Discount; d != nil && d.EnabledForAccount && d.EnabledForSymbol && discountPrice.Sign() > 0 {
+		discount, err := parseDecimal(d.Discount)
+		if err != nil {
+			return nil, err
+		}
+		// standard*(1-discount) + tax
+		rate := new(big.Rat).Sub(big.NewRat(1, 1), discount)
+		rate.Mul(rate, standard).Add(rate, tax)
+		commission := new(big.Rat).Mul(received, rate)
+		return &FeeEstimate{
+			Rate:            rate,
+			Commission:      commission.Mul(commission, discountPrice),
+			CommissionAsset: d.DiscountAsset,
+		}, nil
+	}
+	rate := new(big.Rat).Add(standard, tax)
+	return &FeeEstimate{
+		Rate:            rate,
+		Commission:      new(big.Rat).Mul(received, rate),
+		CommissionAsset: asset,
+	}, nil
+}
+
+// Reconcile estimate the commission of every fill of res and compare it with
+// the commission charged. Fills are taker trades; order supplies the assets
+// and discount price, its Symbol and Side default to those of res.
+func (f *FeeCalculator) Reconcile(order FeeOrder, res *CreateOrderResponseFULL) (*FeeReconciliation, error) {
+	if order.Symbol == "" {
+		order.Symbol = res.Symbol
+	}
+	if order.Side == "" {
+		order.Side = res.Side
+	}
+	order.IsMaker = false
+
+	reconciliation := &FeeReconciliation{
+		Expected: make(map[string]*big.Rat),
+		Charged:  make(map[string]*big.Rat),
+	}
+	for _, fill := range res.Fills {
+		price, err := parseDecimal(fill.Price)
+		if err != nil {
+			return nil, fmt.Errorf("trade %d price: %w", fill.TradeId, err)
+		}
+		qty, err := parseDecimal(fill.Qty)
+		if err != nil {
+			return nil, fmt.Errorf("trade %d qty: %w", fill.TradeId, err)
+		}
+		charged, err := parseDecimal(fill.Commission)
+		if err != nil {
+			return nil, fmt.Errorf("trade %d commission: %w", fill.TradeId, err)
+		}
+		order.Price, order.Quantity = fill.Price, fill.Qty
+		expected, err := f.Estimate(order)
+		if err != nil {
+			return nil, err
+		}
+		diff := new(big.Rat).Sub(expected.Commission, charged)
+		reconciliation.Fills = append(reconciliation.Fills, &FeeFillCheck{
+			Price:        price,
+			Qty:          qty,
+			Expected:     expected,
+			Charged:      charged,
+			ChargedAsset: fill.CommissionAsset,
+			Matches:      expected.CommissionAsset == fill.CommissionAsset && diff.Abs(diff).Cmp(f.Tolerance) <= 0,
+		})
+		addDecimal(reconciliation.Expected, expected.CommissionAsset, expected.Commission)
+		addDecimal(reconciliation.Charged, fill.CommissionAsset, charged)
+	}
+	return reconciliation, nil
+}
+
+func addDecimal(m map[string]*big.Rat, asset string, amount *big.Rat) {
+	if v, ok := m[asset]; ok {
+		v.Add(v, amount)
+		return
+	}
+	m[asset] = new(big.Rat).Set(amount)
+}
+
+// rate returns the rate applying to a trade on side, as maker or taker
+func (r *CommissionRates) rate(side string, isMaker bool) (*big.Rat, error) {
+	if r == nil {
+		return new(big.Rat), nil
+	}
+	liquidity, direction := r.Taker, r.Seller
+	if isMaker {
+		liquidity = r.Maker
+	}
+	if side == "BUY" {
+		direction = r.Buyer
+	}
+	a, err := parseDecimal(liquidity)
+	if err != nil {
+		return nil, err
+	}
+	b, err := parseDecimal(direction)
+	if err != nil {
+		return nil, err
+	}
+	return a.Add(a, b), nil
+}
+
+// parseDecimal parses a decimal string of the API, zero when empty
+func parseDecimal(s string) (*big.Rat, error) {
+	if s == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	return r, nil
+}
+
+func parseRate(rate string) (float64, error) {
+	if rate == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(rate, 64)
+}
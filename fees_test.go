@@ -0,0 +1,80 @@
+package binance_connector
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type feeCalculatorTestSuite struct {
+	suite.Suite
+	fees *FeeCalculator
+}
+
+func TestFeeCalculator(t *testing.T) {
+	suite.Run(t, new(feeCalculatorTestSuite))
+}
+
+func (s *feeCalculatorTestSuite) SetupTest() {
+	s.fees = NewFeeCalculator(&AccountCommissionResponse{
+		Symbol:             "BTCUSDT",
+		StandardCommission: &CommissionRates{Maker: "0.00080000", Taker: "0.00100000", Buyer: "0.00000000", Seller: "0.00000000"},
+		TaxCommission:      &CommissionRates{Maker: "0.00000000", Taker: "0.00010000", Buyer: "0.00000000", Seller: "0.00000000"},
+		Discount:           &CommissionDiscount{EnabledForAccount: true, EnabledForSymbol: true, DiscountAsset: "BNB", Discount: "0.25000000"},
+	})
+}
+
+func (s *feeCalculatorTestSuite) TestEstimate() {
+	r := s.Require()
+	buy, err := s.fees.Estimate(FeeOrder{Symbol: "BTCUSDT", Side: "BUY", Price: "30000", Quantity: "2", BaseAsset: "BTC", QuoteAsset: "USDT"})
+	r.NoError(err)
+	r.Equal("BTC", buy.CommissionAsset)
+	r.Equal("0.0011", buy.Rate.FloatString(4))
+	r.Equal("11/5000", buy.Commission.String())
+
+	sell, err := s.fees.Estimate(FeeOrder{Symbol: "BTCUSDT", Side: "SELL", IsMaker: true, Price: "30000", Quantity: "2", BaseAsset: "BTC", QuoteAsset: "USDT"})
+	r.NoError(err)
+	r.Equal("USDT", sell.CommissionAsset)
+	r.Equal("48/1", sell.Commission.String())
+
+	// the received 2 BTC are worth 100 BNB each, the standard part is discounted by 25%
+	bnb, err := s.fees.Estimate(FeeOrder{Symbol: "BTCUSDT", Side: "BUY", Price: "30000", Quantity: "2", BaseAsset: "BTC", DiscountAssetPrice: "100"})
+	r.NoError(err)
+	r.Equal("BNB", bnb.CommissionAsset)
+	r.Equal("17/100", bnb.Commission.String())
+
+	// 0.1 and 0.2 have no exact binary representation
+	exact, err := s.fees.Estimate(FeeOrder{Symbol: "BTCUSDT", Side: "SELL", Price: "0.1", Quantity: "0.2", BaseAsset: "BTC", QuoteAsset: "USDT"})
+	r.NoError(err)
+	r.Equal("0.0000220000", exact.Commission.FloatString(10))
+	r.Equal(0, exact.Commission.Cmp(big.NewRat(22, 1000000)))
+
+	_, err = s.fees.Estimate(FeeOrder{Symbol: "ETHUSDT", Side: "BUY", Quantity: "1"})
+	r.Error(err)
+	_, err = s.fees.Estimate(FeeOrder{Symbol: "BTCUSDT", Side: "BUY", Quantity: "1e"})
+	r.EqualError(err, `quantity: invalid decimal "1e"`)
+}
+
+func (s *feeCalculatorTestSuite) TestReconcile() {
+	r := s.Require()
+	res := new(CreateOrderResponseFULL)
+	r.NoError(json.Unmarshal([]byte(`{
+		"symbol": "BTCUSDT",
+		"side": "BUY",
+		"fills": [
+			{"price": "30000.00000000", "qty": "1.00000000", "commission": "0.00110000", "commissionAsset": "BTC", "tradeId": 1},
+			{"price": "30001.00000000", "qty": "0.50000000", "commission": "0.00100000", "commissionAsset": "BTC", "tradeId": 2}
+		]
+	}`), res))
+
+	reconciliation, err := s.fees.Reconcile(FeeOrder{BaseAsset: "BTC", QuoteAsset: "USDT"}, res)
+	r.NoError(err)
+	r.Len(reconciliation.Fills, 2)
+	r.True(reconciliation.Fills[0].Matches)
+	r.False(reconciliation.Fills[1].Matches)
+	r.False(reconciliation.Matches())
+	r.Equal("0.00165000", reconciliation.Expected["BTC"].FloatString(8))
+	r.Equal("0.00210000", reconciliation.Charged["BTC"].FloatString(8))
+}
@@ -0,0 +1,395 @@
+package binance_connector
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// FundingKind define the kind of a FundingTransfer
+type FundingKind string
+
+// Funding kinds
+const (
+	FundingKindDeposit           FundingKind = "DEPOSIT"
+	FundingKindWithdrawal        FundingKind = "WITHDRAWAL"
+	FundingKindSubAccountDeposit FundingKind = "SUB_ACCOUNT_DEPOSIT"
+)
+
+// fundingOverlap is how far before its cursor each history is polled again,
+// for transfers that show up late in the history
+const fundingOverlap = 10 * time.Minute
+
+// fundingRetention is how long final transfers older than the cursors are
+// kept for late balanceUpdate events
+const fundingRetention = 24 * time.Hour
+
+// maxUnmatchedBalanceUpdates bounds the balanceUpdate events kept by
+// FundingTracker.Unmatched, the oldest are dropped first
+const maxUnmatchedBalanceUpdates = 1000
+
+// FundingTransfer define a deposit or withdrawal followed by FundingTracker
+type FundingTransfer struct {
+	Kind       FundingKind
+	Id         string
+	Email      string // sub-account of FundingKindSubAccountDeposit
+	Coin       string
+	Network    string
+	Amount     string
+	Fee        string // withdrawal fee
+	Address    string
+	AddressTag string
+	TxId       string
+	Time       int64 // insert time of deposits and apply time of withdrawals, in ms
+	// DepositStatus is set for deposits and WithdrawStatus for withdrawals
+	DepositStatus  DepositStatus
+	WithdrawStatus WithdrawStatus
+	// BalanceUpdated is set once a balanceUpdate event of the user data stream
+	// matched the transfer
+	BalanceUpdated bool
+}
+
+// Status returns the deposit or withdrawal status of the transfer
+func (t *FundingTransfer) Status() string {
+	if t.Kind == FundingKindWithdrawal {
+		return t.WithdrawStatus.String()
+	}
+	return t.DepositStatus.String()
+}
+
+// Final reports whether the transfer can no longer change status
+func (t *FundingTransfer) Final() bool {
+	if t.Kind == FundingKindWithdrawal {
+		return t.WithdrawStatus.Final()
+	}
+	return t.DepositStatus.Final()
+}
+
+// awaited reports whether the history is polled for a change of the
+// transfer. Wrong deposits wait for a recovery by support that can take
+// weeks, so they do not hold the cursor of their history back.
+func (t *FundingTransfer) awaited() bool {
+	return !t.Final() && !(t.Kind != FundingKindWithdrawal && t.DepositStatus == DepositStatusWrongDeposit)
+}
+
+func (t *FundingTransfer) key() string {
+	return string(t.Kind) + "/" + t.Email + "/" + t.Id
+}
+
+// FundingEvent define a change of a FundingTransfer
+type FundingEvent struct {
+	Transfer FundingTransfer
+	// Previous is the transfer before the change, nil the first time it is seen
+	Previous *FundingTransfer
+}
+
+// FundingHandler handles the events of FundingTracker
+type FundingHandler func(event FundingEvent)
+
+// FundingCursor define where FundingTracker resumes polling each history, in ms.
+// Save it with Cursor and restore it with SetCursor to resume after a restart.
+type FundingCursor struct {
+	Deposits           int64
+	Withdrawals        int64
+	SubAccountDeposits map[string]int64 // by sub-account email
+}
+
+// FundingTracker polls DepositHistoryService, WithdrawHistoryService and the
+// deposit history of sub-accounts, and emits a FundingEvent each time a
+// transfer is first seen or changes status. Transfers are polled again until
+// they reach a final status, except wrong deposits, which are reported but
+// not polled again once the history moved past them.
+type FundingTracker struct {
+	Handler FundingHandler
+	// PollInterval between two polls of Run, 1m by default
+	PollInterval time.Duration
+	// SubAccounts lists the emails of the sub-accounts whose deposits are tracked
+	SubAccounts []string
+
+	c         *Client
+	mu        sync.Mutex
+	cursor    FundingCursor
+	transfers map[string]*FundingTransfer
+	unmatched []WsBalanceUpdate
+	now       func() time.Time
+}
+
+// NewFundingTracker create a FundingTracker following the transfers made since
+// since, at most 90 days ago
+func NewFundingTracker(c *Client, since time.Time, handler FundingHandler) *FundingTracker {
+	from := FormatTimestamp(since)
+	return &FundingTracker{
+		Handler:      handler,
+		PollInterval: time.Minute,
+		c:            c,
+		cursor:       FundingCursor{Deposits: from, Withdrawals: from, SubAccountDeposits: make(map[string]int64)},
+		transfers:    make(map[string]*FundingTransfer),
+		now:          time.Now,
+	}
+}
+
+// Cursor returns the current cursor of each history
+func (t *FundingTracker) Cursor() FundingCursor {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	cursor := t.cursor
+	cursor.SubAccountDeposits = make(map[string]int64, len(t.cursor.SubAccountDeposits))
+	for email, from := range t.cursor.SubAccountDeposits {
+		cursor.SubAccountDeposits[email] = from
+	}
+	return cursor
+}
+
+// SetCursor set where each history is polled from. Transfers already
+// reported before a restart are reported again as first seen.
+func (t *FundingTracker) SetCursor(cursor FundingCursor) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cursor = cursor
+	if t.cursor.SubAccountDeposits == nil {
+		t.cursor.SubAccountDeposits = make(map[string]int64)
+	}
+}
+
+// Pending returns the tracked transfers that did not reach a final status
+func (t *FundingTracker) Pending() []FundingTransfer {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var res []FundingTransfer
+	for _, transfer := range t.transfers {
+		if !transfer.Final() {
+			res = append(res, *transfer)
+		}
+	}
+	return res
+}
+
+// Unmatched returns the balanceUpdate events that matched no tracked transfer
+func (t *FundingTracker) Unmatched() []WsBalanceUpdate {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]WsBalanceUpdate(nil), t.unmatched...)
+}
+
+// Run polls every PollInterval until ctx is done or a poll fails
+func (t *FundingTracker) Run(ctx context.Context) error {
+	for {
+		if err := t.Poll(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(t.PollInterval):
+		}
+	}
+}
+
+// Poll every history once and emit the events of the transfers that changed
+func (t *FundingTracker) Poll(ctx context.Context) error {
+	now := t.now()
+	t.mu.Lock()
+	cursor := t.cursor
+	t.mu.Unlock()
+
+	var transfers []*FundingTransfer
+	deposits, err := t.c.NewDepositHistoryService().Range(ctx, fundingFrom(cursor.Deposits, now), now).All()
+	if err != nil {
+		return err
+	}
+	for _, d := range deposits {
+		transfers = append(transfers, &FundingTransfer{
+			Kind: FundingKindDeposit, Id: d.Id, Coin: d.Coin, Network: d.Network, Amount: d.Amount,
+			Address: d.Address, AddressTag: d.AddressTag, TxId: d.TxId, Time: int64(d.InsertTime),
+			DepositStatus: DepositStatus(d.Status),
+		})
+	}
+	withdrawals, err := t.c.NewWithdrawHistoryService().Range(ctx, fundingFrom(cursor.Withdrawals, now), now).All()
+	if err != nil {
+		return err
+	}
+	for _, w := range withdrawals {
+		applyTime, err := time.Parse("2006-01-02 15:04:05", w.ApplyTime)
+		if err != nil {
+			return fmt.Errorf("withdrawal %s: invalid applyTime %q: %w", w.Id, w.ApplyTime, err)
+		}
+		transfers = append(transfers, &FundingTransfer{
+			Kind: FundingKindWithdrawal, Id: w.Id, Coin: w.Coin, Network: w.Network, Amount: w.Amount,
+			Fee: w.TransactionFee, Address: w.Address, TxId: w.TxId, Time: FormatTimestamp(applyTime),
+			WithdrawStatus: WithdrawStatus(w.Status),
+		})
+	}
+	for _, email := range t.SubAccounts {
+		from := fundingFrom(cursor.Deposits, now)
+		if c, ok := cursor.SubAccountDeposits[email]; ok {
+			from = fundingFrom(c, now)
+		}
+		deposits, err := t.c.NewGetSubAccountDepositHistoryService().Email(email).Range(ctx, from, now).All()
+		if err != nil {
+			return err
+		}
+		for _, d := range deposits {
+			transfers = append(transfers, &FundingTransfer{
+				Kind: FundingKindSubAccountDeposit, Id: strconv.FormatInt(d.Id, 10), Email: email, Coin: d.Coin,
+				Network: d.Network, Amount: d.Amount, Address: d.Address, AddressTag: d.AddressTag, TxId: d.TxId,
+				Time: int64(d.InsertTime), DepositStatus: DepositStatus(d.Status),
+			})
+		}
+	}
+
+	t.mu.Lock()
+	var events []FundingEvent
+	for _, transfer := range transfers {
+		events = append(events, t.update(transfer)...)
+	}
+	events = append(events, t.matchUnmatched()...)
+	t.advance(now)
+	t.mu.Unlock()
+
+	t.emit(events)
+	return nil
+}
+
+// BalanceUpdate cross-checks a balanceUpdate event of the user data stream
+// against the tracked deposits and withdrawals, and emits an event for the
+// transfer it matches. Other events are ignored, so it can be called from a
+// WsUserDataHandler with every event.
+func (t *FundingTracker) BalanceUpdate(event *WsUserDataEvent) {
+	if event.Event != UserDataEventTypeBalanceUpdate {
+		return
+	}
+	t.mu.Lock()
+	events := t.match(event.BalanceUpdate)
+	if events == nil {
+		t.unmatched = append(t.unmatched, event.BalanceUpdate)
+		if len(t.unmatched) > maxUnmatchedBalanceUpdates {
+			t.unmatched = t.unmatched[len(t.unmatched)-maxUnmatchedBalanceUpdates:]
+		}
+	}
+	t.mu.Unlock()
+	t.emit(events)
+}
+
+// update stores transfer and returns its event when it is new or changed.
+// t.mu must be held.
+func (t *FundingTracker) update(transfer *FundingTransfer) []FundingEvent {
+	previous, ok := t.transfers[transfer.key()]
+	if ok {
+		transfer.BalanceUpdated = previous.BalanceUpdated
+		if previous.Status() == transfer.Status() {
+			return nil
+		}
+	}
+	t.transfers[transfer.key()] = transfer
+	event := FundingEvent{Transfer: *transfer}
+	if ok {
+		p := *previous
+		event.Previous = &p
+	}
+	return []FundingEvent{event}
+}
+
+// match marks the transfer matching update as BalanceUpdated. Deposits raise
+// the balance by their amount, withdrawals lower it by their amount with or
+// without their fee. t.mu must be held.
+func (t *FundingTracker) match(update WsBalanceUpdate) []FundingEvent {
+	change, err := strconv.ParseFloat(update.Change, 64)
+	if err != nil || change == 0 {
+		return nil
+	}
+	for _, transfer := range t.transfers {
+		if transfer.BalanceUpdated || transfer.Coin != update.Asset {
+			continue
+		}
+		amount, _ := strconv.ParseFloat(transfer.Amount, 64)
+		fee, _ := strconv.ParseFloat(transfer.Fee, 64)
+		switch {
+		case transfer.Kind == FundingKindDeposit && change > 0 && sameAmount(change, amount):
+		case transfer.Kind == FundingKindWithdrawal && change < 0 &&
+			(sameAmount(-change, amount) || sameAmount(-change, amount+fee)):
+		default:
+			continue
+		}
+		previous := *transfer
+		transfer.BalanceUpdated = true
+		return []FundingEvent{{Transfer: *transfer, Previous: &previous}}
+	}
+	return nil
+}
+
+// matchUnmatched retries the balance updates received before their transfer
+// was polled. t.mu must be held.
+func (t *FundingTracker) matchUnmatched() []FundingEvent {
+	var events []FundingEvent
+	unmatched := t.unmatched[:0]
+	for _, update := range t.unmatched {
+		if e := t.match(update); e != nil {
+			events = append(events, e...)
+			continue
+		}
+		unmatched = append(unmatched, update)
+	}
+	t.unmatched = unmatched
+	return events
+}
+
+// advance moves each cursor to its oldest awaited transfer, or to now when
+// none is awaited, and forgets the other transfers long before the cursors.
+// t.mu must be held.
+func (t *FundingTracker) advance(now time.Time) {
+	deposits, withdrawals := FormatTimestamp(now), FormatTimestamp(now)
+	subAccounts := make(map[string]int64, len(t.SubAccounts))
+	for _, email := range t.SubAccounts {
+		subAccounts[email] = FormatTimestamp(now)
+	}
+	for _, transfer := range t.transfers {
+		if !transfer.awaited() {
+			continue
+		}
+		switch transfer.Kind {
+		case FundingKindDeposit:
+			deposits = min(deposits, transfer.Time)
+		case FundingKindWithdrawal:
+			withdrawals = min(withdrawals, transfer.Time)
+		case FundingKindSubAccountDeposit:
+			subAccounts[transfer.Email] = min(subAccounts[transfer.Email], transfer.Time)
+		}
+	}
+	t.cursor = FundingCursor{Deposits: deposits, Withdrawals: withdrawals, SubAccountDeposits: subAccounts}
+
+	oldest := min(deposits, withdrawals)
+	for _, from := range subAccounts {
+		oldest = min(oldest, from)
+	}
+	for key, transfer := range t.transfers {
+		if !transfer.awaited() && transfer.Time < oldest-fundingRetention.Milliseconds() {
+			delete(t.transfers, key)
+		}
+	}
+}
+
+func (t *FundingTracker) emit(events []FundingEvent) {
+	if t.Handler == nil {
+		return
+	}
+	for _, event := range events {
+		t.Handler(event)
+	}
+}
+
+// fundingFrom returns where to poll a history with cursor from, at most 90
+// days before now
+func fundingFrom(cursor int64, now time.Time) time.Time {
+	from := time.UnixMilli(cursor).Add(-fundingOverlap)
+	if oldest := now.Add(-90 * 24 * time.Hour); from.Before(oldest) {
+		return oldest
+	}
+	return from
+}
+
+func sameAmount(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
@@ -0,0 +1,163 @@
+package binance_connector
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type fundingTrackerTestSuite struct {
+	suite.Suite
+	exchange *fundingExchange
+	tracker  *FundingTracker
+	events   []FundingEvent
+	now      time.Time
+}
+
+func TestFundingTracker(t *testing.T) {
+	suite.Run(t, new(fundingTrackerTestSuite))
+}
+
+// fundingExchange fakes the deposit and withdrawal history endpoints
+type fundingExchange struct {
+	deposits    []*DepositHistoryResponse
+	withdrawals []*WithdrawHistoryResponse
+	subDeposits []*SubAccountDeposit
+	queries     map[string][]url.Values
+}
+
+func (e *fundingExchange) client() *Client {
+	routes := fakeRoutes{
+		"/sapi/v1/capital/deposit/hisrec": func(*http.Request) (interface{}, error) {
+			return e.deposits, nil
+		},
+		"/sapi/v1/capital/withdraw/history": func(*http.Request) (interface{}, error) {
+			return e.withdrawals, nil
+		},
+		"/sapi/v1/capital/deposit/subHisrec": func(*http.Request) (interface{}, error) {
+			return e.subDeposits, nil
+		},
+	}
+	return newFakeClient(func(req *http.Request) (interface{}, error) {
+		e.queries[req.URL.Path] = append(e.queries[req.URL.Path], req.URL.Query())
+		return routes.serve(req)
+	})
+}
+
+func (s *fundingTrackerTestSuite) SetupTest() {
+	s.exchange = &fundingExchange{queries: make(map[string][]url.Values)}
+	s.events = nil
+	s.now = time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.tracker = NewFundingTracker(s.exchange.client(), s.now.Add(-time.Hour), func(event FundingEvent) {
+		s.events = append(s.events, event)
+	})
+	s.tracker.now = func() time.Time { return s.now }
+}
+
+func (s *fundingTrackerTestSuite) TestPoll() {
+	r := s.Require()
+	depositTime := s.now.Add(-30 * time.Minute)
+	s.exchange.deposits = []*DepositHistoryResponse{
+		{Id: "d1", Coin: "USDT", Amount: "100", Status: int(DepositStatusPending), InsertTime: uint64(FormatTimestamp(depositTime))},
+	}
+	s.exchange.withdrawals = []*WithdrawHistoryResponse{
+		{Id: "w1", Coin: "BTC", Amount: "0.1", TransactionFee: "0.0001", Status: int(WithdrawStatusCompleted), ApplyTime: "2023-06-01 11:45:00"},
+	}
+
+	r.NoError(s.tracker.Poll(context.Background()))
+	r.Len(s.events, 2)
+	r.Nil(s.events[0].Previous)
+	r.Equal(FundingKindDeposit, s.events[0].Transfer.Kind)
+	r.Equal("PENDING", s.events[0].Transfer.Status())
+	r.Equal(FundingKindWithdrawal, s.events[1].Transfer.Kind)
+	r.Equal(WithdrawStatusCompleted, s.events[1].Transfer.WithdrawStatus)
+	r.Equal(FormatTimestamp(time.Date(2023, 6, 1, 11, 45, 0, 0, time.UTC)), s.events[1].Transfer.Time)
+
+	// the deposit cursor waits for the pending deposit, the withdrawal cursor moves on
+	cursor := s.tracker.Cursor()
+	r.Equal(FormatTimestamp(depositTime), cursor.Deposits)
+	r.Equal(FormatTimestamp(s.now), cursor.Withdrawals)
+	r.Len(s.tracker.Pending(), 1)
+
+	// unchanged transfers emit nothing
+	s.now = s.now.Add(time.Minute)
+	r.NoError(s.tracker.Poll(context.Background()))
+	r.Len(s.events, 2)
+	query := s.exchange.queries["/sapi/v1/capital/deposit/hisrec"][1]
+	r.Equal(strconv.FormatInt(FormatTimestamp(depositTime.Add(-fundingOverlap)), 10), query.Get("startTime"))
+
+	s.exchange.deposits[0].Status = int(DepositStatusSuccess)
+	r.NoError(s.tracker.Poll(context.Background()))
+	r.Len(s.events, 3)
+	r.Equal(DepositStatusPending, s.events[2].Previous.DepositStatus)
+	r.Equal(DepositStatusSuccess, s.events[2].Transfer.DepositStatus)
+	r.Empty(s.tracker.Pending())
+	r.Equal(FormatTimestamp(s.now), s.tracker.Cursor().Deposits)
+}
+
+func (s *fundingTrackerTestSuite) TestSubAccountDeposits() {
+	r := s.Require()
+	s.tracker.SubAccounts = []string{"sub@test.com"}
+	s.exchange.subDeposits = []*SubAccountDeposit{
+		{Id: 42, Coin: "ETH", Amount: "1", Status: int64(DepositStatusWrongDeposit), InsertTime: uint64(FormatTimestamp(s.now.Add(-time.Minute)))},
+	}
+
+	r.NoError(s.tracker.Poll(context.Background()))
+	r.Len(s.events, 1)
+	r.Equal(FundingKindSubAccountDeposit, s.events[0].Transfer.Kind)
+	r.Equal("sub@test.com", s.events[0].Transfer.Email)
+	r.Equal("42", s.events[0].Transfer.Id)
+	r.Equal("WRONG_DEPOSIT", s.events[0].Transfer.Status())
+	r.Equal("sub@test.com", s.exchange.queries["/sapi/v1/capital/deposit/subHisrec"][0].Get("email"))
+	// a wrong deposit waits for support, it does not hold the cursor back
+	r.Equal(FormatTimestamp(s.now), s.tracker.Cursor().SubAccountDeposits["sub@test.com"])
+}
+
+func (s *fundingTrackerTestSuite) TestInvalidApplyTime() {
+	r := s.Require()
+	s.exchange.withdrawals = []*WithdrawHistoryResponse{
+		{Id: "w1", Coin: "BTC", Amount: "0.1", Status: int(WithdrawStatusCompleted), ApplyTime: "2023-06-01T11:45:00Z"},
+	}
+	r.ErrorContains(s.tracker.Poll(context.Background()), `withdrawal w1: invalid applyTime "2023-06-01T11:45:00Z"`)
+	r.Empty(s.events)
+	r.Equal(FormatTimestamp(s.now.Add(-time.Hour)), s.tracker.Cursor().Withdrawals)
+}
+
+func (s *fundingTrackerTestSuite) TestBalanceUpdate() {
+	r := s.Require()
+	// received before the deposit shows up in the history
+	s.tracker.BalanceUpdate(&WsUserDataEvent{Event: UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: WsBalanceUpdate{Asset: "USDT", Change: "100.00000000"}})
+	s.tracker.BalanceUpdate(&WsUserDataEvent{Event: UserDataEventTypeOutboundAccountPosition})
+	r.Len(s.tracker.Unmatched(), 1)
+
+	s.exchange.deposits = []*DepositHistoryResponse{
+		{Id: "d1", Coin: "USDT", Amount: "100", Status: int(DepositStatusSuccess), InsertTime: uint64(FormatTimestamp(s.now))},
+	}
+	s.exchange.withdrawals = []*WithdrawHistoryResponse{
+		{Id: "w1", Coin: "BTC", Amount: "0.1", TransactionFee: "0.0001", Status: int(WithdrawStatusProcessing), ApplyTime: "2023-06-01 11:59:00"},
+	}
+	r.NoError(s.tracker.Poll(context.Background()))
+	r.Len(s.events, 3)
+	r.True(s.events[2].Transfer.BalanceUpdated)
+	r.False(s.events[2].Previous.BalanceUpdated)
+	r.Empty(s.tracker.Unmatched())
+
+	s.tracker.BalanceUpdate(&WsUserDataEvent{Event: UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: WsBalanceUpdate{Asset: "BTC", Change: "-0.10010000"}})
+	r.Len(s.events, 4)
+	r.Equal("w1", s.events[3].Transfer.Id)
+	r.True(s.events[3].Transfer.BalanceUpdated)
+
+	// the cross-check survives status changes
+	s.exchange.withdrawals[0].Status = int(WithdrawStatusCompleted)
+	r.NoError(s.tracker.Poll(context.Background()))
+	r.Len(s.events, 5)
+	r.True(s.events[4].Transfer.BalanceUpdated)
+	r.Equal(WithdrawStatusProcessing, s.events[4].Previous.WithdrawStatus)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 // SideType define side type of order
@@ -80,6 +81,7 @@ const (
 	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
 	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
 	TimeInForceTypeGTX TimeInForceType = "GTX" // Good Till Crossing (Post Only)
+	TimeInForceTypeGTD TimeInForceType = "GTD" // Good Till Date
 
 	NewOrderRespTypeACK    NewOrderRespType = "ACK"
 	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
@@ -122,9 +124,10 @@ const (
 	SymbolFilterTypeMaxNumAlgoOrders SymbolFilterType = "MAX_NUM_ALGO_ORDERS"
 	SymbolFilterTypeMinNotional      SymbolFilterType = "MIN_NOTIONAL"
 
-	SideEffectTypeNoSideEffect SideEffectType = "NO_SIDE_EFFECT"
-	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
-	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
+	SideEffectTypeNoSideEffect    SideEffectType = "NO_SIDE_EFFECT"
+	SideEffectTypeMarginBuy       SideEffectType = "MARGIN_BUY"
+	SideEffectTypeAutoRepay       SideEffectType = "AUTO_REPAY"
+	SideEffectTypeAutoBorrowRepay SideEffectType = "AUTO_BORROW_REPAY"
 
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
@@ -674,6 +677,20 @@ func (s *FuturesGetIncomeService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Range returns an iterator over every income record between from and to,
+// splitting the range into 7 day windows
+func (s *FuturesGetIncomeService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*Income] {
+	p := &windowPager[*Income]{
+		window: 7 * 24 * time.Hour,
+		limit:  1000,
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*Income, error) {
+			svc := *s
+			return svc.StartTime(int64(start)).EndTime(int64(end)).Page(int64(page + 1)).Limit(1000).Do(ctx)
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 type FuturesUserTradesService struct {
 	c          *Client
 	symbol     string
@@ -0,0 +1,337 @@
+package binance_connector
+
+import (
+	"context"
+	"time"
+)
+
+// The interfaces below group the endpoints of Client and WebsocketAPIClient by
+// domain, so that code depending on a subset of the API can accept a fake.
+// Each method takes a request, a service built with new and its setters, and
+// returns the response:
+//
+//	order, err := spot.CreateOrder(ctx, new(CreateOrderService).Symbol("BTCUSDT").
+//		Side("BUY").Type("MARKET").Quantity(0.001))
+//
+// Mocks of every interface are in the mocks package.
+
+// SpotTradingAPI groups the spot order and account endpoints of Client
+type SpotTradingAPI interface {
+	TestNewOrder(ctx context.Context, req *TestNewOrder, opts ...RequestOption) (*AccountOrderBookResponse, error)
+	CreateOrder(ctx context.Context, req *CreateOrderService, opts ...RequestOption) (interface{}, error)
+	CancelOrder(ctx context.Context, req *CancelOrderService, opts ...RequestOption) (*CancelOrderResponse, error)
+	CancelOpenOrders(ctx context.Context, req *CancelOpenOrdersService, opts ...RequestOption) ([]*CancelOrderResponse, error)
+	GetOrder(ctx context.Context, req *GetOrderService, opts ...RequestOption) (*GetOrderResponse, error)
+	CancelReplace(ctx context.Context, req *CancelReplaceService, opts ...RequestOption) (*CancelReplaceResponse, error)
+	GetOpenOrders(ctx context.Context, req *GetOpenOrdersService, opts ...RequestOption) ([]*NewOpenOrdersResponse, error)
+	GetAllOrders(ctx context.Context, req *GetAllOrdersService, opts ...RequestOption) ([]*NewAllOrdersResponse, error)
+	NewOCO(ctx context.Context, req *NewOCOService, opts ...RequestOption) (*OrderOCOResponse, error)
+	CancelOCO(ctx context.Context, req *CancelOCOService, opts ...RequestOption) (*OrderOCOResponse, error)
+	QueryOCO(ctx context.Context, req *QueryOCOService, opts ...RequestOption) (*OCOResponse, error)
+	QueryAllOCO(ctx context.Context, req *QueryAllOCOService, opts ...RequestOption) ([]*OCOResponse, error)
+	QueryOpenOCO(ctx context.Context, req *QueryOpenOCOService, opts ...RequestOption) (*OCOResponse, error)
+	GetAccount(ctx context.Context, req *GetAccountService, opts ...RequestOption) (*AccountResponse, error)
+	GetMyTrades(ctx context.Context, req *GetMyTradesService, opts ...RequestOption) ([]*AccountTradeListResponse, error)
+	GetQueryCurrentOrderCountUsage(ctx context.Context, req *GetQueryCurrentOrderCountUsageService, opts ...RequestOption) ([]*QueryCurrentOrderCountUsageResponse, error)
+	GetQueryPreventedMatches(ctx context.Context, req *GetQueryPreventedMatchesService, opts ...RequestOption) (*QueryPreventedMatchesResponse, error)
+	SOROrder(ctx context.Context, req *SOROrderService, opts ...RequestOption) (*SOROrderResponse, error)
+	TestSOROrder(ctx context.Context, req *TestSOROrderService, opts ...RequestOption) (*TestSOROrderResponse, error)
+	MyAllocations(ctx context.Context, req *MyAllocationsService, opts ...RequestOption) ([]*AllocationResponse, error)
+	OrderListOCO(ctx context.Context, req *OrderListOCOService, opts ...RequestOption) (*OrderListResponse, error)
+	OrderListOTO(ctx context.Context, req *OrderListOTOService, opts ...RequestOption) (*OrderListResponse, error)
+	OrderListOTOCO(ctx context.Context, req *OrderListOTOCOService, opts ...RequestOption) (*OrderListResponse, error)
+	AmendOrderKeepPriority(ctx context.Context, req *AmendOrderKeepPriorityService, opts ...RequestOption) (*AmendOrderKeepPriorityResponse, error)
+	QueryOrderAmendments(ctx context.Context, req *QueryOrderAmendmentsService, opts ...RequestOption) ([]*OrderAmendment, error)
+	GetAccountCommission(ctx context.Context, req *GetAccountCommissionService, opts ...RequestOption) (*AccountCommissionResponse, error)
+}
+
+// MarketDataAPI groups the public market data endpoints of Client
+type MarketDataAPI interface {
+	Ping(ctx context.Context, req *Ping, opts ...RequestOption) error
+	ServerTime(ctx context.Context, req *ServerTime, opts ...RequestOption) (*ServerTimeResponse, error)
+	ExchangeInfo(ctx context.Context, req *ExchangeInfo, opts ...RequestOption) (*ExchangeInfoResponse, error)
+	OrderBook(ctx context.Context, req *OrderBook, opts ...RequestOption) (*OrderBookResponse, error)
+	RecentTradesList(ctx context.Context, req *RecentTradesList, opts ...RequestOption) ([]*RecentTradesListResponse, error)
+	HistoricalTradeLookup(ctx context.Context, req *HistoricalTradeLookup, opts ...RequestOption) ([]*RecentTradesListResponse, error)
+	AggTradesList(ctx context.Context, req *AggTradesList, opts ...RequestOption) ([]*AggTradesListResponse, error)
+	Klines(ctx context.Context, req *Klines, opts ...RequestOption) ([]*KlinesResponse, error)
+	UIKlines(ctx context.Context, req *UiKlines, opts ...RequestOption) ([]*UiKlinesResponse, error)
+	AvgPrice(ctx context.Context, req *AvgPrice, opts ...RequestOption) (*AvgPriceResponse, error)
+	Ticker24hr(ctx context.Context, req *Ticker24hr, opts ...RequestOption) (*Ticker24hrResponse, error)
+	TickerPrice(ctx context.Context, req *TickerPrice, opts ...RequestOption) (*TickerPriceResponse, error)
+	TickerPrices(ctx context.Context, req *TickerPrices, opts ...RequestOption) ([]*TickerPriceResponse, error)
+	TickerBookTicker(ctx context.Context, req *TickerBookTicker, opts ...RequestOption) ([]*TickerBookTickerResponse, error)
+	Ticker(ctx context.Context, req *Ticker, opts ...RequestOption) (*TickerResponse, error)
+	KlinesRange(ctx context.Context, symbol, interval string, from, to time.Time, opts ...IteratorOption) *Iterator[*KlinesResponse]
+	AggTradesRange(ctx context.Context, symbol string, from, to time.Time, opts ...IteratorOption) *Iterator[*AggTradesListResponse]
+	HistoricalTradesRange(ctx context.Context, symbol string, from, to time.Time, opts ...IteratorOption) *Iterator[*RecentTradesListResponse]
+}
+
+// WalletAPI groups the wallet endpoints of Client
+type WalletAPI interface {
+	GetSystemStatus(ctx context.Context, req *GetSystemStatusService, opts ...RequestOption) ([]*SystemStatusResponse, error)
+	GetAllCoinsInfo(ctx context.Context, req *GetAllCoinsInfoService) ([]*CoinInfo, error)
+	GetAccountSnapshot(ctx context.Context, req *GetAccountSnapshotService) (*AccountSnapshotResponse, error)
+	DisableFastWithdrawSwitch(ctx context.Context, req *DisableFastWithdrawSwitchService) (*DisableFastWithdrawSwitchResponse, error)
+	EnableFastWithdrawSwitch(ctx context.Context, req *EnableFastWithdrawSwitchService) (*EnableFastWithdrawSwitchResponse, error)
+	Withdraw(ctx context.Context, req *WithdrawService) (*WithdrawResponse, error)
+	DepositHistory(ctx context.Context, req *DepositHistoryService) ([]*DepositHistoryResponse, error)
+	WithdrawHistory(ctx context.Context, req *WithdrawHistoryService) ([]*WithdrawHistoryResponse, error)
+	DepositAddress(ctx context.Context, req *DepositAddressService) (*DepositAddressResponse, error)
+	AccountStatus(ctx context.Context, req *AccountStatusService) (*AccountStatusResponse, error)
+	AccountApiTradingStatus(ctx context.Context, req *AccountApiTradingStatusService) (*AccountApiTradingStatusResponse, error)
+	DustLog(ctx context.Context, req *DustLogService) (*DustLogResponse, error)
+	AssetDetail(ctx context.Context, req *AssetDetailService) (*AssetDetailResponse, error)
+	DustTransfer(ctx context.Context, req *DustTransferService) (*DustTransferResponse, error)
+	AssetDividendRecord(ctx context.Context, req *AssetDividendRecordService) (*AssetDividendRecordResponse, error)
+	AssetDetailV2(ctx context.Context, req *AssetDetailV2Service) (*AssetDetailV2Response, error)
+	TradeFee(ctx context.Context, req *TradeFeeService) ([]*TradeFeeResponse, error)
+	UserUniversalTransfer(ctx context.Context, req *UserUniversalTransferService) (*UserUniversalTransferResponse, error)
+	UserUniversalTransferHistory(ctx context.Context, req *UserUniversalTransferHistoryService) (*UserUniversalTransferHistoryResponse, error)
+	FundingWallet(ctx context.Context, req *FundingWalletService) ([]*FundingWalletResponse, error)
+	UserAsset(ctx context.Context, req *UserAssetService) ([]*UserAssetResponse, error)
+	BUSDConvert(ctx context.Context, req *BUSDConvertService) (*BUSDConvertResponse, error)
+	BUSDConvertHistory(ctx context.Context, req *BUSDConvertHistoryService) (*BUSDConvertHistoryResponse, error)
+	CloudMiningPaymentHistory(ctx context.Context, req *CloudMiningPaymentHistoryService) (*CloudMiningPaymentHistoryResponse, error)
+	APIKeyPermission(ctx context.Context, req *APIKeyPermissionService) (*APIKeyPermissionResponse, error)
+	AutoConvertStableCoin(ctx context.Context, req *AutoConvertStableCoinService) (*AutoConvertStableCoinResponse, error)
+}
+
+// MarginAPI groups the cross and isolated margin endpoints of Client
+type MarginAPI interface {
+	GetAllMarginAssets(ctx context.Context, req *GetAllMarginAssetsService, opts ...RequestOption) ([]*GetAllMarginAssetsResponse, error)
+	GetAllMarginPairs(ctx context.Context, req *GetAllMarginPairsService, opts ...RequestOption) ([]*GetAllMarginPairsResponse, error)
+	QueryMarginPriceIndex(ctx context.Context, req *QueryMarginPriceIndexService, opts ...RequestOption) (*QueryMarginPriceIndexResponse, error)
+	MarginAccountNewOrder(ctx context.Context, req *MarginAccountNewOrderService, opts ...RequestOption) (interface{}, error)
+	MarginAccountCancelOrder(ctx context.Context, req *MarginAccountCancelOrderService, opts ...RequestOption) (*MarginAccountCancelOrderResponse, error)
+	MarginAccountCancelAllOrders(ctx context.Context, req *MarginAccountCancelAllOrdersService, opts ...RequestOption) (*MarginAccountCancelAllOrdersResponse, error)
+	CrossMarginTransferHistory(ctx context.Context, req *CrossMarginTransferHistoryService, opts ...RequestOption) (*CrossMarginTransferHistoryResponse, error)
+	InterestHistory(ctx context.Context, req *InterestHistoryService, opts ...RequestOption) (*InterestHistoryResponse, error)
+	ForceLiquidationRecord(ctx context.Context, req *ForceLiquidationRecordService, opts ...RequestOption) (*ForceLiquidationRecordResponse, error)
+	CrossMarginAccountDetail(ctx context.Context, req *CrossMarginAccountDetailService, opts ...RequestOption) (*CrossMarginAccountDetailResponse, error)
+	MarginAccountOrder(ctx context.Context, req *MarginAccountOrderService, opts ...RequestOption) (*MarginAccountOrderResponse, error)
+	MarginAccountOpenOrder(ctx context.Context, req *MarginAccountOpenOrderService, opts ...RequestOption) ([]*MarginAccountOpenOrderResponse, error)
+	MarginAccountAllOrder(ctx context.Context, req *MarginAccountAllOrderService, opts ...RequestOption) ([]*MarginAccountAllOrderResponse, error)
+	MarginAccountNewOCO(ctx context.Context, req *MarginAccountNewOCOService, opts ...RequestOption) (*MarginAccountNewOCOResponse, error)
+	MarginAccountCancelOCO(ctx context.Context, req *MarginAccountCancelOCOService, opts ...RequestOption) (*MarginAccountCancelOCOResponse, error)
+	MarginAccountQueryOCO(ctx context.Context, req *MarginAccountQueryOCOService, opts ...RequestOption) (*MarginAccountQueryOCOResponse, error)
+	MarginAccountQueryAllOCO(ctx context.Context, req *MarginAccountQueryAllOCOService, opts ...RequestOption) ([]*MarginAccountQueryAllOCOResponse, error)
+	MarginAccountQueryOpenOCO(ctx context.Context, req *MarginAccountQueryOpenOCOService, opts ...RequestOption) ([]*MarginAccountQueryOpenOCOResponse, error)
+	MarginAccountQueryTradeList(ctx context.Context, req *MarginAccountQueryTradeListService, opts ...RequestOption) ([]*MarginAccountQueryTradeListResponse, error)
+	MarginAccountQueryMaxBorrow(ctx context.Context, req *MarginAccountQueryMaxBorrowService, opts ...RequestOption) (*MarginAccountQueryMaxBorrowResponse, error)
+	MarginAccountQueryMaxTransferOutAmount(ctx context.Context, req *MarginAccountQueryMaxTransferOutAmountService, opts ...RequestOption) (*MarginAccountQueryMaxTransferOutAmountResponse, error)
+	MarginAccountSummary(ctx context.Context, req *MarginAccountSummaryService, opts ...RequestOption) (*MarginAccountSummaryResponse, error)
+	MarginIsolatedAccountInfo(ctx context.Context, req *MarginIsolatedAccountInfoService, opts ...RequestOption) (interface{}, error)
+	MarginIsolatedAccountDisable(ctx context.Context, req *MarginIsolatedAccountDisableService, opts ...RequestOption) (*MarginIsolatedAccountDisableResponse, error)
+	MarginIsolatedAccountEnable(ctx context.Context, req *MarginIsolatedAccountEnableService, opts ...RequestOption) (*MarginIsolatedAccountEnableResponse, error)
+	MarginIsolatedAccountLimit(ctx context.Context, req *MarginIsolatedAccountLimitService, opts ...RequestOption) (*MarginIsolatedAccountLimitResponse, error)
+	AllIsolatedMarginSymbol(ctx context.Context, req *AllIsolatedMarginSymbolService, opts ...RequestOption) ([]*MarginIsolatedSymbolResponse, error)
+	MarginToggleBnbBurn(ctx context.Context, req *MarginToggleBnbBurnService, opts ...RequestOption) (*MarginToggleBnbBurnResponse, error)
+	MarginBnbBurnStatus(ctx context.Context, req *MarginBnbBurnStatusService, opts ...RequestOption) (*MarginBnbBurnStatusResponse, error)
+	MarginInterestRateHistory(ctx context.Context, req *MarginInterestRateHistoryService, opts ...RequestOption) ([]*MarginInterestRateHistoryResponse, error)
+	MarginCrossMarginFee(ctx context.Context, req *MarginCrossMarginFeeService, opts ...RequestOption) ([]*MarginCrossMarginFeeResponse, error)
+	MarginIsolatedMarginFee(ctx context.Context, req *MarginIsolatedMarginFeeService, opts ...RequestOption) ([]*MarginIsolatedMarginFeeResponse, error)
+	MarginIsolatedMarginTier(ctx context.Context, req *MarginIsolatedMarginTierService, opts ...RequestOption) ([]*MarginIsolatedMarginTierResponse, error)
+	MarginCurrentOrderCount(ctx context.Context, req *MarginCurrentOrderCountService, opts ...RequestOption) ([]*MarginCurrentOrderCountResponse, error)
+	MarginCrossCollateralRatio(ctx context.Context, req *MarginCrossCollateralRatioService, opts ...RequestOption) ([]*MarginCrossCollateralRatioResponse, error)
+	MarginSmallLiabilityExchangeCoinList(ctx context.Context, req *MarginSmallLiabilityExchangeCoinListService, opts ...RequestOption) ([]*MarginSmallLiabilityExchangeCoinListResponse, error)
+	MarginSmallLiabilityExchange(ctx context.Context, req *MarginSmallLiabilityExchangeService, opts ...RequestOption) ([]*MarginSmallLiabilityExchangeResponse, error)
+	MarginSmallLiabilityExchangeHistory(ctx context.Context, req *MarginSmallLiabilityExchangeHistoryService, opts ...RequestOption) ([]*MarginSmallLiabilityExchangeHistoryResponse, error)
+	MarginBorrowRepay(ctx context.Context, req *MarginBorrowRepayService, opts ...RequestOption) (*MarginBorrowRepayResponse, error)
+	MarginBorrowRepayRecord(ctx context.Context, req *MarginBorrowRepayRecordService, opts ...RequestOption) (*MarginBorrowRepayRecordResponse, error)
+	CrossMarginTransfer(ctx context.Context, req *CrossMarginTransferService, opts ...RequestOption) (*CrossMarginTransferResponse, error)
+	IsolatedMarginTransfer(ctx context.Context, req *IsolatedMarginTransferService) (*UserUniversalTransferResponse, error)
+}
+
+// SubAccountAPI groups the sub-account endpoints of Client
+type SubAccountAPI interface {
+	CreateSubAccount(ctx context.Context, req *CreateSubAccountService, opts ...RequestOption) (*CreateSubAccountResp, error)
+	QuerySubAccountList(ctx context.Context, req *QuerySubAccountListService, opts ...RequestOption) (*SubAccountListResp, error)
+	QuerySubAccountSpotAssetTransferHistory(ctx context.Context, req *QuerySubAccountSpotAssetTransferHistoryService, opts ...RequestOption) (*QuerySubAccountSpotAssetTransferHistoryResp, error)
+	QuerySubAccountFuturesAssetTransferHistory(ctx context.Context, req *QuerySubAccountFuturesAssetTransferHistoryService, opts ...RequestOption) (*QuerySubAccountFuturesAssetTransferHistoryResp, error)
+	SubAccountFuturesAssetTransfer(ctx context.Context, req *SubAccountFuturesAssetTransferService, opts ...RequestOption) (*SubAccountFuturesAssetTransferResp, error)
+	QuerySubAccountAssets(ctx context.Context, req *QuerySubAccountAssetsService, opts ...RequestOption) (*QuerySubAccountAssetsResp, error)
+	QuerySubAccountSpotAssetsSummary(ctx context.Context, req *QuerySubAccountSpotAssetsSummaryService, opts ...RequestOption) (*QuerySubAccountSpotAssetsSummaryResp, error)
+	GetSubAccountDepositAddress(ctx context.Context, req *GetSubAccountDepositAddressService, opts ...RequestOption) (*GetSubAccountDepositAddressResp, error)
+	GetSubAccountDepositHistory(ctx context.Context, req *GetSubAccountDepositHistoryService, opts ...RequestOption) (*GetSubAccountDepositHistoryResp, error)
+	GetSubAccountStatus(ctx context.Context, req *GetSubAccountStatusService, opts ...RequestOption) (*GetSubAccountStatusResp, error)
+	EnableMarginForSubAccount(ctx context.Context, req *EnableMarginForSubAccountService, opts ...RequestOption) (*EnableMarginForSubAccountResp, error)
+	GetDetailOnSubAccountMarginAccount(ctx context.Context, req *GetDetailOnSubAccountMarginAccountService, opts ...RequestOption) (*GetDetailOnSubAccountMarginAccountResp, error)
+	GetSummaryOfSubAccountMarginAccount(ctx context.Context, req *GetSummaryOfSubAccountMarginAccountService, opts ...RequestOption) (*GetSummaryOfSubAccountMarginAccountResp, error)
+	EnableFuturesForSubAccount(ctx context.Context, req *EnableFuturesForSubAccountService, opts ...RequestOption) (*EnableFuturesForSubAccountResp, error)
+	GetDetailOnSubAccountFuturesAccount(ctx context.Context, req *GetDetailOnSubAccountFuturesAccountService, opts ...RequestOption) (*GetDetailOnSubAccountFuturesAccountResp, error)
+	GetSummaryOfSubAccountFuturesAccount(ctx context.Context, req *GetSummaryOfSubAccountFuturesAccountService, opts ...RequestOption) (*GetSummaryOfSubAccountFuturesAccountResp, error)
+	GetFuturesPositionRiskOfSubAccount(ctx context.Context, req *GetFuturesPositionRiskOfSubAccountService, opts ...RequestOption) (*GetFuturesPositionRiskOfSubAccountResp, error)
+	FuturesTransferForSubAccount(ctx context.Context, req *FuturesTransferForSubAccountService, opts ...RequestOption) (*FuturesTransferForSubAccountResp, error)
+	MarginTransferForSubAccount(ctx context.Context, req *MarginTransferForSubAccountService, opts ...RequestOption) (*MarginTransferForSubAccountResp, error)
+	TransferToSubAccountOfSameMaster(ctx context.Context, req *TransferToSubAccountOfSameMasterService, opts ...RequestOption) (*TransferToSubAccountOfSameMasterResp, error)
+	TransferToMaster(ctx context.Context, req *TransferToMasterService, opts ...RequestOption) (*TransferToMasterResp, error)
+	SubAccountTransferHistory(ctx context.Context, req *SubAccountTransferHistoryService, opts ...RequestOption) (*SubAccountTransferHistoryResp, error)
+	UniversalTransfer(ctx context.Context, req *UniversalTransferService, opts ...RequestOption) (*UniversalTransferResp, error)
+	QueryUniversalTransferHistory(ctx context.Context, req *QueryUniversalTransferHistoryService, opts ...RequestOption) (QueryUniversalTransferHistoryResp, error)
+	GetDetailOnSubAccountFuturesAccountV2(ctx context.Context, req *GetDetailOnSubAccountFuturesAccountV2Service, opts ...RequestOption) (interface{}, error)
+	GetSummaryOfSubAccountFuturesAccountV2(ctx context.Context, req *GetSummaryOfSubAccountFuturesAccountV2Service, opts ...RequestOption) (interface{}, error)
+	GetFuturesPositionRiskOfSubAccountV2(ctx context.Context, req *GetFuturesPositionRiskOfSubAccountV2Service, opts ...RequestOption) (interface{}, error)
+	EnableLeverageTokenForSubAccount(ctx context.Context, req *EnableLeverageTokenForSubAccountService, opts ...RequestOption) (*EnableLeverageTokenForSubAccountResp, error)
+	GetIPRestrictionForSubAccountAPIKey(ctx context.Context, req *GetIPRestrictionForSubAccountAPIKeyService, opts ...RequestOption) (*GetIPRestrictionForSubAccountAPIKeyResp, error)
+	DeleteIPListForSubAccountAPIKey(ctx context.Context, req *DeleteIPListForSubAccountAPIKeyService, opts ...RequestOption) (*DeleteIPListForSubAccountAPIKeyResp, error)
+	UpdateIPRestrictionForSubAccountAPIKey(ctx context.Context, req *UpdateIPRestrictionForSubAccountAPIKeyService, opts ...RequestOption) (*UpdateIPRestrictionForSubAccountAPIKeyResp, error)
+	DepositAssetsIntoManagedSubAccount(ctx context.Context, req *DepositAssetsIntoTheManagedSubAccountService, opts ...RequestOption) (*DepositAssetsIntoTheManagedSubAccountResp, error)
+	QueryManagedSubAccountAssetDetails(ctx context.Context, req *QueryManagedSubAccountAssetDetailsService, opts ...RequestOption) (*QueryManagedSubAccountAssetDetailsResp, error)
+	WithdrawAssetsFromTheManagedSubAccount(ctx context.Context, req *WithdrawAssetsFromTheManagedSubAccountService, opts ...RequestOption) (*WithdrawAssetsFromTheManagedSubAccountResp, error)
+	QueryManagedSubAccountSnapshot(ctx context.Context, req *QueryManagedSubAccountSnapshotService, opts ...RequestOption) (*QueryManagedSubAccountSnapshotResp, error)
+	QueryManagedSubAccountTransferLog(ctx context.Context, req *QueryManagedSubAccountTransferLogService, opts ...RequestOption) (*QueryManagedSubAccountTransferLogResp, error)
+	QueryManagedSubAccountFuturesAssetDetails(ctx context.Context, req *QueryManagedSubAccountFuturesAssetDetailsService, opts ...RequestOption) (*QueryManagedSubAccountFuturesAssetDetailsResp, error)
+	QueryManagedSubAccountMarginAssetDetails(ctx context.Context, req *QueryManagedSubAccountMarginAssetDetailsService, opts ...RequestOption) (*QueryManagedSubAccountMarginAssetDetailsResp, error)
+	QueryManagedSubAccountTransferLogForTradingTeam(ctx context.Context, req *QueryManagedSubAccountTransferLogForTradingTeamService, opts ...RequestOption) (*QueryManagedSubAccountTransferLogForTradingTeamResp, error)
+	QuerySubAccountAssetsForMasterAccount(ctx context.Context, req *QuerySubAccountAssetsForMasterAccountService, opts ...RequestOption) (*QuerySubAccountAssetsForMasterAccountResp, error)
+	QueryManagedSubAccountList(ctx context.Context, req *QueryManagedSubAccountList, opts ...RequestOption) (*QueryManagedSubAccountListResp, error)
+	QuerySubAccountTransactionTatistics(ctx context.Context, req *QuerySubAccountTransactionTatistics, opts ...RequestOption) (*QuerySubAccountTransactionTatisticsResp, error)
+	GetManagedSubAccountDepositAddress(ctx context.Context, req *GetManagedSubAccountDepositAddressService, opts ...RequestOption) (*GetManagedSubAccountDepositAddressResp, error)
+	EnableOptionsForSubAccount(ctx context.Context, req *EnableOptionsForSubAccountService, opts ...RequestOption) (*EnableOptionsForSubAccountResp, error)
+	MoveFuturesPositionForSubAccount(ctx context.Context, req *MoveFuturesPositionForSubAccountService, opts ...RequestOption) (*MoveFuturesPositionForSubAccountResp, error)
+	QueryMoveFuturesPositionHistoryForSubAccount(ctx context.Context, req *QueryMoveFuturesPositionHistoryForSubAccountService, opts ...RequestOption) (*QueryMoveFuturesPositionHistoryForSubAccountResp, error)
+	GetIPRestrictionThirdPartyListForSubAccountAPIKey(ctx context.Context, req *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService, opts ...RequestOption) ([]*GetIPRestrictionThirdPartyListForSubAccountAPIKeyResp, error)
+}
+
+// UserStreamAPI groups the listen key endpoints of Client
+type UserStreamAPI interface {
+	CreateListenKey(ctx context.Context, req *CreateListenKey, opts ...RequestOption) (string, error)
+	PingUserStream(ctx context.Context, req *PingUserStream, opts ...RequestOption) error
+	CloseUserStream(ctx context.Context, req *CloseUserStream, opts ...RequestOption) error
+}
+
+// ConvertAPI groups the convert endpoints of Client
+type ConvertAPI interface {
+	ConvertExchangeInfo(ctx context.Context, req *ConvertExchangeInfoService, opts ...RequestOption) ([]*ConvertExchangeInfoResponse, error)
+	ConvertAssetInfo(ctx context.Context, req *ConvertAssetInfoService, opts ...RequestOption) ([]*ConvertAssetInfoResponse, error)
+	ConvertGetQuote(ctx context.Context, req *ConvertGetQuoteService, opts ...RequestOption) (*ConvertQuoteResponse, error)
+	ConvertAcceptQuote(ctx context.Context, req *ConvertAcceptQuoteService, opts ...RequestOption) (*ConvertAcceptQuoteResponse, error)
+	ConvertOrderStatus(ctx context.Context, req *ConvertOrderStatusService, opts ...RequestOption) (*ConvertOrderStatusResponse, error)
+	ConvertTradeFlow(ctx context.Context, req *ConvertTradeFlowService, opts ...RequestOption) (*ConvertTradeFlowResponse, error)
+	ConvertLimitPlaceOrder(ctx context.Context, req *ConvertLimitPlaceOrderService, opts ...RequestOption) (*ConvertLimitOrderResponse, error)
+	ConvertLimitCancelOrder(ctx context.Context, req *ConvertLimitCancelOrderService, opts ...RequestOption) (*ConvertLimitOrderResponse, error)
+	ConvertLimitQueryOpenOrders(ctx context.Context, req *ConvertLimitQueryOpenOrdersService, opts ...RequestOption) (*ConvertLimitOpenOrdersResponse, error)
+	Convert(ctx context.Context, req *ConvertService, opts ...RequestOption) (*ConvertResult, error)
+}
+
+// SimpleEarnAPI groups the Simple Earn endpoints of Client
+type SimpleEarnAPI interface {
+	SimpleEarnFlexibleProductList(ctx context.Context, req *SimpleEarnFlexibleProductListService, opts ...RequestOption) (*SimpleEarnFlexibleProductListResponse, error)
+	SimpleEarnLockedProductList(ctx context.Context, req *SimpleEarnLockedProductListService, opts ...RequestOption) (*SimpleEarnLockedProductListResponse, error)
+	SimpleEarnFlexibleSubscribe(ctx context.Context, req *SimpleEarnFlexibleSubscribeService, opts ...RequestOption) (*SimpleEarnSubscribeResponse, error)
+	SimpleEarnLockedSubscribe(ctx context.Context, req *SimpleEarnLockedSubscribeService, opts ...RequestOption) (*SimpleEarnSubscribeResponse, error)
+	SimpleEarnFlexibleRedeem(ctx context.Context, req *SimpleEarnFlexibleRedeemService, opts ...RequestOption) (*SimpleEarnRedeemResponse, error)
+	SimpleEarnLockedRedeem(ctx context.Context, req *SimpleEarnLockedRedeemService, opts ...RequestOption) (*SimpleEarnRedeemResponse, error)
+	SimpleEarnFlexiblePosition(ctx context.Context, req *SimpleEarnFlexiblePositionService, opts ...RequestOption) (*SimpleEarnFlexiblePositionResponse, error)
+	SimpleEarnLockedPosition(ctx context.Context, req *SimpleEarnLockedPositionService, opts ...RequestOption) (*SimpleEarnLockedPositionResponse, error)
+	SimpleEarnAccount(ctx context.Context, req *SimpleEarnAccountService, opts ...RequestOption) (*SimpleEarnAccountResponse, error)
+	SimpleEarnFlexibleSubscriptionRecord(ctx context.Context, req *SimpleEarnFlexibleSubscriptionRecordService, opts ...RequestOption) (*SimpleEarnFlexibleSubscriptionRecordResponse, error)
+	SimpleEarnLockedSubscriptionRecord(ctx context.Context, req *SimpleEarnLockedSubscriptionRecordService, opts ...RequestOption) (*SimpleEarnLockedSubscriptionRecordResponse, error)
+	SimpleEarnFlexibleRedemptionRecord(ctx context.Context, req *SimpleEarnFlexibleRedemptionRecordService, opts ...RequestOption) (*SimpleEarnFlexibleRedemptionRecordResponse, error)
+	SimpleEarnLockedRedemptionRecord(ctx context.Context, req *SimpleEarnLockedRedemptionRecordService, opts ...RequestOption) (*SimpleEarnLockedRedemptionRecordResponse, error)
+	SimpleEarnFlexibleRewardsRecord(ctx context.Context, req *SimpleEarnFlexibleRewardsRecordService, opts ...RequestOption) (*SimpleEarnFlexibleRewardsRecordResponse, error)
+	SimpleEarnLockedRewardsRecord(ctx context.Context, req *SimpleEarnLockedRewardsRecordService, opts ...RequestOption) (*SimpleEarnLockedRewardsRecordResponse, error)
+	SimpleEarnFlexiblePersonalLeftQuota(ctx context.Context, req *SimpleEarnFlexiblePersonalLeftQuotaService, opts ...RequestOption) (*SimpleEarnPersonalLeftQuotaResponse, error)
+	SimpleEarnLockedPersonalLeftQuota(ctx context.Context, req *SimpleEarnLockedPersonalLeftQuotaService, opts ...RequestOption) (*SimpleEarnPersonalLeftQuotaResponse, error)
+}
+
+// AutoInvestAPI groups the Auto-Invest endpoints of Client
+type AutoInvestAPI interface {
+	AutoInvestTargetAssetList(ctx context.Context, req *AutoInvestTargetAssetListService, opts ...RequestOption) (*AutoInvestTargetAssetListResponse, error)
+	AutoInvestSourceAssetList(ctx context.Context, req *AutoInvestSourceAssetListService, opts ...RequestOption) (*AutoInvestSourceAssetListResponse, error)
+	AutoInvestPlanAdd(ctx context.Context, req *AutoInvestPlanAddService, opts ...RequestOption) (*AutoInvestPlanResponse, error)
+	AutoInvestPlanEdit(ctx context.Context, req *AutoInvestPlanEditService, opts ...RequestOption) (*AutoInvestPlanResponse, error)
+	AutoInvestPlanEditStatus(ctx context.Context, req *AutoInvestPlanEditStatusService, opts ...RequestOption) (*AutoInvestPlanResponse, error)
+	AutoInvestPlanList(ctx context.Context, req *AutoInvestPlanListService, opts ...RequestOption) (*AutoInvestPlanListResponse, error)
+	AutoInvestHoldingDetails(ctx context.Context, req *AutoInvestHoldingDetailsService, opts ...RequestOption) (*AutoInvestHoldingDetailsResponse, error)
+	AutoInvestSubscriptionHistory(ctx context.Context, req *AutoInvestSubscriptionHistoryService, opts ...RequestOption) (*AutoInvestSubscriptionHistoryResponse, error)
+	AutoInvestIndexInfo(ctx context.Context, req *AutoInvestIndexInfoService, opts ...RequestOption) (*AutoInvestIndexInfoResponse, error)
+	AutoInvestOneTimeTransaction(ctx context.Context, req *AutoInvestOneTimeTransactionService, opts ...RequestOption) (*AutoInvestOneTimeTransactionResponse, error)
+	AutoInvestOneTimeTransactionStatus(ctx context.Context, req *AutoInvestOneTimeTransactionStatusService, opts ...RequestOption) (*AutoInvestOneTimeTransactionStatusResponse, error)
+}
+
+// PortfolioMarginAPI groups the portfolio margin endpoints of PortfolioMarginClient
+type PortfolioMarginAPI interface {
+	PortfolioMarginPing(ctx context.Context, req *PortfolioMarginPingService, opts ...RequestOption) error
+	PortfolioMarginAccount(ctx context.Context, req *PortfolioMarginAccountService, opts ...RequestOption) (*PortfolioMarginAccountResponse, error)
+	PortfolioMarginBalance(ctx context.Context, req *PortfolioMarginBalanceService, opts ...RequestOption) ([]*PortfolioMarginBalance, error)
+	PortfolioMarginUMPositionRisk(ctx context.Context, req *PortfolioMarginUMPositionRiskService, opts ...RequestOption) ([]*PositionRisk, error)
+	PortfolioMarginCMPositionRisk(ctx context.Context, req *PortfolioMarginCMPositionRiskService, opts ...RequestOption) ([]*PortfolioMarginCMPositionRisk, error)
+	PortfolioMarginLoan(ctx context.Context, req *PortfolioMarginLoanService, opts ...RequestOption) (*PortfolioMarginTransactionResponse, error)
+	PortfolioMarginRepayLoan(ctx context.Context, req *PortfolioMarginRepayLoanService, opts ...RequestOption) (*PortfolioMarginTransactionResponse, error)
+	PortfolioMarginRepayFuturesNegativeBalance(ctx context.Context, req *PortfolioMarginRepayFuturesNegativeBalanceService, opts ...RequestOption) (*PortfolioMarginMsgResponse, error)
+	PortfolioMarginAutoCollection(ctx context.Context, req *PortfolioMarginAutoCollectionService, opts ...RequestOption) (*PortfolioMarginMsgResponse, error)
+	PortfolioMarginAssetCollection(ctx context.Context, req *PortfolioMarginAssetCollectionService, opts ...RequestOption) (*PortfolioMarginMsgResponse, error)
+	PortfolioMarginUMNewOrder(ctx context.Context, req *PortfolioMarginUMNewOrderService, opts ...RequestOption) (*CreateOrderResponse, error)
+	PortfolioMarginUMCancelOrder(ctx context.Context, req *PortfolioMarginUMCancelOrderService, opts ...RequestOption) (*Order, error)
+	PortfolioMarginUMQueryOrder(ctx context.Context, req *PortfolioMarginUMQueryOrderService, opts ...RequestOption) (*Order, error)
+	PortfolioMarginUMOpenOrders(ctx context.Context, req *PortfolioMarginUMOpenOrdersService, opts ...RequestOption) ([]*Order, error)
+	PortfolioMarginCMNewOrder(ctx context.Context, req *PortfolioMarginCMNewOrderService, opts ...RequestOption) (*PortfolioMarginCMOrder, error)
+	PortfolioMarginCMCancelOrder(ctx context.Context, req *PortfolioMarginCMCancelOrderService, opts ...RequestOption) (*PortfolioMarginCMOrder, error)
+	PortfolioMarginCMQueryOrder(ctx context.Context, req *PortfolioMarginCMQueryOrderService, opts ...RequestOption) (*PortfolioMarginCMOrder, error)
+	PortfolioMarginCMOpenOrders(ctx context.Context, req *PortfolioMarginCMOpenOrdersService, opts ...RequestOption) ([]*PortfolioMarginCMOrder, error)
+	PortfolioMarginMarginNewOrder(ctx context.Context, req *PortfolioMarginMarginNewOrderService, opts ...RequestOption) (*MarginAccountNewOrderResponseFULL, error)
+	PortfolioMarginMarginCancelOrder(ctx context.Context, req *PortfolioMarginMarginCancelOrderService, opts ...RequestOption) (*MarginAccountCancelOrderResponse, error)
+	PortfolioMarginMarginQueryOrder(ctx context.Context, req *PortfolioMarginMarginQueryOrderService, opts ...RequestOption) (*MarginAccountOrderResponse, error)
+	PortfolioMarginCreateListenKey(ctx context.Context, req *PortfolioMarginCreateListenKeyService, opts ...RequestOption) (string, error)
+	PortfolioMarginPingListenKey(ctx context.Context, req *PortfolioMarginPingListenKeyService, opts ...RequestOption) error
+	PortfolioMarginCloseListenKey(ctx context.Context, req *PortfolioMarginCloseListenKeyService, opts ...RequestOption) error
+}
+
+// OptionsAPI groups the options endpoints of OptionsClient
+type OptionsAPI interface {
+	OptionsPing(ctx context.Context, req *OptionsPingService, opts ...RequestOption) error
+	OptionsExchangeInfo(ctx context.Context, req *OptionsExchangeInfoService, opts ...RequestOption) (*OptionsExchangeInfoResponse, error)
+	OptionsIndexPrice(ctx context.Context, req *OptionsIndexPriceService, opts ...RequestOption) (*OptionsIndexPriceResponse, error)
+	OptionsMarkPrice(ctx context.Context, req *OptionsMarkPriceService, opts ...RequestOption) ([]*OptionsMarkPrice, error)
+	OptionsDepth(ctx context.Context, req *OptionsDepthService, opts ...RequestOption) (*OptionsDepthResponse, error)
+	OptionsKlines(ctx context.Context, req *OptionsKlinesService, opts ...RequestOption) ([]*OptionsKline, error)
+	OptionsExerciseHistory(ctx context.Context, req *OptionsExerciseHistoryService, opts ...RequestOption) ([]*OptionsExerciseHistory, error)
+	OptionsNewOrder(ctx context.Context, req *OptionsNewOrderService, opts ...RequestOption) (*OptionsOrder, error)
+	OptionsBatchOrders(ctx context.Context, req *OptionsBatchOrdersService, opts ...RequestOption) ([]*OptionsBatchOrderResponse, error)
+	OptionsCancelOrder(ctx context.Context, req *OptionsCancelOrderService, opts ...RequestOption) (*OptionsOrder, error)
+	OptionsBatchCancelOrders(ctx context.Context, req *OptionsBatchCancelOrdersService, opts ...RequestOption) ([]*OptionsBatchOrderResponse, error)
+	OptionsPosition(ctx context.Context, req *OptionsPositionService, opts ...RequestOption) ([]*OptionsPosition, error)
+	OptionsAccount(ctx context.Context, req *OptionsAccountService, opts ...RequestOption) (*OptionsAccountResponse, error)
+	OptionsUserExerciseRecord(ctx context.Context, req *OptionsUserExerciseRecordService, opts ...RequestOption) ([]*OptionsUserExerciseRecord, error)
+	OptionsCreateListenKey(ctx context.Context, req *OptionsCreateListenKeyService, opts ...RequestOption) (string, error)
+	OptionsPingListenKey(ctx context.Context, req *OptionsPingListenKeyService, opts ...RequestOption) error
+	OptionsCloseListenKey(ctx context.Context, req *OptionsCloseListenKeyService, opts ...RequestOption) error
+}
+
+// WebsocketTradingAPI groups the trading methods of WebsocketAPIClient
+type WebsocketTradingAPI interface {
+	PlaceNewOrder(ctx context.Context, req *OrderPlacementService) (*OrderPlacementResponse, error)
+	TestPlaceOrder(ctx context.Context, req *TestOrderPlacementService) (*OrderPlacementResponse, error)
+	QueryOrder(ctx context.Context, req *OrderStatusService) (*OrderStatusResponse, error)
+	CancelOrder(ctx context.Context, req *OrderCancelService) (*OrderCancelResponse, error)
+	CancelReplaceOrder(ctx context.Context, req *OrderCancelReplaceService) (*OrderCancelReplaceResponse, error)
+	CurrentOpenOrders(ctx context.Context, req *OpenOrdersStatusService) (*OpenOrdersStatusResponse, error)
+	CancelOpenOrders(ctx context.Context, req *OpenOrdersCancelAllService) (*OpenOrdersCancelAllResponse, error)
+	PlaceOCO(ctx context.Context, req *OrderListPlaceService) (*OrderListPlaceResponse, error)
+	QueryOCO(ctx context.Context, req *OrderListStatusService) (*OrderListStatusResponse, error)
+	CurrentOpenOCO(ctx context.Context, req *OpenOrderListsStatusService) (*OpenOrderListsStatusResponse, error)
+	CancelOCO(ctx context.Context, req *OrderListCancelService) (*OrderListCancelResponse, error)
+	PlaceSOROrder(ctx context.Context, req *SOROrderPlacementService) (*SOROrderPlacementResponse, error)
+	TestPlaceSOROrder(ctx context.Context, req *TestSOROrderPlacementService) (*TestSOROrderPlacementResponse, error)
+	PlaceOrderListOCO(ctx context.Context, req *OrderListPlaceOCOService) (*OrderListPlaceResponse, error)
+	PlaceOrderListOTO(ctx context.Context, req *OrderListPlaceOTOService) (*OrderListPlaceResponse, error)
+	PlaceOrderListOTOCO(ctx context.Context, req *OrderListPlaceOTOCOService) (*OrderListPlaceResponse, error)
+	AmendOrderKeepPriority(ctx context.Context, req *OrderAmendKeepPriorityService) (*OrderAmendKeepPriorityResponse, error)
+}
+
+var (
+	_ SpotTradingAPI      = (*Client)(nil)
+	_ MarketDataAPI       = (*Client)(nil)
+	_ WalletAPI           = (*Client)(nil)
+	_ MarginAPI           = (*Client)(nil)
+	_ SubAccountAPI       = (*Client)(nil)
+	_ UserStreamAPI       = (*Client)(nil)
+	_ ConvertAPI          = (*Client)(nil)
+	_ SimpleEarnAPI       = (*Client)(nil)
+	_ AutoInvestAPI       = (*Client)(nil)
+	_ PortfolioMarginAPI  = (*PortfolioMarginClient)(nil)
+	_ OptionsAPI          = (*OptionsClient)(nil)
+	_ WebsocketTradingAPI = (*WebsocketAPIClient)(nil)
+)
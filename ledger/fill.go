@@ -0,0 +1,99 @@
+package ledger
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	binance_connector "github.com/binance/binance-connector-go"
+)
+
+// FromMyTrades converts the trades of GetMyTradesService
+func FromMyTrades(trades []*binance_connector.AccountTradeListResponse) ([]*Fill, error) {
+	res := make([]*Fill, 0, len(trades))
+	for _, t := range trades {
+		f, err := newFill(AccountSpot, t.Symbol, t.Id, t.Price, t.Quantity, t.Commission)
+		if err != nil {
+			return nil, err
+		}
+		f.OrderId, f.Time, f.IsBuyer, f.CommissionAsset = t.OrderId, int64(t.Time), t.IsBuyer, t.CommissionAsset
+		res = append(res, f)
+	}
+	return res, nil
+}
+
+// FromMarginTrades converts the trades of MarginAccountQueryTradeListService
+func FromMarginTrades(trades []*binance_connector.MarginAccountQueryTradeListResponse) ([]*Fill, error) {
+	res := make([]*Fill, 0, len(trades))
+	for _, t := range trades {
+		account := AccountMargin
+		if t.IsIsolated {
+			account = AccountIsolatedMargin
+		}
+		f, err := newFill(account, t.Symbol, int64(t.Id), t.Price, t.Qty, t.Commission)
+		if err != nil {
+			return nil, err
+		}
+		f.OrderId, f.Time, f.IsBuyer, f.CommissionAsset = int64(t.OrderId), int64(t.Time), t.IsBuyer, t.CommissionAsset
+		res = append(res, f)
+	}
+	return res, nil
+}
+
+// FromFuturesTrades converts the trades of FuturesUserTradesService. Trades
+// of a one-way mode position have no PositionSide.
+func FromFuturesTrades(trades []*binance_connector.UserTradesInfo) ([]*Fill, error) {
+	res := make([]*Fill, 0, len(trades))
+	for _, t := range trades {
+		f, err := newFill(AccountFutures, t.Symbol, int64(t.ID), t.Price, t.Qty, t.Commission)
+		if err != nil {
+			return nil, err
+		}
+		f.OrderId, f.Time, f.IsBuyer, f.CommissionAsset = int64(t.OrderID), t.Time, t.Buyer, t.CommissionAsset
+		if t.PositionSide != "BOTH" {
+			f.PositionSide = t.PositionSide
+		}
+		res = append(res, f)
+	}
+	return res, nil
+}
+
+// FromOrderUpdate converts the execution of an executionReport event of the
+// user data stream of account. It returns nil for events that are not trades.
+func FromOrderUpdate(account string, u *binance_connector.WsOrderUpdate) (*Fill, error) {
+	if u.ExecutionType != "TRADE" {
+		return nil, nil
+	}
+	f, err := newFill(account, u.Symbol, u.TradeId, u.LatestPrice, u.LatestVolume, u.FeeCost)
+	if err != nil {
+		return nil, err
+	}
+	f.OrderId, f.Time, f.IsBuyer, f.CommissionAsset = u.Id, u.TransactionTime, u.Side == "BUY", u.FeeAsset
+	return f, nil
+}
+
+func newFill(account, symbol string, tradeId int64, price, qty, commission string) (*Fill, error) {
+	f := &Fill{Account: account, Symbol: symbol, TradeId: tradeId}
+	var err error
+	if f.Price, err = parseRat(price); err != nil {
+		return nil, fmt.Errorf("trade %d of %s: price: %w", tradeId, symbol, err)
+	}
+	if f.Qty, err = parseRat(qty); err != nil {
+		return nil, fmt.Errorf("trade %d of %s: quantity: %w", tradeId, symbol, err)
+	}
+	if commission != "" {
+		if f.Commission, err = parseRat(commission); err != nil {
+			return nil, fmt.Errorf("trade %d of %s: commission: %w", tradeId, symbol, err)
+		}
+	}
+	return f, nil
+}
+
+// parseRat parses a decimal string of the API
+func parseRat(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	return r, nil
+}
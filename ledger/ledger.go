@@ -0,0 +1,434 @@
+// Package ledger tracks the positions of an account from its trade fills and
+// computes their cost basis and realized and unrealized PnL. Fills come from
+// GetMyTradesService, MarginAccountQueryTradeListService,
+// FuturesUserTradesService or the executions of the user data stream.
+//
+// Amounts are exact rationals parsed from the decimal strings of the API, so
+// that no rounding is introduced by the ledger. Holdings acquired before the
+// first fill are added as a buy Fill at their cost; without them, a sale
+// opens a short position.
+package ledger
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Method define how the cost of a closed quantity is taken from the open lots
+type Method int
+
+// Cost methods
+const (
+	// FIFO closes the oldest lots first
+	FIFO Method = iota
+	// LIFO closes the newest lots first
+	LIFO
+	// AverageCost keeps a single lot at the average entry price
+	AverageCost
+)
+
+func (m Method) String() string {
+	switch m {
+	case FIFO:
+		return "FIFO"
+	case LIFO:
+		return "LIFO"
+	case AverageCost:
+		return "AVERAGE_COST"
+	}
+	return fmt.Sprintf("Method(%d)", int(m))
+}
+
+// Accounts of a Fill. Positions of different accounts are tracked apart.
+const (
+	AccountSpot           = "SPOT"
+	AccountMargin         = "MARGIN"
+	AccountIsolatedMargin = "ISOLATED_MARGIN"
+	AccountFutures        = "FUTURES"
+)
+
+// Fill define a trade execution of an order
+type Fill struct {
+	Account         string
+	Symbol          string
+	PositionSide    string // futures hedge mode position side, LONG or SHORT
+	TradeId         int64
+	OrderId         int64
+	Time            int64 // in ms
+	IsBuyer         bool
+	Price           *big.Rat
+	Qty             *big.Rat
+	Commission      *big.Rat // nil without commission
+	CommissionAsset string
+}
+
+// Lot define an open quantity of a Position. The cost of a short lot is
+// the value it was sold for.
+type Lot struct {
+	TradeId int64
+	Time    int64
+	Qty     *big.Rat
+	Cost    *big.Rat
+}
+
+// Position define the position of an account in a symbol. The realized PnL
+// and cost basis are in the quote asset.
+//
+// A commission paid in the base asset changes the quantity bought or sold,
+// one paid in the quote asset the cost or proceeds. Commissions in another
+// asset, such as BNB, are only summed in Commissions.
+type Position struct {
+	Account      string
+	Symbol       string
+	PositionSide string
+	Base         string
+	Quote        string
+	Qty          *big.Rat // negative when short
+	CostBasis    *big.Rat // sum of the cost of the open lots
+	RealizedPnl  *big.Rat
+	Commissions  map[string]*big.Rat // by asset
+	Lots         []Lot               // open lots, oldest first
+	Fills        int
+}
+
+// AvgPrice returns the average entry price of the open quantity, nil when
+// the position is flat
+func (p *Position) AvgPrice() *big.Rat {
+	if p.Qty.Sign() == 0 {
+		return nil
+	}
+	return new(big.Rat).Quo(p.CostBasis, new(big.Rat).Abs(p.Qty))
+}
+
+// UnrealizedPnlAt returns the PnL of the open quantity valued at price
+func (p *Position) UnrealizedPnlAt(price *big.Rat) *big.Rat {
+	value := new(big.Rat).Mul(price, p.Qty)
+	if p.Qty.Sign() < 0 {
+		return value.Add(value, p.CostBasis)
+	}
+	return value.Sub(value, p.CostBasis)
+}
+
+func (p *Position) copy() *Position {
+	c := *p
+	c.Qty = new(big.Rat).Set(p.Qty)
+	c.CostBasis = new(big.Rat).Set(p.CostBasis)
+	c.RealizedPnl = new(big.Rat).Set(p.RealizedPnl)
+	c.Commissions = make(map[string]*big.Rat, len(p.Commissions))
+	for asset, amount := range p.Commissions {
+		c.Commissions[asset] = new(big.Rat).Set(amount)
+	}
+	c.Lots = make([]Lot, len(p.Lots))
+	for i, lot := range p.Lots {
+		c.Lots[i] = Lot{TradeId: lot.TradeId, Time: lot.Time, Qty: new(big.Rat).Set(lot.Qty), Cost: new(big.Rat).Set(lot.Cost)}
+	}
+	return &c
+}
+
+// Summary define the PnL of the positions of a Ledger
+type Summary struct {
+	Positions []*PositionSummary
+	// RealizedPnl, UnrealizedPnl and CostBasis are summed by quote asset
+	RealizedPnl   map[string]*big.Rat
+	UnrealizedPnl map[string]*big.Rat
+	CostBasis     map[string]*big.Rat
+	// Commissions are summed by asset
+	Commissions map[string]*big.Rat
+}
+
+// PositionSummary define a position and its PnL at Price
+type PositionSummary struct {
+	*Position
+	Price         *big.Rat // nil when no price was given for the symbol
+	UnrealizedPnl *big.Rat // nil when no price was given for the symbol
+}
+
+type positionKey struct {
+	account, symbol, positionSide string
+}
+
+type fillKey struct {
+	account, symbol string
+	tradeId         int64
+	isBuyer         bool
+}
+
+// Ledger tracks positions from fills
+type Ledger struct {
+	Method Method
+
+	mu        sync.Mutex
+	positions map[positionKey]*Position
+	assets    map[string][2]string
+	seen      map[fillKey]bool
+}
+
+// New create a Ledger closing lots with method
+func New(method Method) *Ledger {
+	return &Ledger{
+		Method:    method,
+		positions: make(map[positionKey]*Position),
+		assets:    make(map[string][2]string),
+		seen:      make(map[fillKey]bool),
+	}
+}
+
+// SetAssets set the base and quote assets of symbol. Symbols without assets
+// are split on the quote assets of quoteAssets.
+func (l *Ledger) SetAssets(symbol, base, quote string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.assets[symbol] = [2]string{base, quote}
+}
+
+// Add fills in the order they were executed, see Sort. A fill with a
+// TradeId already added to the account is skipped, so that fills of the
+// user data stream and of a trade history can overlap.
+func (l *Ledger) Add(fills ...*Fill) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, f := range fills {
+		if err := validate(f); err != nil {
+			return err
+		}
+		if f.TradeId != 0 {
+			key := fillKey{f.Account, f.Symbol, f.TradeId, f.IsBuyer}
+			if l.seen[key] {
+				continue
+			}
+			l.seen[key] = true
+		}
+		l.add(f)
+	}
+	return nil
+}
+
+// Position returns a copy of a position, nil when the ledger has no fill of it
+func (l *Ledger) Position(account, symbol, positionSide string) *Position {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	p, ok := l.positions[positionKey{account, symbol, positionSide}]
+	if !ok {
+		return nil
+	}
+	return p.copy()
+}
+
+// Positions returns a copy of every position, sorted by account, symbol and
+// position side
+func (l *Ledger) Positions() []*Position {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	res := make([]*Position, 0, len(l.positions))
+	for _, p := range l.positions {
+		res = append(res, p.copy())
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i], res[j]
+		if a.Account != b.Account {
+			return a.Account < b.Account
+		}
+		if a.Symbol != b.Symbol {
+			return a.Symbol < b.Symbol
+		}
+		return a.PositionSide < b.PositionSide
+	})
+	return res
+}
+
+// Summary returns the positions with their unrealized PnL at prices, by
+// symbol, and the totals by asset. Positions of symbols missing from prices
+// have no unrealized PnL.
+func (l *Ledger) Summary(prices map[string]*big.Rat) *Summary {
+	s := &Summary{
+		RealizedPnl:   make(map[string]*big.Rat),
+		UnrealizedPnl: make(map[string]*big.Rat),
+		CostBasis:     make(map[string]*big.Rat),
+		Commissions:   make(map[string]*big.Rat),
+	}
+	for _, p := range l.Positions() {
+		ps := &PositionSummary{Position: p}
+		addTo(s.RealizedPnl, p.Quote, p.RealizedPnl)
+		addTo(s.CostBasis, p.Quote, p.CostBasis)
+		for asset, amount := range p.Commissions {
+			addTo(s.Commissions, asset, amount)
+		}
+		if price, ok := prices[p.Symbol]; ok && price != nil {
+			ps.Price = new(big.Rat).Set(price)
+			ps.UnrealizedPnl = p.UnrealizedPnlAt(price)
+			addTo(s.UnrealizedPnl, p.Quote, ps.UnrealizedPnl)
+		}
+		s.Positions = append(s.Positions, ps)
+	}
+	return s
+}
+
+// Sort fills by time, then by trade id
+func Sort(fills []*Fill) {
+	sort.SliceStable(fills, func(i, j int) bool {
+		if fills[i].Time != fills[j].Time {
+			return fills[i].Time < fills[j].Time
+		}
+		return fills[i].TradeId < fills[j].TradeId
+	})
+}
+
+func validate(f *Fill) error {
+	switch {
+	case f.Symbol == "":
+		return fmt.Errorf("fill %d without symbol", f.TradeId)
+	case f.Qty == nil || f.Qty.Sign() <= 0:
+		return fmt.Errorf("fill %d of %s: invalid quantity", f.TradeId, f.Symbol)
+	case f.Price == nil || f.Price.Sign() < 0:
+		return fmt.Errorf("fill %d of %s: invalid price", f.TradeId, f.Symbol)
+	case f.Commission != nil && f.Commission.Sign() < 0:
+		return fmt.Errorf("fill %d of %s: invalid commission", f.TradeId, f.Symbol)
+	}
+	return nil
+}
+
+func (l *Ledger) add(f *Fill) {
+	key := positionKey{f.Account, f.Symbol, f.PositionSide}
+	p, ok := l.positions[key]
+	if !ok {
+		base, quote := l.splitSymbol(f.Symbol)
+		p = &Position{Account: f.Account, Symbol: f.Symbol, PositionSide: f.PositionSide, Base: base, Quote: quote,
+			Qty: new(big.Rat), CostBasis: new(big.Rat), RealizedPnl: new(big.Rat), Commissions: make(map[string]*big.Rat)}
+		l.positions[key] = p
+	}
+	p.Fills++
+
+	// units is the signed change of the position, amount the quote paid for
+	// a buy or received for a sell
+	units := new(big.Rat).Set(f.Qty)
+	amount := new(big.Rat).Mul(f.Qty, f.Price)
+	if f.Commission != nil && f.Commission.Sign() > 0 {
+		addTo(p.Commissions, f.CommissionAsset, f.Commission)
+		switch f.CommissionAsset {
+		case p.Base:
+			if f.IsBuyer {
+				units.Sub(units, f.Commission)
+			} else {
+				units.Add(units, f.Commission)
+			}
+		case p.Quote:
+			if f.IsBuyer {
+				amount.Add(amount, f.Commission)
+			} else {
+				amount.Sub(amount, f.Commission)
+			}
+		}
+	}
+	if !f.IsBuyer {
+		units.Neg(units)
+	}
+	if units.Sign() == 0 {
+		return
+	}
+
+	// close the open lots on the other side of the position
+	if p.Qty.Sign() != 0 && p.Qty.Sign() != units.Sign() {
+		size := new(big.Rat).Abs(units)
+		closed := minRat(size, new(big.Rat).Abs(p.Qty))
+		closedAmount := new(big.Rat).Quo(new(big.Rat).Mul(amount, closed), size)
+		cost := l.closeLots(p, closed)
+		if p.Qty.Sign() > 0 {
+			// a sell closing a long position
+			p.RealizedPnl.Add(p.RealizedPnl, closedAmount.Sub(closedAmount, cost))
+		} else {
+			// a buy closing a short position
+			p.RealizedPnl.Add(p.RealizedPnl, cost.Sub(cost, closedAmount))
+		}
+		p.CostBasis = sumLots(p.Lots)
+		if units.Sign() > 0 {
+			p.Qty.Add(p.Qty, closed)
+			units.Sub(units, closed)
+		} else {
+			p.Qty.Sub(p.Qty, closed)
+			units.Add(units, closed)
+		}
+		amount.Sub(amount, new(big.Rat).Quo(new(big.Rat).Mul(amount, closed), size))
+		if units.Sign() == 0 {
+			return
+		}
+	}
+
+	// open the rest
+	lot := Lot{TradeId: f.TradeId, Time: f.Time, Qty: new(big.Rat).Abs(units), Cost: amount}
+	if l.Method == AverageCost && len(p.Lots) > 0 {
+		p.Lots[0].Qty.Add(p.Lots[0].Qty, lot.Qty)
+		p.Lots[0].Cost.Add(p.Lots[0].Cost, lot.Cost)
+		p.Lots[0].TradeId, p.Lots[0].Time = lot.TradeId, lot.Time
+	} else {
+		p.Lots = append(p.Lots, lot)
+	}
+	p.Qty.Add(p.Qty, units)
+	p.CostBasis.Add(p.CostBasis, amount)
+}
+
+// closeLots remove qty from the lots of p and returns its cost
+func (l *Ledger) closeLots(p *Position, qty *big.Rat) *big.Rat {
+	cost := new(big.Rat)
+	left := new(big.Rat).Set(qty)
+	for left.Sign() > 0 && len(p.Lots) > 0 {
+		i := 0
+		if l.Method == LIFO {
+			i = len(p.Lots) - 1
+		}
+		lot := &p.Lots[i]
+		if lot.Qty.Cmp(left) <= 0 {
+			cost.Add(cost, lot.Cost)
+			left.Sub(left, lot.Qty)
+			p.Lots = append(p.Lots[:i], p.Lots[i+1:]...)
+			continue
+		}
+		part := new(big.Rat).Quo(new(big.Rat).Mul(lot.Cost, left), lot.Qty)
+		cost.Add(cost, part)
+		lot.Cost.Sub(lot.Cost, part)
+		lot.Qty.Sub(lot.Qty, left)
+		left.SetInt64(0)
+	}
+	return cost
+}
+
+// quoteAssets are the quote assets symbols are split on, in the order they are tried
+var quoteAssets = []string{"FDUSD", "USDT", "USDC", "BUSD", "TUSD", "DAI", "BTC", "ETH", "BNB", "EUR", "TRY", "BRL", "JPY", "USD"}
+
+func (l *Ledger) splitSymbol(symbol string) (base, quote string) {
+	if assets, ok := l.assets[symbol]; ok {
+		return assets[0], assets[1]
+	}
+	// coin-margined futures, such as BTCUSD_PERP
+	name, _, _ := strings.Cut(symbol, "_")
+	for _, quote := range quoteAssets {
+		if strings.HasSuffix(name, quote) && len(name) > len(quote) {
+			return strings.TrimSuffix(name, quote), quote
+		}
+	}
+	return "", ""
+}
+
+func sumLots(lots []Lot) *big.Rat {
+	sum := new(big.Rat)
+	for _, lot := range lots {
+		sum.Add(sum, lot.Cost)
+	}
+	return sum
+}
+
+func addTo(m map[string]*big.Rat, key string, amount *big.Rat) {
+	if m[key] == nil {
+		m[key] = new(big.Rat)
+	}
+	m[key].Add(m[key], amount)
+}
+
+func minRat(a, b *big.Rat) *big.Rat {
+	if a.Cmp(b) <= 0 {
+		return new(big.Rat).Set(a)
+	}
+	return new(big.Rat).Set(b)
+}
@@ -0,0 +1,189 @@
+package ledger_test
+
+import (
+	"math/big"
+	"testing"
+
+	binance_connector "github.com/binance/binance-connector-go"
+	"github.com/binance/binance-connector-go/ledger"
+	"github.com/stretchr/testify/suite"
+)
+
+type ledgerTestSuite struct {
+	suite.Suite
+}
+
+func TestLedger(t *testing.T) {
+	suite.Run(t, new(ledgerTestSuite))
+}
+
+func dec(s string) *big.Rat {
+	r, _ := new(big.Rat).SetString(s)
+	return r
+}
+
+func fill(tradeId int64, isBuyer bool, qty, price string) *ledger.Fill {
+	return &ledger.Fill{Account: ledger.AccountSpot, Symbol: "BTCUSDT", TradeId: tradeId, Time: tradeId, IsBuyer: isBuyer,
+		Qty: dec(qty), Price: dec(price)}
+}
+
+func (s *ledgerTestSuite) TestMethods() {
+	r := s.Require()
+	for _, tc := range []struct {
+		method            ledger.Method
+		realized, basis   string
+		lots              int
+		remainingLotPrice string
+		remainingLotCost  string
+		remainingLotId    int64
+	}{
+		{ledger.FIFO, "200", "200", 1, "200", "200", 2},
+		{ledger.LIFO, "100", "100", 1, "100", "100", 1},
+		{ledger.AverageCost, "150", "150", 1, "150", "150", 2},
+	} {
+		l := ledger.New(tc.method)
+		r.NoError(l.Add(fill(1, true, "1", "100"), fill(2, true, "1", "200"), fill(3, false, "1", "300")))
+		p := l.Position(ledger.AccountSpot, "BTCUSDT", "")
+		r.Equal("BTC", p.Base, tc.method)
+		r.Equal("USDT", p.Quote, tc.method)
+		r.Zero(p.Qty.Cmp(dec("1")), tc.method)
+		r.Zero(p.RealizedPnl.Cmp(dec(tc.realized)), "%s realized %s", tc.method, p.RealizedPnl.FloatString(8))
+		r.Zero(p.CostBasis.Cmp(dec(tc.basis)), tc.method)
+		r.Zero(p.AvgPrice().Cmp(dec(tc.remainingLotPrice)), tc.method)
+		r.Len(p.Lots, tc.lots, tc.method)
+		r.Zero(p.Lots[0].Cost.Cmp(dec(tc.remainingLotCost)), tc.method)
+		r.Equal(tc.remainingLotId, p.Lots[0].TradeId, tc.method)
+		r.Equal(3, p.Fills)
+	}
+}
+
+func (s *ledgerTestSuite) TestAverageCostIsExact() {
+	r := s.Require()
+	l := ledger.New(ledger.AverageCost)
+	r.NoError(l.Add(fill(1, true, "1", "10"), fill(2, true, "2", "10.1"), fill(3, false, "1", "11")))
+	p := l.Position(ledger.AccountSpot, "BTCUSDT", "")
+	// the average of 30.2 / 3 does not terminate
+	r.Zero(p.RealizedPnl.Cmp(dec("14/15")))
+	r.Zero(p.CostBasis.Cmp(dec("302/15")))
+	r.NoError(l.Add(fill(4, false, "2", "11")))
+	p = l.Position(ledger.AccountSpot, "BTCUSDT", "")
+	r.Zero(p.RealizedPnl.Cmp(dec("2.8")))
+	r.Zero(p.CostBasis.Sign())
+	r.Nil(p.AvgPrice())
+	r.Empty(p.Lots)
+}
+
+func (s *ledgerTestSuite) TestCommissions() {
+	r := s.Require()
+	l := ledger.New(ledger.FIFO)
+	buy := fill(1, true, "1", "100")
+	buy.Commission, buy.CommissionAsset = dec("0.001"), "BTC"
+	sell := fill(2, false, "0.999", "200")
+	sell.Commission, sell.CommissionAsset = dec("0.1998"), "USDT"
+	bnb := fill(3, true, "0.5", "200")
+	bnb.Commission, bnb.CommissionAsset = dec("0.0002"), "BNB"
+	r.NoError(l.Add(buy, sell, bnb))
+
+	p := l.Position(ledger.AccountSpot, "BTCUSDT", "")
+	// the BTC commission reduces the quantity bought, the USDT commission the proceeds
+	r.Zero(p.RealizedPnl.Cmp(dec("99.6002")), p.RealizedPnl.FloatString(8))
+	// the BNB commission is only summed
+	r.Zero(p.Qty.Cmp(dec("0.5")))
+	r.Zero(p.CostBasis.Cmp(dec("100")))
+	r.Zero(p.Commissions["BTC"].Cmp(dec("0.001")))
+	r.Zero(p.Commissions["USDT"].Cmp(dec("0.1998")))
+	r.Zero(p.Commissions["BNB"].Cmp(dec("0.0002")))
+}
+
+func (s *ledgerTestSuite) TestShortPosition() {
+	r := s.Require()
+	l := ledger.New(ledger.FIFO)
+	open := &ledger.Fill{Account: ledger.AccountFutures, Symbol: "BTCUSDT", TradeId: 1, Qty: dec("2"), Price: dec("100")}
+	flip := &ledger.Fill{Account: ledger.AccountFutures, Symbol: "BTCUSDT", TradeId: 2, IsBuyer: true, Qty: dec("3"), Price: dec("90"),
+		Commission: dec("0.27"), CommissionAsset: "USDT"}
+	r.NoError(l.Add(open))
+	p := l.Position(ledger.AccountFutures, "BTCUSDT", "")
+	r.Zero(p.Qty.Cmp(dec("-2")))
+	r.Zero(p.UnrealizedPnlAt(dec("95")).Cmp(dec("10")))
+
+	// closes the short and opens a long, the commission is split between them
+	r.NoError(l.Add(flip))
+	p = l.Position(ledger.AccountFutures, "BTCUSDT", "")
+	r.Zero(p.RealizedPnl.Cmp(dec("19.82")), p.RealizedPnl.FloatString(8))
+	r.Zero(p.Qty.Cmp(dec("1")))
+	r.Zero(p.CostBasis.Cmp(dec("90.09")))
+	r.Zero(p.UnrealizedPnlAt(dec("100")).Cmp(dec("9.91")))
+}
+
+func (s *ledgerTestSuite) TestSummary() {
+	r := s.Require()
+	l := ledger.New(ledger.FIFO)
+	eth := &ledger.Fill{Account: ledger.AccountSpot, Symbol: "ETHBTC", TradeId: 1, IsBuyer: true, Qty: dec("2"), Price: dec("0.05")}
+	r.NoError(l.Add(fill(1, true, "1", "100"), fill(2, false, "0.5", "120"), eth))
+
+	summary := l.Summary(map[string]*big.Rat{"BTCUSDT": dec("110")})
+	r.Len(summary.Positions, 2)
+	r.Equal("BTCUSDT", summary.Positions[0].Symbol)
+	r.Zero(summary.Positions[0].UnrealizedPnl.Cmp(dec("5")))
+	r.Nil(summary.Positions[1].UnrealizedPnl)
+	r.Zero(summary.RealizedPnl["USDT"].Cmp(dec("10")))
+	r.Zero(summary.UnrealizedPnl["USDT"].Cmp(dec("5")))
+	r.Zero(summary.CostBasis["USDT"].Cmp(dec("50")))
+	r.Zero(summary.CostBasis["BTC"].Cmp(dec("0.1")))
+	r.Nil(summary.UnrealizedPnl["BTC"])
+}
+
+func (s *ledgerTestSuite) TestConverters() {
+	r := s.Require()
+	spot, err := ledger.FromMyTrades([]*binance_connector.AccountTradeListResponse{
+		{Id: 7, Symbol: "BNBBTC", OrderId: 3, Price: "0.00400000", Quantity: "12.00000000", Commission: "0.01200000",
+			CommissionAsset: "BNB", Time: 1499865549590, IsBuyer: true},
+	})
+	r.NoError(err)
+	margin, err := ledger.FromMarginTrades([]*binance_connector.MarginAccountQueryTradeListResponse{
+		{Id: 8, Symbol: "BNBBTC", Price: "0.005", Qty: "1", Commission: "0", CommissionAsset: "BTC", IsIsolated: true, Time: 1499865549591},
+	})
+	r.NoError(err)
+	futures, err := ledger.FromFuturesTrades([]*binance_connector.UserTradesInfo{
+		{ID: 9, Symbol: "BTCUSDT", Price: "30000", Qty: "0.01", Commission: "0.12", CommissionAsset: "USDT", Buyer: false,
+			PositionSide: "BOTH", Time: 1499865549592},
+		{ID: 10, Symbol: "BTCUSDT", Price: "30000", Qty: "0.01", Commission: "0.12", CommissionAsset: "USDT", Buyer: false,
+			PositionSide: "SHORT", Time: 1499865549593},
+	})
+	r.NoError(err)
+
+	r.Equal(ledger.AccountSpot, spot[0].Account)
+	r.Equal(int64(7), spot[0].TradeId)
+	r.Equal(int64(3), spot[0].OrderId)
+	r.Zero(spot[0].Qty.Cmp(dec("12")))
+	r.Equal(ledger.AccountIsolatedMargin, margin[0].Account)
+	r.Empty(futures[0].PositionSide)
+	r.Equal("SHORT", futures[1].PositionSide)
+
+	_, err = ledger.FromMyTrades([]*binance_connector.AccountTradeListResponse{{Id: 1, Price: "x", Quantity: "1"}})
+	r.Error(err)
+
+	update := &binance_connector.WsOrderUpdate{Symbol: "BNBBTC", Side: "BUY", ExecutionType: "NEW", Id: 3}
+	f, err := ledger.FromOrderUpdate(ledger.AccountSpot, update)
+	r.NoError(err)
+	r.Nil(f)
+	update.ExecutionType, update.TradeId, update.LatestPrice, update.LatestVolume = "TRADE", 7, "0.004", "12"
+	update.FeeCost, update.FeeAsset, update.TransactionTime = "0.012", "BNB", 1499865549590
+	f, err = ledger.FromOrderUpdate(ledger.AccountSpot, update)
+	r.NoError(err)
+	r.True(f.IsBuyer)
+
+	// the execution from the user data stream and the trade history are counted once
+	l := ledger.New(ledger.FIFO)
+	fills := append(append(append([]*ledger.Fill{}, futures...), margin...), spot...)
+	fills = append(fills, f)
+	ledger.Sort(fills)
+	r.Equal(int64(7), fills[0].TradeId)
+	r.NoError(l.Add(fills...))
+	p := l.Position(ledger.AccountSpot, "BNBBTC", "")
+	r.Equal(1, p.Fills)
+	r.Zero(p.Commissions["BNB"].Cmp(dec("0.012")))
+	r.Len(l.Positions(), 4)
+
+	r.Error(l.Add(&ledger.Fill{Symbol: "BTCUSDT", Qty: dec("0"), Price: dec("1")}))
+}
@@ -3,7 +3,10 @@ package binance_connector
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // Get all margin assets API Endpoint
@@ -151,7 +154,7 @@ type MarginAccountNewOrderService struct {
 	newClientOrderId *string
 	icebergQty       *float64
 	newOrderRespType *string
-	sideEffectType   *string
+	sideEffectType   *SideEffectType
 	timeInForce      *string
 }
 
@@ -221,8 +224,9 @@ func (s *MarginAccountNewOrderService) NewOrderRespType(newOrderRespType string)
 	return s
 }
 
-// SideEffectType set sideEffectType
-func (s *MarginAccountNewOrderService) SideEffectType(sideEffectType string) *MarginAccountNewOrderService {
+// SideEffectType set sideEffectType, one of SideEffectTypeNoSideEffect, SideEffectTypeMarginBuy,
+// SideEffectTypeAutoRepay or SideEffectTypeAutoBorrowRepay
+func (s *MarginAccountNewOrderService) SideEffectType(sideEffectType SideEffectType) *MarginAccountNewOrderService {
 	s.sideEffectType = &sideEffectType
 	return s
 }
@@ -235,6 +239,13 @@ func (s *MarginAccountNewOrderService) TimeInForce(timeInForce string) *MarginAc
 
 // Do send request
 func (s *MarginAccountNewOrderService) Do(ctx context.Context, opts ...RequestOption) (res interface{}, err error) {
+	if s.sideEffectType != nil {
+		switch *s.sideEffectType {
+		case SideEffectTypeNoSideEffect, SideEffectTypeMarginBuy, SideEffectTypeAutoRepay, SideEffectTypeAutoBorrowRepay:
+		default:
+			return nil, fmt.Errorf("invalid sideEffectType %q", *s.sideEffectType)
+		}
+	}
 	respType := ACK
 	r := &request{
 		method:   http.MethodPost,
@@ -285,7 +296,7 @@ func (s *MarginAccountNewOrderService) Do(ctx context.Context, opts ...RequestOp
 		}
 	}
 	if s.sideEffectType != nil {
-		m["sideEffectType"] = *s.sideEffectType
+		m["sideEffectType"] = string(*s.sideEffectType)
 	}
 	if s.timeInForce != nil {
 		m["timeInForce"] = *s.timeInForce
@@ -314,7 +325,7 @@ func (s *MarginAccountNewOrderService) Do(ctx context.Context, opts ...RequestOp
 type MarginAccountNewOrderResponseACK struct {
 	Symbol        string `json:"symbol"`
 	OrderId       int64  `json:"orderId"`
-	ClientOrderId int64  `json:"clientOrderId"`
+	ClientOrderId string `json:"clientOrderId"`
 	IsIsolated    bool   `json:"isIsolated"`
 	TransactTime  uint64 `json:"transactTime"`
 }
@@ -737,20 +748,45 @@ func (s *InterestHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Range returns an iterator over every interest record accrued between from
+// and to, splitting the range into the 30 day windows accepted by the endpoint
+func (s *InterestHistoryService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*InterestHistoryRow] {
+	p := &windowPager[*InterestHistoryRow]{
+		window: 30 * 24 * time.Hour,
+		limit:  100,
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*InterestHistoryRow, error) {
+			svc := *s
+			res, err := svc.StartTime(start).EndTime(end).Current(page + 1).Size(100).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([]*InterestHistoryRow, len(res.Rows))
+			for i := range res.Rows {
+				rows[i] = &res.Rows[i]
+			}
+			return rows, nil
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 // InterestHistoryResponse define interest history response
 type InterestHistoryResponse struct {
-	Rows []struct {
-		TxId                int64  `json:"txId"`
-		InterestAccuredTime uint64 `json:"interestAccuredTime"`
-		Asset               string `json:"asset"`
-		RawAsset            string `json:"rawAsset"`
-		Principal           string `json:"principal"`
-		Interest            string `json:"interest"`
-		InterestRate        string `json:"interestRate"`
-		Type                string `json:"type"`
-		IsolatedSymbol      string `json:"isolatedSymbol"`
-	} `json:"rows"`
-	Total int `json:"total"`
+	Rows  []InterestHistoryRow `json:"rows"`
+	Total int                  `json:"total"`
+}
+
+// InterestHistoryRow define a single interest history record
+type InterestHistoryRow struct {
+	TxId                int64  `json:"txId"`
+	InterestAccuredTime uint64 `json:"interestAccuredTime"`
+	Asset               string `json:"asset"`
+	RawAsset            string `json:"rawAsset"`
+	Principal           string `json:"principal"`
+	Interest            string `json:"interest"`
+	InterestRate        string `json:"interestRate"`
+	Type                string `json:"type"`
+	IsolatedSymbol      string `json:"isolatedSymbol"`
 }
 
 // Query Force Liquidation Record (USER_DATA) API Endpoint
@@ -1148,6 +1184,23 @@ func (s *MarginAccountAllOrderService) Do(ctx context.Context, opts ...RequestOp
 	return res, nil
 }
 
+// Range returns an iterator over every margin order created between from and
+// to, splitting the range into the 24 hour windows accepted by the endpoint
+func (s *MarginAccountAllOrderService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*MarginAccountAllOrderResponse] {
+	p := &windowPager[*MarginAccountAllOrderResponse]{
+		window: 24 * time.Hour,
+		limit:  500,
+		key:    func(o *MarginAccountAllOrderResponse) any { return o.OrderId },
+		timeOf: func(o *MarginAccountAllOrderResponse) uint64 { return o.Time },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*MarginAccountAllOrderResponse, error) {
+			svc := *s
+			svc.orderId = nil
+			return svc.StartTime(start).EndTime(end).Limit(500).Do(ctx)
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 // MarginAccountAllOrderResponse define margin account all order response
 type MarginAccountAllOrderResponse struct {
 	ClientOrderId      string `json:"clientOrderId"`
@@ -2051,39 +2104,33 @@ func (s *MarginIsolatedAccountInfoService) Do(ctx context.Context, opts ...Reque
 	return res, nil
 }
 
+// IsolatedMarginAsset define the base or quote asset of an isolated margin pair
+type IsolatedMarginAsset struct {
+	Asset         string `json:"asset"`
+	BorrowEnabled bool   `json:"borrowEnabled"`
+	Borrowed      string `json:"borrowed"`
+	Free          string `json:"free"`
+	Interest      string `json:"interest"`
+	Locked        string `json:"locked"`
+	NetAsset      string `json:"netAsset"`
+	NetAssetOfBtc string `json:"netAssetOfBtc"`
+	RepayEnabled  bool   `json:"repayEnabled"`
+	TotalAsset    string `json:"totalAsset"`
+}
+
 type MarginIsolatedAccountInfoAssets struct {
-	BaseAsset struct {
-		Asset         string `json:"asset"`
-		BorrowEnabled bool   `json:"borrowEnabled"`
-		Free          string `json:"free"`
-		Interest      string `json:"interest"`
-		Locked        string `json:"locked"`
-		NetAsset      string `json:"netAsset"`
-		NetAssetOfBtc string `json:"netAssetOfBtc"`
-		RepayEnabled  bool   `json:"repayEnabled"`
-		TotalAsset    string `json:"totalAsset"`
-	} `json:"baseAsset"`
-	QuoteAsset struct {
-		Asset         string `json:"asset"`
-		BorrowEnabled bool   `json:"borrowEnabled"`
-		Free          string `json:"free"`
-		Interest      string `json:"interest"`
-		Locked        string `json:"locked"`
-		NetAsset      string `json:"netAsset"`
-		NetAssetOfBtc string `json:"netAssetOfBtc"`
-		RepayEnabled  bool   `json:"repayEnabled"`
-		TotalAsset    string `json:"totalAsset"`
-	} `json:"quoteAsset"`
-	Symbol            string `json:"symbol"`
-	IsolatedCreated   bool   `json:"isolatedCreated"`
-	Enabled           bool   `json:"enabled"`
-	MarginLevel       string `json:"marginLevel"`
-	MarginLevelStatus string `json:"marginLevelStatus"`
-	MarginRatio       string `json:"marginRatio"`
-	IndexPrice        string `json:"indexPrice"`
-	LiquidatePrice    string `json:"liquidatePrice"`
-	LiquidateRate     string `json:"liquidateRate"`
-	TradeEnabled      bool   `json:"tradeEnabled"`
+	BaseAsset         IsolatedMarginAsset `json:"baseAsset"`
+	QuoteAsset        IsolatedMarginAsset `json:"quoteAsset"`
+	Symbol            string              `json:"symbol"`
+	IsolatedCreated   bool                `json:"isolatedCreated"`
+	Enabled           bool                `json:"enabled"`
+	MarginLevel       string              `json:"marginLevel"`
+	MarginLevelStatus string              `json:"marginLevelStatus"`
+	MarginRatio       string              `json:"marginRatio"`
+	IndexPrice        string              `json:"indexPrice"`
+	LiquidatePrice    string              `json:"liquidatePrice"`
+	LiquidateRate     string              `json:"liquidateRate"`
+	TradeEnabled      bool                `json:"tradeEnabled"`
 }
 
 // MarginIsolatedAccountInfoService response if symbols parameter is not sent
@@ -2881,3 +2928,322 @@ type MarginSmallLiabilityExchangeHistoryResponse struct {
 		Timestamp    uint64 `json:"timestamp"`
 	} `json:"rows"`
 }
+
+// Margin Account Borrow/Repay (MARGIN) API Endpoint
+const (
+	marginBorrowRepayEndpoint = "/sapi/v1/margin/borrow-repay"
+)
+
+// MarginBorrowRepayService borrow or repay an asset in the cross or an isolated margin account
+type MarginBorrowRepayService struct {
+	c            *Client
+	asset        string
+	isIsolated   string
+	symbol       *string
+	amount       float64
+	transferType string
+}
+
+// Asset set asset
+func (s *MarginBorrowRepayService) Asset(asset string) *MarginBorrowRepayService {
+	s.asset = asset
+	return s
+}
+
+// IsIsolated set isIsolated, "TRUE" for isolated margin, "FALSE" for cross margin
+func (s *MarginBorrowRepayService) IsIsolated(isIsolated string) *MarginBorrowRepayService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// Symbol set symbol, required for isolated margin
+func (s *MarginBorrowRepayService) Symbol(symbol string) *MarginBorrowRepayService {
+	s.symbol = &symbol
+	return s
+}
+
+// Amount set amount
+func (s *MarginBorrowRepayService) Amount(amount float64) *MarginBorrowRepayService {
+	s.amount = amount
+	return s
+}
+
+// Type set type, BORROW or REPAY
+func (s *MarginBorrowRepayService) Type(transferType string) *MarginBorrowRepayService {
+	s.transferType = transferType
+	return s
+}
+
+// Do send request
+func (s *MarginBorrowRepayService) Do(ctx context.Context, opts ...RequestOption) (res *MarginBorrowRepayResponse, err error) {
+	isIsolated := s.isIsolated
+	if isIsolated == "" {
+		isIsolated = "FALSE"
+	}
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: marginBorrowRepayEndpoint,
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"asset":      s.asset,
+		"isIsolated": isIsolated,
+		"amount":     strconv.FormatFloat(s.amount, 'f', -1, 64),
+		"type":       s.transferType,
+	}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	r.setParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginBorrowRepayResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginBorrowRepayResponse define margin borrow/repay response
+type MarginBorrowRepayResponse struct {
+	TranId int64 `json:"tranId"`
+}
+
+// Query Borrow/Repay Records in Margin Account (USER_DATA) API Endpoint
+const (
+	marginBorrowRepayRecordEndpoint = "/sapi/v1/margin/borrow-repay"
+)
+
+// MarginBorrowRepayRecordService query borrow or repay records in the margin account
+type MarginBorrowRepayRecordService struct {
+	c              *Client
+	transferType   string
+	asset          *string
+	isolatedSymbol *string
+	txId           *int64
+	startTime      *uint64
+	endTime        *uint64
+	current        *int
+	size           *int
+}
+
+// Type set type, BORROW or REPAY
+func (s *MarginBorrowRepayRecordService) Type(transferType string) *MarginBorrowRepayRecordService {
+	s.transferType = transferType
+	return s
+}
+
+// Asset set asset
+func (s *MarginBorrowRepayRecordService) Asset(asset string) *MarginBorrowRepayRecordService {
+	s.asset = &asset
+	return s
+}
+
+// IsolatedSymbol set isolatedSymbol
+func (s *MarginBorrowRepayRecordService) IsolatedSymbol(isolatedSymbol string) *MarginBorrowRepayRecordService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// TxId set txId
+func (s *MarginBorrowRepayRecordService) TxId(txId int64) *MarginBorrowRepayRecordService {
+	s.txId = &txId
+	return s
+}
+
+// StartTime set startTime
+func (s *MarginBorrowRepayRecordService) StartTime(startTime uint64) *MarginBorrowRepayRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MarginBorrowRepayRecordService) EndTime(endTime uint64) *MarginBorrowRepayRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current
+func (s *MarginBorrowRepayRecordService) Current(current int) *MarginBorrowRepayRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *MarginBorrowRepayRecordService) Size(size int) *MarginBorrowRepayRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *MarginBorrowRepayRecordService) Do(ctx context.Context, opts ...RequestOption) (res *MarginBorrowRepayRecordResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: marginBorrowRepayRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("type", s.transferType)
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.isolatedSymbol != nil {
+		r.setParam("isolatedSymbol", *s.isolatedSymbol)
+	}
+	if s.txId != nil {
+		r.setParam("txId", *s.txId)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginBorrowRepayRecordResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginBorrowRepayRecordResponse define margin borrow/repay record response
+type MarginBorrowRepayRecordResponse struct {
+	Rows []struct {
+		IsolatedSymbol string `json:"isolatedSymbol"`
+		Amount         string `json:"amount"`
+		Asset          string `json:"asset"`
+		Interest       string `json:"interest"`
+		Principal      string `json:"principal"`
+		Status         string `json:"status"`
+		Timestamp      uint64 `json:"timestamp"`
+		TxId           int64  `json:"txId"`
+	} `json:"rows"`
+	Total int `json:"total"`
+}
+
+// Cross Margin Account Transfer (MARGIN) API Endpoint
+const (
+	crossMarginTransferEndpoint = "/sapi/v1/margin/transfer"
+)
+
+// CrossMarginTransferService transfer an asset between the spot and the cross margin account
+type CrossMarginTransferService struct {
+	c            *Client
+	asset        string
+	amount       float64
+	transferType int
+}
+
+// Asset set asset
+func (s *CrossMarginTransferService) Asset(asset string) *CrossMarginTransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *CrossMarginTransferService) Amount(amount float64) *CrossMarginTransferService {
+	s.amount = amount
+	return s
+}
+
+// Type set type, 1 from spot to cross margin, 2 from cross margin to spot
+func (s *CrossMarginTransferService) Type(transferType int) *CrossMarginTransferService {
+	s.transferType = transferType
+	return s
+}
+
+// Do send request
+func (s *CrossMarginTransferService) Do(ctx context.Context, opts ...RequestOption) (res *CrossMarginTransferResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: crossMarginTransferEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"asset":  s.asset,
+		"amount": strconv.FormatFloat(s.amount, 'f', -1, 64),
+		"type":   s.transferType,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CrossMarginTransferResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CrossMarginTransferResponse define cross margin transfer response
+type CrossMarginTransferResponse struct {
+	TranId int64 `json:"tranId"`
+}
+
+// Isolated margin transfer directions of IsolatedMarginTransferService
+const (
+	IsolatedMarginTransferIn  = "MARGIN_ISOLATEDMARGIN"
+	IsolatedMarginTransferOut = "ISOLATEDMARGIN_MARGIN"
+)
+
+// IsolatedMarginTransferService transfer an asset between the cross margin account and an
+// isolated margin account, through the user universal transfer endpoint
+type IsolatedMarginTransferService struct {
+	c         *Client
+	asset     string
+	symbol    string
+	amount    float64
+	direction string
+}
+
+// Asset set asset
+func (s *IsolatedMarginTransferService) Asset(asset string) *IsolatedMarginTransferService {
+	s.asset = asset
+	return s
+}
+
+// Symbol set the isolated margin symbol
+func (s *IsolatedMarginTransferService) Symbol(symbol string) *IsolatedMarginTransferService {
+	s.symbol = symbol
+	return s
+}
+
+// Amount set amount
+func (s *IsolatedMarginTransferService) Amount(amount float64) *IsolatedMarginTransferService {
+	s.amount = amount
+	return s
+}
+
+// Direction set direction, IsolatedMarginTransferIn or IsolatedMarginTransferOut
+func (s *IsolatedMarginTransferService) Direction(direction string) *IsolatedMarginTransferService {
+	s.direction = direction
+	return s
+}
+
+// Do send request
+func (s *IsolatedMarginTransferService) Do(ctx context.Context) (res *UserUniversalTransferResponse, err error) {
+	transfer := s.c.NewUserUniversalTransferService().TransferType(s.direction).Asset(s.asset).Amount(s.amount)
+	switch s.direction {
+	case IsolatedMarginTransferIn:
+		transfer.ToSymbol(s.symbol)
+	case IsolatedMarginTransferOut:
+		transfer.FromSymbol(s.symbol)
+	default:
+		return nil, fmt.Errorf("invalid isolated margin transfer direction %q", s.direction)
+	}
+	return transfer.Do(ctx)
+}
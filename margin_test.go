@@ -1376,3 +1376,150 @@ func (s *marginTestSuite) TestMarginToggleBnbBurn() {
 	s.Equal(true, resp.SpotBNBBurn)
 	s.Equal(false, resp.InterestBNBBurn)
 }
+
+func (s *marginTestSuite) TestMarginAccountNewOrderSideEffectType() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 28,
+		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+		"transactTime": 1507725176595,
+		"isIsolated": false
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             "BUY",
+			"type":             "STOP_LOSS",
+			"quantity":         1.0,
+			"stopPrice":        30000.0,
+			"sideEffectType":   "AUTO_BORROW_REPAY",
+			"newOrderRespType": "ACK",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewMarginAccountNewOrderService().Symbol("BTCUSDT").Side("BUY").
+		OrderType("STOP_LOSS").Quantity(1).StopPrice(30000).NewOrderRespType("ACK").
+		SideEffectType(SideEffectTypeAutoBorrowRepay).
+		Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(28), resp.(*MarginAccountNewOrderResponseACK).OrderId)
+
+	_, err = s.client.NewMarginAccountNewOrderService().Symbol("BTCUSDT").Side("BUY").
+		OrderType("MARKET").Quantity(1).SideEffectType("AUTO_BORROW").
+		Do(context.Background())
+	s.r().EqualError(err, `invalid sideEffectType "AUTO_BORROW"`)
+}
+
+func (s *marginTestSuite) TestMarginBorrowRepay() {
+	data := []byte(`{
+		"tranId": 100000001
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset":      "USDT",
+			"isIsolated": "TRUE",
+			"symbol":     "BTCUSDT",
+			"amount":     "100.5",
+			"type":       "BORROW",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewMarginBorrowRepayService().Asset("USDT").IsIsolated("TRUE").
+		Symbol("BTCUSDT").Amount(100.5).Type("BORROW").Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(100000001), resp.TranId)
+}
+
+func (s *marginTestSuite) TestMarginBorrowRepayRecord() {
+	data := []byte(`{
+		"rows": [
+			{
+				"isolatedSymbol": "BNBUSDT",
+				"amount": "14.00000000",
+				"asset": "BNB",
+				"interest": "0.01866667",
+				"principal": "13.98133333",
+				"status": "CONFIRMED",
+				"timestamp": 1563438204000,
+				"txId": 2970933056
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"type":  "REPAY",
+			"asset": "BNB",
+			"size":  10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewMarginBorrowRepayRecordService().Type("REPAY").Asset("BNB").Size(10).
+		Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(1, resp.Total)
+	s.Len(resp.Rows, 1)
+	s.Equal("13.98133333", resp.Rows[0].Principal)
+	s.Equal(int64(2970933056), resp.Rows[0].TxId)
+}
+
+func (s *marginTestSuite) TestCrossMarginTransfer() {
+	data := []byte(`{
+		"tranId": 100000001
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset":  "BTC",
+			"amount": "0.01",
+			"type":   2,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewCrossMarginTransferService().Asset("BTC").Amount(0.01).Type(2).
+		Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(100000001), resp.TranId)
+}
+
+func (s *marginTestSuite) TestIsolatedMarginTransfer() {
+	data := []byte(`{
+		"tranId": 13526853623
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"type":     "MARGIN_ISOLATEDMARGIN",
+			"asset":    "USDT",
+			"amount":   50.0,
+			"toSymbol": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewIsolatedMarginTransferService().Asset("USDT").Symbol("BTCUSDT").Amount(50).
+		Direction(IsolatedMarginTransferIn).Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(13526853623), resp.TranId)
+
+	_, err = s.client.NewIsolatedMarginTransferService().Asset("USDT").Symbol("BTCUSDT").Amount(50).
+		Direction("MAIN_MARGIN").Do(context.Background())
+	s.r().Error(err)
+}
@@ -756,6 +756,42 @@ type TickerPriceResponse struct {
 	Price  string `json:"price"`
 }
 
+// Binance Symbol Price Ticker of several symbols (GET /api/v3/ticker/price),
+// all of them when no symbol is set
+type TickerPrices struct {
+	c       *Client
+	symbols *[]string
+}
+
+// Symbols set symbols
+func (s *TickerPrices) Symbols(symbols []string) *TickerPrices {
+	s.symbols = &symbols
+	return s
+}
+
+// Send the request
+func (s *TickerPrices) Do(ctx context.Context, opts ...RequestOption) (res []*TickerPriceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/ticker/price",
+		secType:  secTypeNone,
+	}
+	if s.symbols != nil {
+		s, _ := json.Marshal(s.symbols)
+		r.setParam("symbols", string(s))
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*TickerPriceResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // Binance Symbol Order Book Ticker (GET /api/v3/ticker/bookTicker)
 type TickerBookTicker struct {
 	c       *Client
@@ -168,6 +168,43 @@ func (s *marketTestSuite) TestListBookTickers() {
 	s.assertBookTickerEqual(e2, tickers[1])
 }
 
+func (s *marketTestSuite) TestTickerPrice() {
+	data := []byte(`{"symbol": "LTCBTC", "price": "4.00000200"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", "LTCBTC")
+		s.assertRequestEqual(e, r)
+	})
+
+	ticker, err := s.client.NewTickerPriceService().Symbol("LTCBTC").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&TickerPriceResponse{Symbol: "LTCBTC", Price: "4.00000200"}, ticker)
+}
+
+func (s *marketTestSuite) TestTickerPrices() {
+	data := []byte(`[
+		{"symbol": "LTCBTC", "price": "4.00000200"},
+		{"symbol": "ETHBTC", "price": "0.07946600"}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbols", `["LTCBTC","ETHBTC"]`)
+		s.assertRequestEqual(e, r)
+	})
+
+	tickers, err := s.client.NewTickerPricesService().Symbols([]string{"LTCBTC", "ETHBTC"}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(tickers, 2)
+	r.Equal("ETHBTC", tickers[1].Symbol)
+	r.Equal("0.07946600", tickers[1].Price)
+}
+
 func (s *marketTestSuite) assertBookTickerEqual(e, a *TickerBookTickerResponse) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
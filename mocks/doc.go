@@ -0,0 +1,6 @@
+// Package mocks provides testify mocks of the domain interfaces of the
+// connector, such as SpotTradingAPI or WebsocketTradingAPI, and a Transport
+// to stub the REST responses of a Client.
+package mocks
+
+//go:generate go run ./internal/gen
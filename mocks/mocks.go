@@ -0,0 +1,3139 @@
+// Code generated by go run ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	binance_connector "github.com/binance/binance-connector-go"
+	"github.com/stretchr/testify/mock"
+)
+
+// SpotTradingAPI is a mock of binance_connector.SpotTradingAPI
+type SpotTradingAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.SpotTradingAPI = (*SpotTradingAPI)(nil)
+
+// NewSpotTradingAPI creates a SpotTradingAPI that asserts its expectations when the test ends
+func NewSpotTradingAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SpotTradingAPI {
+	m := &SpotTradingAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AmendOrderKeepPriority provides a mock function
+func (_m *SpotTradingAPI) AmendOrderKeepPriority(a0 context.Context, a1 *binance_connector.AmendOrderKeepPriorityService, a2 ...binance_connector.RequestOption) (*binance_connector.AmendOrderKeepPriorityResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AmendOrderKeepPriorityResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelOCO provides a mock function
+func (_m *SpotTradingAPI) CancelOCO(a0 context.Context, a1 *binance_connector.CancelOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.OrderOCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OrderOCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelOpenOrders provides a mock function
+func (_m *SpotTradingAPI) CancelOpenOrders(a0 context.Context, a1 *binance_connector.CancelOpenOrdersService, a2 ...binance_connector.RequestOption) ([]*binance_connector.CancelOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.CancelOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelOrder provides a mock function
+func (_m *SpotTradingAPI) CancelOrder(a0 context.Context, a1 *binance_connector.CancelOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.CancelOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.CancelOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelReplace provides a mock function
+func (_m *SpotTradingAPI) CancelReplace(a0 context.Context, a1 *binance_connector.CancelReplaceService, a2 ...binance_connector.RequestOption) (*binance_connector.CancelReplaceResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.CancelReplaceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CreateOrder provides a mock function
+func (_m *SpotTradingAPI) CreateOrder(a0 context.Context, a1 *binance_connector.CreateOrderService, a2 ...binance_connector.RequestOption) (interface{}, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(interface{})
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetAccount provides a mock function
+func (_m *SpotTradingAPI) GetAccount(a0 context.Context, a1 *binance_connector.GetAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.AccountResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AccountResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetAccountCommission provides a mock function
+func (_m *SpotTradingAPI) GetAccountCommission(a0 context.Context, a1 *binance_connector.GetAccountCommissionService, a2 ...binance_connector.RequestOption) (*binance_connector.AccountCommissionResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AccountCommissionResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetAllOrders provides a mock function
+func (_m *SpotTradingAPI) GetAllOrders(a0 context.Context, a1 *binance_connector.GetAllOrdersService, a2 ...binance_connector.RequestOption) ([]*binance_connector.NewAllOrdersResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.NewAllOrdersResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetMyTrades provides a mock function
+func (_m *SpotTradingAPI) GetMyTrades(a0 context.Context, a1 *binance_connector.GetMyTradesService, a2 ...binance_connector.RequestOption) ([]*binance_connector.AccountTradeListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.AccountTradeListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetOpenOrders provides a mock function
+func (_m *SpotTradingAPI) GetOpenOrders(a0 context.Context, a1 *binance_connector.GetOpenOrdersService, a2 ...binance_connector.RequestOption) ([]*binance_connector.NewOpenOrdersResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.NewOpenOrdersResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetOrder provides a mock function
+func (_m *SpotTradingAPI) GetOrder(a0 context.Context, a1 *binance_connector.GetOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.GetOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetQueryCurrentOrderCountUsage provides a mock function
+func (_m *SpotTradingAPI) GetQueryCurrentOrderCountUsage(a0 context.Context, a1 *binance_connector.GetQueryCurrentOrderCountUsageService, a2 ...binance_connector.RequestOption) ([]*binance_connector.QueryCurrentOrderCountUsageResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.QueryCurrentOrderCountUsageResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetQueryPreventedMatches provides a mock function
+func (_m *SpotTradingAPI) GetQueryPreventedMatches(a0 context.Context, a1 *binance_connector.GetQueryPreventedMatchesService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryPreventedMatchesResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryPreventedMatchesResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MyAllocations provides a mock function
+func (_m *SpotTradingAPI) MyAllocations(a0 context.Context, a1 *binance_connector.MyAllocationsService, a2 ...binance_connector.RequestOption) ([]*binance_connector.AllocationResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.AllocationResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// NewOCO provides a mock function
+func (_m *SpotTradingAPI) NewOCO(a0 context.Context, a1 *binance_connector.NewOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.OrderOCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OrderOCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OrderListOCO provides a mock function
+func (_m *SpotTradingAPI) OrderListOCO(a0 context.Context, a1 *binance_connector.OrderListOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.OrderListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OrderListOTO provides a mock function
+func (_m *SpotTradingAPI) OrderListOTO(a0 context.Context, a1 *binance_connector.OrderListOTOService, a2 ...binance_connector.RequestOption) (*binance_connector.OrderListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OrderListOTOCO provides a mock function
+func (_m *SpotTradingAPI) OrderListOTOCO(a0 context.Context, a1 *binance_connector.OrderListOTOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.OrderListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryAllOCO provides a mock function
+func (_m *SpotTradingAPI) QueryAllOCO(a0 context.Context, a1 *binance_connector.QueryAllOCOService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryOCO provides a mock function
+func (_m *SpotTradingAPI) QueryOCO(a0 context.Context, a1 *binance_connector.QueryOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.OCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryOpenOCO provides a mock function
+func (_m *SpotTradingAPI) QueryOpenOCO(a0 context.Context, a1 *binance_connector.QueryOpenOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.OCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryOrderAmendments provides a mock function
+func (_m *SpotTradingAPI) QueryOrderAmendments(a0 context.Context, a1 *binance_connector.QueryOrderAmendmentsService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OrderAmendment, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OrderAmendment)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SOROrder provides a mock function
+func (_m *SpotTradingAPI) SOROrder(a0 context.Context, a1 *binance_connector.SOROrderService, a2 ...binance_connector.RequestOption) (*binance_connector.SOROrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SOROrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TestNewOrder provides a mock function
+func (_m *SpotTradingAPI) TestNewOrder(a0 context.Context, a1 *binance_connector.TestNewOrder, a2 ...binance_connector.RequestOption) (*binance_connector.AccountOrderBookResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AccountOrderBookResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TestSOROrder provides a mock function
+func (_m *SpotTradingAPI) TestSOROrder(a0 context.Context, a1 *binance_connector.TestSOROrderService, a2 ...binance_connector.RequestOption) (*binance_connector.TestSOROrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.TestSOROrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarketDataAPI is a mock of binance_connector.MarketDataAPI
+type MarketDataAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.MarketDataAPI = (*MarketDataAPI)(nil)
+
+// NewMarketDataAPI creates a MarketDataAPI that asserts its expectations when the test ends
+func NewMarketDataAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarketDataAPI {
+	m := &MarketDataAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AggTradesList provides a mock function
+func (_m *MarketDataAPI) AggTradesList(a0 context.Context, a1 *binance_connector.AggTradesList, a2 ...binance_connector.RequestOption) ([]*binance_connector.AggTradesListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.AggTradesListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AggTradesRange provides a mock function
+func (_m *MarketDataAPI) AggTradesRange(a0 context.Context, a1 string, a2 time.Time, a3 time.Time, a4 ...binance_connector.IteratorOption) *binance_connector.Iterator[*binance_connector.AggTradesListResponse] {
+	args := []interface{}{a0, a1, a2, a3}
+	for _, a := range a4 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.Iterator[*binance_connector.AggTradesListResponse])
+	return r0
+}
+
+// AvgPrice provides a mock function
+func (_m *MarketDataAPI) AvgPrice(a0 context.Context, a1 *binance_connector.AvgPrice, a2 ...binance_connector.RequestOption) (*binance_connector.AvgPriceResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AvgPriceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ExchangeInfo provides a mock function
+func (_m *MarketDataAPI) ExchangeInfo(a0 context.Context, a1 *binance_connector.ExchangeInfo, a2 ...binance_connector.RequestOption) (*binance_connector.ExchangeInfoResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ExchangeInfoResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// HistoricalTradeLookup provides a mock function
+func (_m *MarketDataAPI) HistoricalTradeLookup(a0 context.Context, a1 *binance_connector.HistoricalTradeLookup, a2 ...binance_connector.RequestOption) ([]*binance_connector.RecentTradesListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.RecentTradesListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// HistoricalTradesRange provides a mock function
+func (_m *MarketDataAPI) HistoricalTradesRange(a0 context.Context, a1 string, a2 time.Time, a3 time.Time, a4 ...binance_connector.IteratorOption) *binance_connector.Iterator[*binance_connector.RecentTradesListResponse] {
+	args := []interface{}{a0, a1, a2, a3}
+	for _, a := range a4 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.Iterator[*binance_connector.RecentTradesListResponse])
+	return r0
+}
+
+// Klines provides a mock function
+func (_m *MarketDataAPI) Klines(a0 context.Context, a1 *binance_connector.Klines, a2 ...binance_connector.RequestOption) ([]*binance_connector.KlinesResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.KlinesResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// KlinesRange provides a mock function
+func (_m *MarketDataAPI) KlinesRange(a0 context.Context, a1 string, a2 string, a3 time.Time, a4 time.Time, a5 ...binance_connector.IteratorOption) *binance_connector.Iterator[*binance_connector.KlinesResponse] {
+	args := []interface{}{a0, a1, a2, a3, a4}
+	for _, a := range a5 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.Iterator[*binance_connector.KlinesResponse])
+	return r0
+}
+
+// OrderBook provides a mock function
+func (_m *MarketDataAPI) OrderBook(a0 context.Context, a1 *binance_connector.OrderBook, a2 ...binance_connector.RequestOption) (*binance_connector.OrderBookResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OrderBookResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// Ping provides a mock function
+func (_m *MarketDataAPI) Ping(a0 context.Context, a1 *binance_connector.Ping, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// RecentTradesList provides a mock function
+func (_m *MarketDataAPI) RecentTradesList(a0 context.Context, a1 *binance_connector.RecentTradesList, a2 ...binance_connector.RequestOption) ([]*binance_connector.RecentTradesListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.RecentTradesListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ServerTime provides a mock function
+func (_m *MarketDataAPI) ServerTime(a0 context.Context, a1 *binance_connector.ServerTime, a2 ...binance_connector.RequestOption) (*binance_connector.ServerTimeResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ServerTimeResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// Ticker provides a mock function
+func (_m *MarketDataAPI) Ticker(a0 context.Context, a1 *binance_connector.Ticker, a2 ...binance_connector.RequestOption) (*binance_connector.TickerResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.TickerResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// Ticker24hr provides a mock function
+func (_m *MarketDataAPI) Ticker24hr(a0 context.Context, a1 *binance_connector.Ticker24hr, a2 ...binance_connector.RequestOption) (*binance_connector.Ticker24hrResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.Ticker24hrResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TickerBookTicker provides a mock function
+func (_m *MarketDataAPI) TickerBookTicker(a0 context.Context, a1 *binance_connector.TickerBookTicker, a2 ...binance_connector.RequestOption) ([]*binance_connector.TickerBookTickerResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.TickerBookTickerResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TickerPrice provides a mock function
+func (_m *MarketDataAPI) TickerPrice(a0 context.Context, a1 *binance_connector.TickerPrice, a2 ...binance_connector.RequestOption) (*binance_connector.TickerPriceResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.TickerPriceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TickerPrices provides a mock function
+func (_m *MarketDataAPI) TickerPrices(a0 context.Context, a1 *binance_connector.TickerPrices, a2 ...binance_connector.RequestOption) ([]*binance_connector.TickerPriceResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.TickerPriceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// UIKlines provides a mock function
+func (_m *MarketDataAPI) UIKlines(a0 context.Context, a1 *binance_connector.UiKlines, a2 ...binance_connector.RequestOption) ([]*binance_connector.UiKlinesResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.UiKlinesResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// WalletAPI is a mock of binance_connector.WalletAPI
+type WalletAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.WalletAPI = (*WalletAPI)(nil)
+
+// NewWalletAPI creates a WalletAPI that asserts its expectations when the test ends
+func NewWalletAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WalletAPI {
+	m := &WalletAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// APIKeyPermission provides a mock function
+func (_m *WalletAPI) APIKeyPermission(a0 context.Context, a1 *binance_connector.APIKeyPermissionService) (*binance_connector.APIKeyPermissionResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.APIKeyPermissionResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AccountApiTradingStatus provides a mock function
+func (_m *WalletAPI) AccountApiTradingStatus(a0 context.Context, a1 *binance_connector.AccountApiTradingStatusService) (*binance_connector.AccountApiTradingStatusResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.AccountApiTradingStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AccountStatus provides a mock function
+func (_m *WalletAPI) AccountStatus(a0 context.Context, a1 *binance_connector.AccountStatusService) (*binance_connector.AccountStatusResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.AccountStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AssetDetail provides a mock function
+func (_m *WalletAPI) AssetDetail(a0 context.Context, a1 *binance_connector.AssetDetailService) (*binance_connector.AssetDetailResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.AssetDetailResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AssetDetailV2 provides a mock function
+func (_m *WalletAPI) AssetDetailV2(a0 context.Context, a1 *binance_connector.AssetDetailV2Service) (*binance_connector.AssetDetailV2Response, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.AssetDetailV2Response)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AssetDividendRecord provides a mock function
+func (_m *WalletAPI) AssetDividendRecord(a0 context.Context, a1 *binance_connector.AssetDividendRecordService) (*binance_connector.AssetDividendRecordResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.AssetDividendRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoConvertStableCoin provides a mock function
+func (_m *WalletAPI) AutoConvertStableCoin(a0 context.Context, a1 *binance_connector.AutoConvertStableCoinService) (*binance_connector.AutoConvertStableCoinResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.AutoConvertStableCoinResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// BUSDConvert provides a mock function
+func (_m *WalletAPI) BUSDConvert(a0 context.Context, a1 *binance_connector.BUSDConvertService) (*binance_connector.BUSDConvertResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.BUSDConvertResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// BUSDConvertHistory provides a mock function
+func (_m *WalletAPI) BUSDConvertHistory(a0 context.Context, a1 *binance_connector.BUSDConvertHistoryService) (*binance_connector.BUSDConvertHistoryResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.BUSDConvertHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CloudMiningPaymentHistory provides a mock function
+func (_m *WalletAPI) CloudMiningPaymentHistory(a0 context.Context, a1 *binance_connector.CloudMiningPaymentHistoryService) (*binance_connector.CloudMiningPaymentHistoryResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.CloudMiningPaymentHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// DepositAddress provides a mock function
+func (_m *WalletAPI) DepositAddress(a0 context.Context, a1 *binance_connector.DepositAddressService) (*binance_connector.DepositAddressResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.DepositAddressResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// DepositHistory provides a mock function
+func (_m *WalletAPI) DepositHistory(a0 context.Context, a1 *binance_connector.DepositHistoryService) ([]*binance_connector.DepositHistoryResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).([]*binance_connector.DepositHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// DisableFastWithdrawSwitch provides a mock function
+func (_m *WalletAPI) DisableFastWithdrawSwitch(a0 context.Context, a1 *binance_connector.DisableFastWithdrawSwitchService) (*binance_connector.DisableFastWithdrawSwitchResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.DisableFastWithdrawSwitchResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// DustLog provides a mock function
+func (_m *WalletAPI) DustLog(a0 context.Context, a1 *binance_connector.DustLogService) (*binance_connector.DustLogResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.DustLogResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// DustTransfer provides a mock function
+func (_m *WalletAPI) DustTransfer(a0 context.Context, a1 *binance_connector.DustTransferService) (*binance_connector.DustTransferResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.DustTransferResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// EnableFastWithdrawSwitch provides a mock function
+func (_m *WalletAPI) EnableFastWithdrawSwitch(a0 context.Context, a1 *binance_connector.EnableFastWithdrawSwitchService) (*binance_connector.EnableFastWithdrawSwitchResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.EnableFastWithdrawSwitchResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// FundingWallet provides a mock function
+func (_m *WalletAPI) FundingWallet(a0 context.Context, a1 *binance_connector.FundingWalletService) ([]*binance_connector.FundingWalletResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).([]*binance_connector.FundingWalletResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetAccountSnapshot provides a mock function
+func (_m *WalletAPI) GetAccountSnapshot(a0 context.Context, a1 *binance_connector.GetAccountSnapshotService) (*binance_connector.AccountSnapshotResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.AccountSnapshotResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetAllCoinsInfo provides a mock function
+func (_m *WalletAPI) GetAllCoinsInfo(a0 context.Context, a1 *binance_connector.GetAllCoinsInfoService) ([]*binance_connector.CoinInfo, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).([]*binance_connector.CoinInfo)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetSystemStatus provides a mock function
+func (_m *WalletAPI) GetSystemStatus(a0 context.Context, a1 *binance_connector.GetSystemStatusService, a2 ...binance_connector.RequestOption) ([]*binance_connector.SystemStatusResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.SystemStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TradeFee provides a mock function
+func (_m *WalletAPI) TradeFee(a0 context.Context, a1 *binance_connector.TradeFeeService) ([]*binance_connector.TradeFeeResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).([]*binance_connector.TradeFeeResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// UserAsset provides a mock function
+func (_m *WalletAPI) UserAsset(a0 context.Context, a1 *binance_connector.UserAssetService) ([]*binance_connector.UserAssetResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).([]*binance_connector.UserAssetResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// UserUniversalTransfer provides a mock function
+func (_m *WalletAPI) UserUniversalTransfer(a0 context.Context, a1 *binance_connector.UserUniversalTransferService) (*binance_connector.UserUniversalTransferResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.UserUniversalTransferResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// UserUniversalTransferHistory provides a mock function
+func (_m *WalletAPI) UserUniversalTransferHistory(a0 context.Context, a1 *binance_connector.UserUniversalTransferHistoryService) (*binance_connector.UserUniversalTransferHistoryResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.UserUniversalTransferHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// Withdraw provides a mock function
+func (_m *WalletAPI) Withdraw(a0 context.Context, a1 *binance_connector.WithdrawService) (*binance_connector.WithdrawResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.WithdrawResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// WithdrawHistory provides a mock function
+func (_m *WalletAPI) WithdrawHistory(a0 context.Context, a1 *binance_connector.WithdrawHistoryService) ([]*binance_connector.WithdrawHistoryResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).([]*binance_connector.WithdrawHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAPI is a mock of binance_connector.MarginAPI
+type MarginAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.MarginAPI = (*MarginAPI)(nil)
+
+// NewMarginAPI creates a MarginAPI that asserts its expectations when the test ends
+func NewMarginAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarginAPI {
+	m := &MarginAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AllIsolatedMarginSymbol provides a mock function
+func (_m *MarginAPI) AllIsolatedMarginSymbol(a0 context.Context, a1 *binance_connector.AllIsolatedMarginSymbolService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginIsolatedSymbolResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginIsolatedSymbolResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CrossMarginAccountDetail provides a mock function
+func (_m *MarginAPI) CrossMarginAccountDetail(a0 context.Context, a1 *binance_connector.CrossMarginAccountDetailService, a2 ...binance_connector.RequestOption) (*binance_connector.CrossMarginAccountDetailResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.CrossMarginAccountDetailResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CrossMarginTransfer provides a mock function
+func (_m *MarginAPI) CrossMarginTransfer(a0 context.Context, a1 *binance_connector.CrossMarginTransferService, a2 ...binance_connector.RequestOption) (*binance_connector.CrossMarginTransferResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.CrossMarginTransferResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CrossMarginTransferHistory provides a mock function
+func (_m *MarginAPI) CrossMarginTransferHistory(a0 context.Context, a1 *binance_connector.CrossMarginTransferHistoryService, a2 ...binance_connector.RequestOption) (*binance_connector.CrossMarginTransferHistoryResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.CrossMarginTransferHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ForceLiquidationRecord provides a mock function
+func (_m *MarginAPI) ForceLiquidationRecord(a0 context.Context, a1 *binance_connector.ForceLiquidationRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.ForceLiquidationRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ForceLiquidationRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetAllMarginAssets provides a mock function
+func (_m *MarginAPI) GetAllMarginAssets(a0 context.Context, a1 *binance_connector.GetAllMarginAssetsService, a2 ...binance_connector.RequestOption) ([]*binance_connector.GetAllMarginAssetsResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.GetAllMarginAssetsResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetAllMarginPairs provides a mock function
+func (_m *MarginAPI) GetAllMarginPairs(a0 context.Context, a1 *binance_connector.GetAllMarginPairsService, a2 ...binance_connector.RequestOption) ([]*binance_connector.GetAllMarginPairsResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.GetAllMarginPairsResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// InterestHistory provides a mock function
+func (_m *MarginAPI) InterestHistory(a0 context.Context, a1 *binance_connector.InterestHistoryService, a2 ...binance_connector.RequestOption) (*binance_connector.InterestHistoryResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.InterestHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// IsolatedMarginTransfer provides a mock function
+func (_m *MarginAPI) IsolatedMarginTransfer(a0 context.Context, a1 *binance_connector.IsolatedMarginTransferService) (*binance_connector.UserUniversalTransferResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.UserUniversalTransferResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountAllOrder provides a mock function
+func (_m *MarginAPI) MarginAccountAllOrder(a0 context.Context, a1 *binance_connector.MarginAccountAllOrderService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginAccountAllOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginAccountAllOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountCancelAllOrders provides a mock function
+func (_m *MarginAPI) MarginAccountCancelAllOrders(a0 context.Context, a1 *binance_connector.MarginAccountCancelAllOrdersService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountCancelAllOrdersResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountCancelAllOrdersResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountCancelOCO provides a mock function
+func (_m *MarginAPI) MarginAccountCancelOCO(a0 context.Context, a1 *binance_connector.MarginAccountCancelOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountCancelOCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountCancelOCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountCancelOrder provides a mock function
+func (_m *MarginAPI) MarginAccountCancelOrder(a0 context.Context, a1 *binance_connector.MarginAccountCancelOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountCancelOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountCancelOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountNewOCO provides a mock function
+func (_m *MarginAPI) MarginAccountNewOCO(a0 context.Context, a1 *binance_connector.MarginAccountNewOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountNewOCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountNewOCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountNewOrder provides a mock function
+func (_m *MarginAPI) MarginAccountNewOrder(a0 context.Context, a1 *binance_connector.MarginAccountNewOrderService, a2 ...binance_connector.RequestOption) (interface{}, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(interface{})
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountOpenOrder provides a mock function
+func (_m *MarginAPI) MarginAccountOpenOrder(a0 context.Context, a1 *binance_connector.MarginAccountOpenOrderService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginAccountOpenOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginAccountOpenOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountOrder provides a mock function
+func (_m *MarginAPI) MarginAccountOrder(a0 context.Context, a1 *binance_connector.MarginAccountOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountQueryAllOCO provides a mock function
+func (_m *MarginAPI) MarginAccountQueryAllOCO(a0 context.Context, a1 *binance_connector.MarginAccountQueryAllOCOService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginAccountQueryAllOCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginAccountQueryAllOCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountQueryMaxBorrow provides a mock function
+func (_m *MarginAPI) MarginAccountQueryMaxBorrow(a0 context.Context, a1 *binance_connector.MarginAccountQueryMaxBorrowService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountQueryMaxBorrowResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountQueryMaxBorrowResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountQueryMaxTransferOutAmount provides a mock function
+func (_m *MarginAPI) MarginAccountQueryMaxTransferOutAmount(a0 context.Context, a1 *binance_connector.MarginAccountQueryMaxTransferOutAmountService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountQueryMaxTransferOutAmountResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountQueryMaxTransferOutAmountResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountQueryOCO provides a mock function
+func (_m *MarginAPI) MarginAccountQueryOCO(a0 context.Context, a1 *binance_connector.MarginAccountQueryOCOService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountQueryOCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountQueryOCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountQueryOpenOCO provides a mock function
+func (_m *MarginAPI) MarginAccountQueryOpenOCO(a0 context.Context, a1 *binance_connector.MarginAccountQueryOpenOCOService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginAccountQueryOpenOCOResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginAccountQueryOpenOCOResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountQueryTradeList provides a mock function
+func (_m *MarginAPI) MarginAccountQueryTradeList(a0 context.Context, a1 *binance_connector.MarginAccountQueryTradeListService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginAccountQueryTradeListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginAccountQueryTradeListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginAccountSummary provides a mock function
+func (_m *MarginAPI) MarginAccountSummary(a0 context.Context, a1 *binance_connector.MarginAccountSummaryService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountSummaryResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountSummaryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginBnbBurnStatus provides a mock function
+func (_m *MarginAPI) MarginBnbBurnStatus(a0 context.Context, a1 *binance_connector.MarginBnbBurnStatusService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginBnbBurnStatusResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginBnbBurnStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginBorrowRepay provides a mock function
+func (_m *MarginAPI) MarginBorrowRepay(a0 context.Context, a1 *binance_connector.MarginBorrowRepayService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginBorrowRepayResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginBorrowRepayResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginBorrowRepayRecord provides a mock function
+func (_m *MarginAPI) MarginBorrowRepayRecord(a0 context.Context, a1 *binance_connector.MarginBorrowRepayRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginBorrowRepayRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginBorrowRepayRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginCrossCollateralRatio provides a mock function
+func (_m *MarginAPI) MarginCrossCollateralRatio(a0 context.Context, a1 *binance_connector.MarginCrossCollateralRatioService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginCrossCollateralRatioResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginCrossCollateralRatioResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginCrossMarginFee provides a mock function
+func (_m *MarginAPI) MarginCrossMarginFee(a0 context.Context, a1 *binance_connector.MarginCrossMarginFeeService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginCrossMarginFeeResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginCrossMarginFeeResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginCurrentOrderCount provides a mock function
+func (_m *MarginAPI) MarginCurrentOrderCount(a0 context.Context, a1 *binance_connector.MarginCurrentOrderCountService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginCurrentOrderCountResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginCurrentOrderCountResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginInterestRateHistory provides a mock function
+func (_m *MarginAPI) MarginInterestRateHistory(a0 context.Context, a1 *binance_connector.MarginInterestRateHistoryService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginInterestRateHistoryResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginInterestRateHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginIsolatedAccountDisable provides a mock function
+func (_m *MarginAPI) MarginIsolatedAccountDisable(a0 context.Context, a1 *binance_connector.MarginIsolatedAccountDisableService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginIsolatedAccountDisableResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginIsolatedAccountDisableResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginIsolatedAccountEnable provides a mock function
+func (_m *MarginAPI) MarginIsolatedAccountEnable(a0 context.Context, a1 *binance_connector.MarginIsolatedAccountEnableService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginIsolatedAccountEnableResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginIsolatedAccountEnableResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginIsolatedAccountInfo provides a mock function
+func (_m *MarginAPI) MarginIsolatedAccountInfo(a0 context.Context, a1 *binance_connector.MarginIsolatedAccountInfoService, a2 ...binance_connector.RequestOption) (interface{}, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(interface{})
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginIsolatedAccountLimit provides a mock function
+func (_m *MarginAPI) MarginIsolatedAccountLimit(a0 context.Context, a1 *binance_connector.MarginIsolatedAccountLimitService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginIsolatedAccountLimitResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginIsolatedAccountLimitResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginIsolatedMarginFee provides a mock function
+func (_m *MarginAPI) MarginIsolatedMarginFee(a0 context.Context, a1 *binance_connector.MarginIsolatedMarginFeeService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginIsolatedMarginFeeResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginIsolatedMarginFeeResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginIsolatedMarginTier provides a mock function
+func (_m *MarginAPI) MarginIsolatedMarginTier(a0 context.Context, a1 *binance_connector.MarginIsolatedMarginTierService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginIsolatedMarginTierResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginIsolatedMarginTierResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginSmallLiabilityExchange provides a mock function
+func (_m *MarginAPI) MarginSmallLiabilityExchange(a0 context.Context, a1 *binance_connector.MarginSmallLiabilityExchangeService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginSmallLiabilityExchangeResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginSmallLiabilityExchangeResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginSmallLiabilityExchangeCoinList provides a mock function
+func (_m *MarginAPI) MarginSmallLiabilityExchangeCoinList(a0 context.Context, a1 *binance_connector.MarginSmallLiabilityExchangeCoinListService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginSmallLiabilityExchangeCoinListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginSmallLiabilityExchangeCoinListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginSmallLiabilityExchangeHistory provides a mock function
+func (_m *MarginAPI) MarginSmallLiabilityExchangeHistory(a0 context.Context, a1 *binance_connector.MarginSmallLiabilityExchangeHistoryService, a2 ...binance_connector.RequestOption) ([]*binance_connector.MarginSmallLiabilityExchangeHistoryResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.MarginSmallLiabilityExchangeHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginToggleBnbBurn provides a mock function
+func (_m *MarginAPI) MarginToggleBnbBurn(a0 context.Context, a1 *binance_connector.MarginToggleBnbBurnService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginToggleBnbBurnResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginToggleBnbBurnResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryMarginPriceIndex provides a mock function
+func (_m *MarginAPI) QueryMarginPriceIndex(a0 context.Context, a1 *binance_connector.QueryMarginPriceIndexService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryMarginPriceIndexResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryMarginPriceIndexResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SubAccountAPI is a mock of binance_connector.SubAccountAPI
+type SubAccountAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.SubAccountAPI = (*SubAccountAPI)(nil)
+
+// NewSubAccountAPI creates a SubAccountAPI that asserts its expectations when the test ends
+func NewSubAccountAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubAccountAPI {
+	m := &SubAccountAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// CreateSubAccount provides a mock function
+func (_m *SubAccountAPI) CreateSubAccount(a0 context.Context, a1 *binance_connector.CreateSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.CreateSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.CreateSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// DeleteIPListForSubAccountAPIKey provides a mock function
+func (_m *SubAccountAPI) DeleteIPListForSubAccountAPIKey(a0 context.Context, a1 *binance_connector.DeleteIPListForSubAccountAPIKeyService, a2 ...binance_connector.RequestOption) (*binance_connector.DeleteIPListForSubAccountAPIKeyResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.DeleteIPListForSubAccountAPIKeyResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// DepositAssetsIntoManagedSubAccount provides a mock function
+func (_m *SubAccountAPI) DepositAssetsIntoManagedSubAccount(a0 context.Context, a1 *binance_connector.DepositAssetsIntoTheManagedSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.DepositAssetsIntoTheManagedSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.DepositAssetsIntoTheManagedSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// EnableFuturesForSubAccount provides a mock function
+func (_m *SubAccountAPI) EnableFuturesForSubAccount(a0 context.Context, a1 *binance_connector.EnableFuturesForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.EnableFuturesForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.EnableFuturesForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// EnableLeverageTokenForSubAccount provides a mock function
+func (_m *SubAccountAPI) EnableLeverageTokenForSubAccount(a0 context.Context, a1 *binance_connector.EnableLeverageTokenForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.EnableLeverageTokenForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.EnableLeverageTokenForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// EnableMarginForSubAccount provides a mock function
+func (_m *SubAccountAPI) EnableMarginForSubAccount(a0 context.Context, a1 *binance_connector.EnableMarginForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.EnableMarginForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.EnableMarginForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// EnableOptionsForSubAccount provides a mock function
+func (_m *SubAccountAPI) EnableOptionsForSubAccount(a0 context.Context, a1 *binance_connector.EnableOptionsForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.EnableOptionsForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.EnableOptionsForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// FuturesTransferForSubAccount provides a mock function
+func (_m *SubAccountAPI) FuturesTransferForSubAccount(a0 context.Context, a1 *binance_connector.FuturesTransferForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.FuturesTransferForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.FuturesTransferForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetDetailOnSubAccountFuturesAccount provides a mock function
+func (_m *SubAccountAPI) GetDetailOnSubAccountFuturesAccount(a0 context.Context, a1 *binance_connector.GetDetailOnSubAccountFuturesAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.GetDetailOnSubAccountFuturesAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetDetailOnSubAccountFuturesAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetDetailOnSubAccountFuturesAccountV2 provides a mock function
+func (_m *SubAccountAPI) GetDetailOnSubAccountFuturesAccountV2(a0 context.Context, a1 *binance_connector.GetDetailOnSubAccountFuturesAccountV2Service, a2 ...binance_connector.RequestOption) (interface{}, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(interface{})
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetDetailOnSubAccountMarginAccount provides a mock function
+func (_m *SubAccountAPI) GetDetailOnSubAccountMarginAccount(a0 context.Context, a1 *binance_connector.GetDetailOnSubAccountMarginAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.GetDetailOnSubAccountMarginAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetDetailOnSubAccountMarginAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetFuturesPositionRiskOfSubAccount provides a mock function
+func (_m *SubAccountAPI) GetFuturesPositionRiskOfSubAccount(a0 context.Context, a1 *binance_connector.GetFuturesPositionRiskOfSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.GetFuturesPositionRiskOfSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetFuturesPositionRiskOfSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetFuturesPositionRiskOfSubAccountV2 provides a mock function
+func (_m *SubAccountAPI) GetFuturesPositionRiskOfSubAccountV2(a0 context.Context, a1 *binance_connector.GetFuturesPositionRiskOfSubAccountV2Service, a2 ...binance_connector.RequestOption) (interface{}, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(interface{})
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetIPRestrictionForSubAccountAPIKey provides a mock function
+func (_m *SubAccountAPI) GetIPRestrictionForSubAccountAPIKey(a0 context.Context, a1 *binance_connector.GetIPRestrictionForSubAccountAPIKeyService, a2 ...binance_connector.RequestOption) (*binance_connector.GetIPRestrictionForSubAccountAPIKeyResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetIPRestrictionForSubAccountAPIKeyResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetIPRestrictionThirdPartyListForSubAccountAPIKey provides a mock function
+func (_m *SubAccountAPI) GetIPRestrictionThirdPartyListForSubAccountAPIKey(a0 context.Context, a1 *binance_connector.GetIPRestrictionThirdPartyListForSubAccountAPIKeyService, a2 ...binance_connector.RequestOption) ([]*binance_connector.GetIPRestrictionThirdPartyListForSubAccountAPIKeyResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.GetIPRestrictionThirdPartyListForSubAccountAPIKeyResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetManagedSubAccountDepositAddress provides a mock function
+func (_m *SubAccountAPI) GetManagedSubAccountDepositAddress(a0 context.Context, a1 *binance_connector.GetManagedSubAccountDepositAddressService, a2 ...binance_connector.RequestOption) (*binance_connector.GetManagedSubAccountDepositAddressResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetManagedSubAccountDepositAddressResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetSubAccountDepositAddress provides a mock function
+func (_m *SubAccountAPI) GetSubAccountDepositAddress(a0 context.Context, a1 *binance_connector.GetSubAccountDepositAddressService, a2 ...binance_connector.RequestOption) (*binance_connector.GetSubAccountDepositAddressResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetSubAccountDepositAddressResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetSubAccountDepositHistory provides a mock function
+func (_m *SubAccountAPI) GetSubAccountDepositHistory(a0 context.Context, a1 *binance_connector.GetSubAccountDepositHistoryService, a2 ...binance_connector.RequestOption) (*binance_connector.GetSubAccountDepositHistoryResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetSubAccountDepositHistoryResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetSubAccountStatus provides a mock function
+func (_m *SubAccountAPI) GetSubAccountStatus(a0 context.Context, a1 *binance_connector.GetSubAccountStatusService, a2 ...binance_connector.RequestOption) (*binance_connector.GetSubAccountStatusResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetSubAccountStatusResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetSummaryOfSubAccountFuturesAccount provides a mock function
+func (_m *SubAccountAPI) GetSummaryOfSubAccountFuturesAccount(a0 context.Context, a1 *binance_connector.GetSummaryOfSubAccountFuturesAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.GetSummaryOfSubAccountFuturesAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetSummaryOfSubAccountFuturesAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetSummaryOfSubAccountFuturesAccountV2 provides a mock function
+func (_m *SubAccountAPI) GetSummaryOfSubAccountFuturesAccountV2(a0 context.Context, a1 *binance_connector.GetSummaryOfSubAccountFuturesAccountV2Service, a2 ...binance_connector.RequestOption) (interface{}, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(interface{})
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// GetSummaryOfSubAccountMarginAccount provides a mock function
+func (_m *SubAccountAPI) GetSummaryOfSubAccountMarginAccount(a0 context.Context, a1 *binance_connector.GetSummaryOfSubAccountMarginAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.GetSummaryOfSubAccountMarginAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.GetSummaryOfSubAccountMarginAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MarginTransferForSubAccount provides a mock function
+func (_m *SubAccountAPI) MarginTransferForSubAccount(a0 context.Context, a1 *binance_connector.MarginTransferForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginTransferForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginTransferForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// MoveFuturesPositionForSubAccount provides a mock function
+func (_m *SubAccountAPI) MoveFuturesPositionForSubAccount(a0 context.Context, a1 *binance_connector.MoveFuturesPositionForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.MoveFuturesPositionForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MoveFuturesPositionForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryManagedSubAccountAssetDetails provides a mock function
+func (_m *SubAccountAPI) QueryManagedSubAccountAssetDetails(a0 context.Context, a1 *binance_connector.QueryManagedSubAccountAssetDetailsService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryManagedSubAccountAssetDetailsResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryManagedSubAccountAssetDetailsResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryManagedSubAccountFuturesAssetDetails provides a mock function
+func (_m *SubAccountAPI) QueryManagedSubAccountFuturesAssetDetails(a0 context.Context, a1 *binance_connector.QueryManagedSubAccountFuturesAssetDetailsService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryManagedSubAccountFuturesAssetDetailsResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryManagedSubAccountFuturesAssetDetailsResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryManagedSubAccountList provides a mock function
+func (_m *SubAccountAPI) QueryManagedSubAccountList(a0 context.Context, a1 *binance_connector.QueryManagedSubAccountList, a2 ...binance_connector.RequestOption) (*binance_connector.QueryManagedSubAccountListResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryManagedSubAccountListResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryManagedSubAccountMarginAssetDetails provides a mock function
+func (_m *SubAccountAPI) QueryManagedSubAccountMarginAssetDetails(a0 context.Context, a1 *binance_connector.QueryManagedSubAccountMarginAssetDetailsService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryManagedSubAccountMarginAssetDetailsResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryManagedSubAccountMarginAssetDetailsResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryManagedSubAccountSnapshot provides a mock function
+func (_m *SubAccountAPI) QueryManagedSubAccountSnapshot(a0 context.Context, a1 *binance_connector.QueryManagedSubAccountSnapshotService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryManagedSubAccountSnapshotResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryManagedSubAccountSnapshotResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryManagedSubAccountTransferLog provides a mock function
+func (_m *SubAccountAPI) QueryManagedSubAccountTransferLog(a0 context.Context, a1 *binance_connector.QueryManagedSubAccountTransferLogService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryManagedSubAccountTransferLogResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryManagedSubAccountTransferLogResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryManagedSubAccountTransferLogForTradingTeam provides a mock function
+func (_m *SubAccountAPI) QueryManagedSubAccountTransferLogForTradingTeam(a0 context.Context, a1 *binance_connector.QueryManagedSubAccountTransferLogForTradingTeamService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryManagedSubAccountTransferLogForTradingTeamResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryManagedSubAccountTransferLogForTradingTeamResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryMoveFuturesPositionHistoryForSubAccount provides a mock function
+func (_m *SubAccountAPI) QueryMoveFuturesPositionHistoryForSubAccount(a0 context.Context, a1 *binance_connector.QueryMoveFuturesPositionHistoryForSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.QueryMoveFuturesPositionHistoryForSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QueryMoveFuturesPositionHistoryForSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QuerySubAccountAssets provides a mock function
+func (_m *SubAccountAPI) QuerySubAccountAssets(a0 context.Context, a1 *binance_connector.QuerySubAccountAssetsService, a2 ...binance_connector.RequestOption) (*binance_connector.QuerySubAccountAssetsResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QuerySubAccountAssetsResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QuerySubAccountAssetsForMasterAccount provides a mock function
+func (_m *SubAccountAPI) QuerySubAccountAssetsForMasterAccount(a0 context.Context, a1 *binance_connector.QuerySubAccountAssetsForMasterAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.QuerySubAccountAssetsForMasterAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QuerySubAccountAssetsForMasterAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QuerySubAccountFuturesAssetTransferHistory provides a mock function
+func (_m *SubAccountAPI) QuerySubAccountFuturesAssetTransferHistory(a0 context.Context, a1 *binance_connector.QuerySubAccountFuturesAssetTransferHistoryService, a2 ...binance_connector.RequestOption) (*binance_connector.QuerySubAccountFuturesAssetTransferHistoryResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QuerySubAccountFuturesAssetTransferHistoryResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QuerySubAccountList provides a mock function
+func (_m *SubAccountAPI) QuerySubAccountList(a0 context.Context, a1 *binance_connector.QuerySubAccountListService, a2 ...binance_connector.RequestOption) (*binance_connector.SubAccountListResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SubAccountListResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QuerySubAccountSpotAssetTransferHistory provides a mock function
+func (_m *SubAccountAPI) QuerySubAccountSpotAssetTransferHistory(a0 context.Context, a1 *binance_connector.QuerySubAccountSpotAssetTransferHistoryService, a2 ...binance_connector.RequestOption) (*binance_connector.QuerySubAccountSpotAssetTransferHistoryResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QuerySubAccountSpotAssetTransferHistoryResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QuerySubAccountSpotAssetsSummary provides a mock function
+func (_m *SubAccountAPI) QuerySubAccountSpotAssetsSummary(a0 context.Context, a1 *binance_connector.QuerySubAccountSpotAssetsSummaryService, a2 ...binance_connector.RequestOption) (*binance_connector.QuerySubAccountSpotAssetsSummaryResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QuerySubAccountSpotAssetsSummaryResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QuerySubAccountTransactionTatistics provides a mock function
+func (_m *SubAccountAPI) QuerySubAccountTransactionTatistics(a0 context.Context, a1 *binance_connector.QuerySubAccountTransactionTatistics, a2 ...binance_connector.RequestOption) (*binance_connector.QuerySubAccountTransactionTatisticsResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.QuerySubAccountTransactionTatisticsResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryUniversalTransferHistory provides a mock function
+func (_m *SubAccountAPI) QueryUniversalTransferHistory(a0 context.Context, a1 *binance_connector.QueryUniversalTransferHistoryService, a2 ...binance_connector.RequestOption) (binance_connector.QueryUniversalTransferHistoryResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(binance_connector.QueryUniversalTransferHistoryResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SubAccountFuturesAssetTransfer provides a mock function
+func (_m *SubAccountAPI) SubAccountFuturesAssetTransfer(a0 context.Context, a1 *binance_connector.SubAccountFuturesAssetTransferService, a2 ...binance_connector.RequestOption) (*binance_connector.SubAccountFuturesAssetTransferResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SubAccountFuturesAssetTransferResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SubAccountTransferHistory provides a mock function
+func (_m *SubAccountAPI) SubAccountTransferHistory(a0 context.Context, a1 *binance_connector.SubAccountTransferHistoryService, a2 ...binance_connector.RequestOption) (*binance_connector.SubAccountTransferHistoryResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SubAccountTransferHistoryResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TransferToMaster provides a mock function
+func (_m *SubAccountAPI) TransferToMaster(a0 context.Context, a1 *binance_connector.TransferToMasterService, a2 ...binance_connector.RequestOption) (*binance_connector.TransferToMasterResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.TransferToMasterResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TransferToSubAccountOfSameMaster provides a mock function
+func (_m *SubAccountAPI) TransferToSubAccountOfSameMaster(a0 context.Context, a1 *binance_connector.TransferToSubAccountOfSameMasterService, a2 ...binance_connector.RequestOption) (*binance_connector.TransferToSubAccountOfSameMasterResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.TransferToSubAccountOfSameMasterResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// UniversalTransfer provides a mock function
+func (_m *SubAccountAPI) UniversalTransfer(a0 context.Context, a1 *binance_connector.UniversalTransferService, a2 ...binance_connector.RequestOption) (*binance_connector.UniversalTransferResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.UniversalTransferResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// UpdateIPRestrictionForSubAccountAPIKey provides a mock function
+func (_m *SubAccountAPI) UpdateIPRestrictionForSubAccountAPIKey(a0 context.Context, a1 *binance_connector.UpdateIPRestrictionForSubAccountAPIKeyService, a2 ...binance_connector.RequestOption) (*binance_connector.UpdateIPRestrictionForSubAccountAPIKeyResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.UpdateIPRestrictionForSubAccountAPIKeyResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// WithdrawAssetsFromTheManagedSubAccount provides a mock function
+func (_m *SubAccountAPI) WithdrawAssetsFromTheManagedSubAccount(a0 context.Context, a1 *binance_connector.WithdrawAssetsFromTheManagedSubAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.WithdrawAssetsFromTheManagedSubAccountResp, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.WithdrawAssetsFromTheManagedSubAccountResp)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// UserStreamAPI is a mock of binance_connector.UserStreamAPI
+type UserStreamAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.UserStreamAPI = (*UserStreamAPI)(nil)
+
+// NewUserStreamAPI creates a UserStreamAPI that asserts its expectations when the test ends
+func NewUserStreamAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserStreamAPI {
+	m := &UserStreamAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// CloseUserStream provides a mock function
+func (_m *UserStreamAPI) CloseUserStream(a0 context.Context, a1 *binance_connector.CloseUserStream, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// CreateListenKey provides a mock function
+func (_m *UserStreamAPI) CreateListenKey(a0 context.Context, a1 *binance_connector.CreateListenKey, a2 ...binance_connector.RequestOption) (string, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PingUserStream provides a mock function
+func (_m *UserStreamAPI) PingUserStream(a0 context.Context, a1 *binance_connector.PingUserStream, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// ConvertAPI is a mock of binance_connector.ConvertAPI
+type ConvertAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.ConvertAPI = (*ConvertAPI)(nil)
+
+// NewConvertAPI creates a ConvertAPI that asserts its expectations when the test ends
+func NewConvertAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConvertAPI {
+	m := &ConvertAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Convert provides a mock function
+func (_m *ConvertAPI) Convert(a0 context.Context, a1 *binance_connector.ConvertService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertResult, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertResult)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertAcceptQuote provides a mock function
+func (_m *ConvertAPI) ConvertAcceptQuote(a0 context.Context, a1 *binance_connector.ConvertAcceptQuoteService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertAcceptQuoteResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertAcceptQuoteResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertAssetInfo provides a mock function
+func (_m *ConvertAPI) ConvertAssetInfo(a0 context.Context, a1 *binance_connector.ConvertAssetInfoService, a2 ...binance_connector.RequestOption) ([]*binance_connector.ConvertAssetInfoResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.ConvertAssetInfoResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertExchangeInfo provides a mock function
+func (_m *ConvertAPI) ConvertExchangeInfo(a0 context.Context, a1 *binance_connector.ConvertExchangeInfoService, a2 ...binance_connector.RequestOption) ([]*binance_connector.ConvertExchangeInfoResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.ConvertExchangeInfoResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertGetQuote provides a mock function
+func (_m *ConvertAPI) ConvertGetQuote(a0 context.Context, a1 *binance_connector.ConvertGetQuoteService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertQuoteResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertQuoteResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertLimitCancelOrder provides a mock function
+func (_m *ConvertAPI) ConvertLimitCancelOrder(a0 context.Context, a1 *binance_connector.ConvertLimitCancelOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertLimitOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertLimitOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertLimitPlaceOrder provides a mock function
+func (_m *ConvertAPI) ConvertLimitPlaceOrder(a0 context.Context, a1 *binance_connector.ConvertLimitPlaceOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertLimitOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertLimitOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertLimitQueryOpenOrders provides a mock function
+func (_m *ConvertAPI) ConvertLimitQueryOpenOrders(a0 context.Context, a1 *binance_connector.ConvertLimitQueryOpenOrdersService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertLimitOpenOrdersResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertLimitOpenOrdersResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertOrderStatus provides a mock function
+func (_m *ConvertAPI) ConvertOrderStatus(a0 context.Context, a1 *binance_connector.ConvertOrderStatusService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertOrderStatusResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertOrderStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// ConvertTradeFlow provides a mock function
+func (_m *ConvertAPI) ConvertTradeFlow(a0 context.Context, a1 *binance_connector.ConvertTradeFlowService, a2 ...binance_connector.RequestOption) (*binance_connector.ConvertTradeFlowResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.ConvertTradeFlowResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnAPI is a mock of binance_connector.SimpleEarnAPI
+type SimpleEarnAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.SimpleEarnAPI = (*SimpleEarnAPI)(nil)
+
+// NewSimpleEarnAPI creates a SimpleEarnAPI that asserts its expectations when the test ends
+func NewSimpleEarnAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SimpleEarnAPI {
+	m := &SimpleEarnAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// SimpleEarnAccount provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnAccount(a0 context.Context, a1 *binance_connector.SimpleEarnAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnAccountResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnAccountResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexiblePersonalLeftQuota provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexiblePersonalLeftQuota(a0 context.Context, a1 *binance_connector.SimpleEarnFlexiblePersonalLeftQuotaService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnPersonalLeftQuotaResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnPersonalLeftQuotaResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexiblePosition provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexiblePosition(a0 context.Context, a1 *binance_connector.SimpleEarnFlexiblePositionService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnFlexiblePositionResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnFlexiblePositionResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexibleProductList provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexibleProductList(a0 context.Context, a1 *binance_connector.SimpleEarnFlexibleProductListService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnFlexibleProductListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnFlexibleProductListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexibleRedeem provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexibleRedeem(a0 context.Context, a1 *binance_connector.SimpleEarnFlexibleRedeemService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnRedeemResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnRedeemResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexibleRedemptionRecord provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexibleRedemptionRecord(a0 context.Context, a1 *binance_connector.SimpleEarnFlexibleRedemptionRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnFlexibleRedemptionRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnFlexibleRedemptionRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexibleRewardsRecord provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexibleRewardsRecord(a0 context.Context, a1 *binance_connector.SimpleEarnFlexibleRewardsRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnFlexibleRewardsRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnFlexibleRewardsRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexibleSubscribe provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexibleSubscribe(a0 context.Context, a1 *binance_connector.SimpleEarnFlexibleSubscribeService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnSubscribeResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnSubscribeResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnFlexibleSubscriptionRecord provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnFlexibleSubscriptionRecord(a0 context.Context, a1 *binance_connector.SimpleEarnFlexibleSubscriptionRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnFlexibleSubscriptionRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnFlexibleSubscriptionRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedPersonalLeftQuota provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedPersonalLeftQuota(a0 context.Context, a1 *binance_connector.SimpleEarnLockedPersonalLeftQuotaService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnPersonalLeftQuotaResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnPersonalLeftQuotaResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedPosition provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedPosition(a0 context.Context, a1 *binance_connector.SimpleEarnLockedPositionService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnLockedPositionResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnLockedPositionResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedProductList provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedProductList(a0 context.Context, a1 *binance_connector.SimpleEarnLockedProductListService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnLockedProductListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnLockedProductListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedRedeem provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedRedeem(a0 context.Context, a1 *binance_connector.SimpleEarnLockedRedeemService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnRedeemResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnRedeemResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedRedemptionRecord provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedRedemptionRecord(a0 context.Context, a1 *binance_connector.SimpleEarnLockedRedemptionRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnLockedRedemptionRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnLockedRedemptionRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedRewardsRecord provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedRewardsRecord(a0 context.Context, a1 *binance_connector.SimpleEarnLockedRewardsRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnLockedRewardsRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnLockedRewardsRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedSubscribe provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedSubscribe(a0 context.Context, a1 *binance_connector.SimpleEarnLockedSubscribeService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnSubscribeResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnSubscribeResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// SimpleEarnLockedSubscriptionRecord provides a mock function
+func (_m *SimpleEarnAPI) SimpleEarnLockedSubscriptionRecord(a0 context.Context, a1 *binance_connector.SimpleEarnLockedSubscriptionRecordService, a2 ...binance_connector.RequestOption) (*binance_connector.SimpleEarnLockedSubscriptionRecordResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.SimpleEarnLockedSubscriptionRecordResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestAPI is a mock of binance_connector.AutoInvestAPI
+type AutoInvestAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.AutoInvestAPI = (*AutoInvestAPI)(nil)
+
+// NewAutoInvestAPI creates a AutoInvestAPI that asserts its expectations when the test ends
+func NewAutoInvestAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AutoInvestAPI {
+	m := &AutoInvestAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AutoInvestHoldingDetails provides a mock function
+func (_m *AutoInvestAPI) AutoInvestHoldingDetails(a0 context.Context, a1 *binance_connector.AutoInvestHoldingDetailsService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestHoldingDetailsResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestHoldingDetailsResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestIndexInfo provides a mock function
+func (_m *AutoInvestAPI) AutoInvestIndexInfo(a0 context.Context, a1 *binance_connector.AutoInvestIndexInfoService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestIndexInfoResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestIndexInfoResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestOneTimeTransaction provides a mock function
+func (_m *AutoInvestAPI) AutoInvestOneTimeTransaction(a0 context.Context, a1 *binance_connector.AutoInvestOneTimeTransactionService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestOneTimeTransactionResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestOneTimeTransactionResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestOneTimeTransactionStatus provides a mock function
+func (_m *AutoInvestAPI) AutoInvestOneTimeTransactionStatus(a0 context.Context, a1 *binance_connector.AutoInvestOneTimeTransactionStatusService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestOneTimeTransactionStatusResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestOneTimeTransactionStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestPlanAdd provides a mock function
+func (_m *AutoInvestAPI) AutoInvestPlanAdd(a0 context.Context, a1 *binance_connector.AutoInvestPlanAddService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestPlanResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestPlanResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestPlanEdit provides a mock function
+func (_m *AutoInvestAPI) AutoInvestPlanEdit(a0 context.Context, a1 *binance_connector.AutoInvestPlanEditService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestPlanResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestPlanResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestPlanEditStatus provides a mock function
+func (_m *AutoInvestAPI) AutoInvestPlanEditStatus(a0 context.Context, a1 *binance_connector.AutoInvestPlanEditStatusService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestPlanResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestPlanResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestPlanList provides a mock function
+func (_m *AutoInvestAPI) AutoInvestPlanList(a0 context.Context, a1 *binance_connector.AutoInvestPlanListService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestPlanListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestPlanListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestSourceAssetList provides a mock function
+func (_m *AutoInvestAPI) AutoInvestSourceAssetList(a0 context.Context, a1 *binance_connector.AutoInvestSourceAssetListService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestSourceAssetListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestSourceAssetListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestSubscriptionHistory provides a mock function
+func (_m *AutoInvestAPI) AutoInvestSubscriptionHistory(a0 context.Context, a1 *binance_connector.AutoInvestSubscriptionHistoryService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestSubscriptionHistoryResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestSubscriptionHistoryResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// AutoInvestTargetAssetList provides a mock function
+func (_m *AutoInvestAPI) AutoInvestTargetAssetList(a0 context.Context, a1 *binance_connector.AutoInvestTargetAssetListService, a2 ...binance_connector.RequestOption) (*binance_connector.AutoInvestTargetAssetListResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.AutoInvestTargetAssetListResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginAPI is a mock of binance_connector.PortfolioMarginAPI
+type PortfolioMarginAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.PortfolioMarginAPI = (*PortfolioMarginAPI)(nil)
+
+// NewPortfolioMarginAPI creates a PortfolioMarginAPI that asserts its expectations when the test ends
+func NewPortfolioMarginAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PortfolioMarginAPI {
+	m := &PortfolioMarginAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// PortfolioMarginAccount provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginAccount(a0 context.Context, a1 *binance_connector.PortfolioMarginAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginAccountResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginAccountResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginAssetCollection provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginAssetCollection(a0 context.Context, a1 *binance_connector.PortfolioMarginAssetCollectionService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginMsgResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginMsgResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginAutoCollection provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginAutoCollection(a0 context.Context, a1 *binance_connector.PortfolioMarginAutoCollectionService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginMsgResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginMsgResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginBalance provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginBalance(a0 context.Context, a1 *binance_connector.PortfolioMarginBalanceService, a2 ...binance_connector.RequestOption) ([]*binance_connector.PortfolioMarginBalance, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.PortfolioMarginBalance)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginCMCancelOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginCMCancelOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginCMCancelOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginCMOrder, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginCMOrder)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginCMNewOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginCMNewOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginCMNewOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginCMOrder, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginCMOrder)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginCMOpenOrders provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginCMOpenOrders(a0 context.Context, a1 *binance_connector.PortfolioMarginCMOpenOrdersService, a2 ...binance_connector.RequestOption) ([]*binance_connector.PortfolioMarginCMOrder, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.PortfolioMarginCMOrder)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginCMPositionRisk provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginCMPositionRisk(a0 context.Context, a1 *binance_connector.PortfolioMarginCMPositionRiskService, a2 ...binance_connector.RequestOption) ([]*binance_connector.PortfolioMarginCMPositionRisk, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.PortfolioMarginCMPositionRisk)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginCMQueryOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginCMQueryOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginCMQueryOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginCMOrder, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginCMOrder)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginCloseListenKey provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginCloseListenKey(a0 context.Context, a1 *binance_connector.PortfolioMarginCloseListenKeyService, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// PortfolioMarginCreateListenKey provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginCreateListenKey(a0 context.Context, a1 *binance_connector.PortfolioMarginCreateListenKeyService, a2 ...binance_connector.RequestOption) (string, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginLoan provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginLoan(a0 context.Context, a1 *binance_connector.PortfolioMarginLoanService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginTransactionResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginTransactionResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginMarginCancelOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginMarginCancelOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginMarginCancelOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountCancelOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountCancelOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginMarginNewOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginMarginNewOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginMarginNewOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountNewOrderResponseFULL, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountNewOrderResponseFULL)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginMarginQueryOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginMarginQueryOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginMarginQueryOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.MarginAccountOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.MarginAccountOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginPing provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginPing(a0 context.Context, a1 *binance_connector.PortfolioMarginPingService, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// PortfolioMarginPingListenKey provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginPingListenKey(a0 context.Context, a1 *binance_connector.PortfolioMarginPingListenKeyService, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// PortfolioMarginRepayFuturesNegativeBalance provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginRepayFuturesNegativeBalance(a0 context.Context, a1 *binance_connector.PortfolioMarginRepayFuturesNegativeBalanceService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginMsgResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginMsgResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginRepayLoan provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginRepayLoan(a0 context.Context, a1 *binance_connector.PortfolioMarginRepayLoanService, a2 ...binance_connector.RequestOption) (*binance_connector.PortfolioMarginTransactionResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.PortfolioMarginTransactionResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginUMCancelOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginUMCancelOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginUMCancelOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.Order, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.Order)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginUMNewOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginUMNewOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginUMNewOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.CreateOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.CreateOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginUMOpenOrders provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginUMOpenOrders(a0 context.Context, a1 *binance_connector.PortfolioMarginUMOpenOrdersService, a2 ...binance_connector.RequestOption) ([]*binance_connector.Order, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.Order)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginUMPositionRisk provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginUMPositionRisk(a0 context.Context, a1 *binance_connector.PortfolioMarginUMPositionRiskService, a2 ...binance_connector.RequestOption) ([]*binance_connector.PositionRisk, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.PositionRisk)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PortfolioMarginUMQueryOrder provides a mock function
+func (_m *PortfolioMarginAPI) PortfolioMarginUMQueryOrder(a0 context.Context, a1 *binance_connector.PortfolioMarginUMQueryOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.Order, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.Order)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsAPI is a mock of binance_connector.OptionsAPI
+type OptionsAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.OptionsAPI = (*OptionsAPI)(nil)
+
+// NewOptionsAPI creates a OptionsAPI that asserts its expectations when the test ends
+func NewOptionsAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OptionsAPI {
+	m := &OptionsAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// OptionsAccount provides a mock function
+func (_m *OptionsAPI) OptionsAccount(a0 context.Context, a1 *binance_connector.OptionsAccountService, a2 ...binance_connector.RequestOption) (*binance_connector.OptionsAccountResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OptionsAccountResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsBatchCancelOrders provides a mock function
+func (_m *OptionsAPI) OptionsBatchCancelOrders(a0 context.Context, a1 *binance_connector.OptionsBatchCancelOrdersService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OptionsBatchOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OptionsBatchOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsBatchOrders provides a mock function
+func (_m *OptionsAPI) OptionsBatchOrders(a0 context.Context, a1 *binance_connector.OptionsBatchOrdersService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OptionsBatchOrderResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OptionsBatchOrderResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsCancelOrder provides a mock function
+func (_m *OptionsAPI) OptionsCancelOrder(a0 context.Context, a1 *binance_connector.OptionsCancelOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.OptionsOrder, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OptionsOrder)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsCloseListenKey provides a mock function
+func (_m *OptionsAPI) OptionsCloseListenKey(a0 context.Context, a1 *binance_connector.OptionsCloseListenKeyService, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// OptionsCreateListenKey provides a mock function
+func (_m *OptionsAPI) OptionsCreateListenKey(a0 context.Context, a1 *binance_connector.OptionsCreateListenKeyService, a2 ...binance_connector.RequestOption) (string, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(string)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsDepth provides a mock function
+func (_m *OptionsAPI) OptionsDepth(a0 context.Context, a1 *binance_connector.OptionsDepthService, a2 ...binance_connector.RequestOption) (*binance_connector.OptionsDepthResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OptionsDepthResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsExchangeInfo provides a mock function
+func (_m *OptionsAPI) OptionsExchangeInfo(a0 context.Context, a1 *binance_connector.OptionsExchangeInfoService, a2 ...binance_connector.RequestOption) (*binance_connector.OptionsExchangeInfoResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OptionsExchangeInfoResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsExerciseHistory provides a mock function
+func (_m *OptionsAPI) OptionsExerciseHistory(a0 context.Context, a1 *binance_connector.OptionsExerciseHistoryService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OptionsExerciseHistory, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OptionsExerciseHistory)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsIndexPrice provides a mock function
+func (_m *OptionsAPI) OptionsIndexPrice(a0 context.Context, a1 *binance_connector.OptionsIndexPriceService, a2 ...binance_connector.RequestOption) (*binance_connector.OptionsIndexPriceResponse, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OptionsIndexPriceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsKlines provides a mock function
+func (_m *OptionsAPI) OptionsKlines(a0 context.Context, a1 *binance_connector.OptionsKlinesService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OptionsKline, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OptionsKline)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsMarkPrice provides a mock function
+func (_m *OptionsAPI) OptionsMarkPrice(a0 context.Context, a1 *binance_connector.OptionsMarkPriceService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OptionsMarkPrice, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OptionsMarkPrice)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsNewOrder provides a mock function
+func (_m *OptionsAPI) OptionsNewOrder(a0 context.Context, a1 *binance_connector.OptionsNewOrderService, a2 ...binance_connector.RequestOption) (*binance_connector.OptionsOrder, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(*binance_connector.OptionsOrder)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsPing provides a mock function
+func (_m *OptionsAPI) OptionsPing(a0 context.Context, a1 *binance_connector.OptionsPingService, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// OptionsPingListenKey provides a mock function
+func (_m *OptionsAPI) OptionsPingListenKey(a0 context.Context, a1 *binance_connector.OptionsPingListenKeyService, a2 ...binance_connector.RequestOption) error {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).(error)
+	return r0
+}
+
+// OptionsPosition provides a mock function
+func (_m *OptionsAPI) OptionsPosition(a0 context.Context, a1 *binance_connector.OptionsPositionService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OptionsPosition, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OptionsPosition)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// OptionsUserExerciseRecord provides a mock function
+func (_m *OptionsAPI) OptionsUserExerciseRecord(a0 context.Context, a1 *binance_connector.OptionsUserExerciseRecordService, a2 ...binance_connector.RequestOption) ([]*binance_connector.OptionsUserExerciseRecord, error) {
+	args := []interface{}{a0, a1}
+	for _, a := range a2 {
+		args = append(args, a)
+	}
+	ret := _m.Called(args...)
+	r0, _ := ret.Get(0).([]*binance_connector.OptionsUserExerciseRecord)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// WebsocketTradingAPI is a mock of binance_connector.WebsocketTradingAPI
+type WebsocketTradingAPI struct {
+	mock.Mock
+}
+
+var _ binance_connector.WebsocketTradingAPI = (*WebsocketTradingAPI)(nil)
+
+// NewWebsocketTradingAPI creates a WebsocketTradingAPI that asserts its expectations when the test ends
+func NewWebsocketTradingAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebsocketTradingAPI {
+	m := &WebsocketTradingAPI{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AmendOrderKeepPriority provides a mock function
+func (_m *WebsocketTradingAPI) AmendOrderKeepPriority(a0 context.Context, a1 *binance_connector.OrderAmendKeepPriorityService) (*binance_connector.OrderAmendKeepPriorityResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderAmendKeepPriorityResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelOCO provides a mock function
+func (_m *WebsocketTradingAPI) CancelOCO(a0 context.Context, a1 *binance_connector.OrderListCancelService) (*binance_connector.OrderListCancelResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListCancelResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelOpenOrders provides a mock function
+func (_m *WebsocketTradingAPI) CancelOpenOrders(a0 context.Context, a1 *binance_connector.OpenOrdersCancelAllService) (*binance_connector.OpenOrdersCancelAllResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OpenOrdersCancelAllResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelOrder provides a mock function
+func (_m *WebsocketTradingAPI) CancelOrder(a0 context.Context, a1 *binance_connector.OrderCancelService) (*binance_connector.OrderCancelResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderCancelResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CancelReplaceOrder provides a mock function
+func (_m *WebsocketTradingAPI) CancelReplaceOrder(a0 context.Context, a1 *binance_connector.OrderCancelReplaceService) (*binance_connector.OrderCancelReplaceResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderCancelReplaceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CurrentOpenOCO provides a mock function
+func (_m *WebsocketTradingAPI) CurrentOpenOCO(a0 context.Context, a1 *binance_connector.OpenOrderListsStatusService) (*binance_connector.OpenOrderListsStatusResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OpenOrderListsStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// CurrentOpenOrders provides a mock function
+func (_m *WebsocketTradingAPI) CurrentOpenOrders(a0 context.Context, a1 *binance_connector.OpenOrdersStatusService) (*binance_connector.OpenOrdersStatusResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OpenOrdersStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PlaceNewOrder provides a mock function
+func (_m *WebsocketTradingAPI) PlaceNewOrder(a0 context.Context, a1 *binance_connector.OrderPlacementService) (*binance_connector.OrderPlacementResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderPlacementResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PlaceOCO provides a mock function
+func (_m *WebsocketTradingAPI) PlaceOCO(a0 context.Context, a1 *binance_connector.OrderListPlaceService) (*binance_connector.OrderListPlaceResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListPlaceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PlaceOrderListOCO provides a mock function
+func (_m *WebsocketTradingAPI) PlaceOrderListOCO(a0 context.Context, a1 *binance_connector.OrderListPlaceOCOService) (*binance_connector.OrderListPlaceResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListPlaceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PlaceOrderListOTO provides a mock function
+func (_m *WebsocketTradingAPI) PlaceOrderListOTO(a0 context.Context, a1 *binance_connector.OrderListPlaceOTOService) (*binance_connector.OrderListPlaceResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListPlaceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PlaceOrderListOTOCO provides a mock function
+func (_m *WebsocketTradingAPI) PlaceOrderListOTOCO(a0 context.Context, a1 *binance_connector.OrderListPlaceOTOCOService) (*binance_connector.OrderListPlaceResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListPlaceResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// PlaceSOROrder provides a mock function
+func (_m *WebsocketTradingAPI) PlaceSOROrder(a0 context.Context, a1 *binance_connector.SOROrderPlacementService) (*binance_connector.SOROrderPlacementResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.SOROrderPlacementResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryOCO provides a mock function
+func (_m *WebsocketTradingAPI) QueryOCO(a0 context.Context, a1 *binance_connector.OrderListStatusService) (*binance_connector.OrderListStatusResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderListStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// QueryOrder provides a mock function
+func (_m *WebsocketTradingAPI) QueryOrder(a0 context.Context, a1 *binance_connector.OrderStatusService) (*binance_connector.OrderStatusResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderStatusResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TestPlaceOrder provides a mock function
+func (_m *WebsocketTradingAPI) TestPlaceOrder(a0 context.Context, a1 *binance_connector.TestOrderPlacementService) (*binance_connector.OrderPlacementResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.OrderPlacementResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
+
+// TestPlaceSOROrder provides a mock function
+func (_m *WebsocketTradingAPI) TestPlaceSOROrder(a0 context.Context, a1 *binance_connector.TestSOROrderPlacementService) (*binance_connector.TestSOROrderPlacementResponse, error) {
+	ret := _m.Called(a0, a1)
+	r0, _ := ret.Get(0).(*binance_connector.TestSOROrderPlacementResponse)
+	r1, _ := ret.Get(1).(error)
+	return r0, r1
+}
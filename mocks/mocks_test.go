@@ -0,0 +1,63 @@
+package mocks_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	binance_connector "github.com/binance/binance-connector-go"
+	"github.com/binance/binance-connector-go/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type mocksTestSuite struct {
+	suite.Suite
+}
+
+func TestMocks(t *testing.T) {
+	suite.Run(t, new(mocksTestSuite))
+}
+
+// usdtBalance stands for downstream code that only needs spot trading
+func usdtBalance(ctx context.Context, spot binance_connector.SpotTradingAPI) (string, error) {
+	account, err := spot.GetAccount(ctx, new(binance_connector.GetAccountService))
+	if err != nil {
+		return "", err
+	}
+	for _, b := range account.Balances {
+		if b.Asset == "USDT" {
+			return b.Free, nil
+		}
+	}
+	return "0", nil
+}
+
+func (s *mocksTestSuite) TestSpotTradingAPI() {
+	r := s.Require()
+	spot := mocks.NewSpotTradingAPI(s.T())
+	account := &binance_connector.AccountResponse{Balances: []binance_connector.Balance{{Asset: "USDT", Free: "12.5", Locked: "0"}}}
+	spot.On("GetAccount", mock.Anything, new(binance_connector.GetAccountService)).
+		Return(account, nil).Once()
+
+	free, err := usdtBalance(context.Background(), spot)
+	r.NoError(err)
+	r.Equal("12.5", free)
+}
+
+func (s *mocksTestSuite) TestClient() {
+	r := s.Require()
+	transport := mocks.NewTransport(s.T())
+	transport.OnRequest(http.MethodGet, "/api/v3/account").
+		Return(http.StatusOK, `{"balances":[{"asset":"USDT","free":"12.5","locked":"0"}]}`).Once()
+	transport.OnRequest(http.MethodGet, "/api/v3/account").
+		Return(http.StatusBadRequest, `{"code":-2015,"msg":"Invalid API-key, IP, or permissions for action."}`).Once()
+	client := binance_connector.NewClient("key", "secret", "https://api.binance.com")
+	client.HTTPClient = &http.Client{Transport: transport}
+
+	free, err := usdtBalance(context.Background(), client)
+	r.NoError(err)
+	r.Equal("12.5", free)
+	_, err = usdtBalance(context.Background(), client)
+	r.Error(err)
+}
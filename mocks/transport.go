@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Transport is a mock http.RoundTripper. Set it as the transport of a Client
+// so that its services answer from expectations instead of the network:
+//
+//	transport := mocks.NewTransport(t)
+//	transport.OnRequest(http.MethodPost, "/api/v3/order").Return(http.StatusOK, `{"orderId":1}`)
+//	client := binance_connector.NewClient("key", "secret")
+//	client.HTTPClient = &http.Client{Transport: transport}
+type Transport struct {
+	mock.Mock
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+// NewTransport creates a Transport that asserts its expectations when the test ends
+func NewTransport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Transport {
+	m := &Transport{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// OnRequest expects a request by method and URL path. Complete the call with
+// Return(statusCode int, body string).
+func (m *Transport) OnRequest(method, path string) *mock.Call {
+	return m.On("RoundTrip", method, path)
+}
+
+// RoundTrip answers req with the status code and body of the matching expectation
+func (m *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ret := m.Called(req.Method, req.URL.Path)
+	return &http.Response{
+		StatusCode: ret.Int(0),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewBufferString(ret.String(1))),
+		Request:    req,
+	}, nil
+}
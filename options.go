@@ -0,0 +1,525 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+)
+
+// OptionSide define the side of an option contract
+type OptionSide string
+
+// Global enums
+const (
+	OptionSideCall OptionSide = "CALL"
+	OptionSidePut  OptionSide = "PUT"
+)
+
+// OptionGreeks define the Greeks of an option symbol or of the positions on an underlying
+type OptionGreeks struct {
+	Delta string `json:"delta"`
+	Theta string `json:"theta"`
+	Gamma string `json:"gamma"`
+	Vega  string `json:"vega"`
+}
+
+// OptionsClient define European Options API client. Requests are signed and
+// sent by Client, so Debug, TimeOffset and HTTPClient are set on it.
+type OptionsClient struct {
+	Client *Client
+}
+
+// NewOptionsClient create an options client. The baseURL is optional and
+// defaults to "https://eapi.binance.com".
+func NewOptionsClient(apiKey string, secretKey string, baseURL ...string) *OptionsClient {
+	url := "https://eapi.binance.com"
+
+	if len(baseURL) > 0 {
+		url = baseURL[0]
+	}
+
+	return &OptionsClient{
+		Client: NewClient(apiKey, secretKey, url),
+	}
+}
+
+func (o *OptionsClient) NewOptionsPingService() *OptionsPingService {
+	return &OptionsPingService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsExchangeInfoService() *OptionsExchangeInfoService {
+	return &OptionsExchangeInfoService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsIndexPriceService() *OptionsIndexPriceService {
+	return &OptionsIndexPriceService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsMarkPriceService() *OptionsMarkPriceService {
+	return &OptionsMarkPriceService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsDepthService() *OptionsDepthService {
+	return &OptionsDepthService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsKlinesService() *OptionsKlinesService {
+	return &OptionsKlinesService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsExerciseHistoryService() *OptionsExerciseHistoryService {
+	return &OptionsExerciseHistoryService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsNewOrderService() *OptionsNewOrderService {
+	return &OptionsNewOrderService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsBatchOrdersService() *OptionsBatchOrdersService {
+	return &OptionsBatchOrdersService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsCancelOrderService() *OptionsCancelOrderService {
+	return &OptionsCancelOrderService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsBatchCancelOrdersService() *OptionsBatchCancelOrdersService {
+	return &OptionsBatchCancelOrdersService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsPositionService() *OptionsPositionService {
+	return &OptionsPositionService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsAccountService() *OptionsAccountService {
+	return &OptionsAccountService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsUserExerciseRecordService() *OptionsUserExerciseRecordService {
+	return &OptionsUserExerciseRecordService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsCreateListenKeyService() *OptionsCreateListenKeyService {
+	return &OptionsCreateListenKeyService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsPingListenKeyService() *OptionsPingListenKeyService {
+	return &OptionsPingListenKeyService{c: o.Client}
+}
+
+func (o *OptionsClient) NewOptionsCloseListenKeyService() *OptionsCloseListenKeyService {
+	return &OptionsCloseListenKeyService{c: o.Client}
+}
+
+// Test Connectivity API Endpoint
+const (
+	optionsPingEndpoint = "/eapi/v1/ping"
+)
+
+// OptionsPingService test connectivity to the Options API
+type OptionsPingService struct {
+	c *Client
+}
+
+// Do send request
+func (s *OptionsPingService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsPingEndpoint,
+		secType:  secTypeNone,
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// Exchange Information API Endpoint
+const (
+	optionsExchangeInfoEndpoint = "/eapi/v1/exchangeInfo"
+)
+
+// OptionsExchangeInfoService query the option contracts, assets and symbols
+type OptionsExchangeInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *OptionsExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (res *OptionsExchangeInfoResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsExchangeInfoEndpoint,
+		secType:  secTypeNone,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OptionsExchangeInfoResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OptionsExchangeInfoResponse define options exchange info response
+type OptionsExchangeInfoResponse struct {
+	Timezone        string `json:"timezone"`
+	ServerTime      int64  `json:"serverTime"`
+	OptionContracts []struct {
+		Id          int64  `json:"id"`
+		BaseAsset   string `json:"baseAsset"`
+		QuoteAsset  string `json:"quoteAsset"`
+		Underlying  string `json:"underlying"`
+		SettleAsset string `json:"settleAsset"`
+	} `json:"optionContracts"`
+	OptionAssets []struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"optionAssets"`
+	OptionSymbols []*OptionsSymbol `json:"optionSymbols"`
+	RateLimits    []*RateLimit     `json:"rateLimits"`
+}
+
+// OptionsSymbol define an option symbol
+type OptionsSymbol struct {
+	ContractId           int64                    `json:"contractId"`
+	ExpiryDate           int64                    `json:"expiryDate"`
+	Filters              []map[string]interface{} `json:"filters"`
+	Id                   int64                    `json:"id"`
+	Symbol               string                   `json:"symbol"`
+	Side                 OptionSide               `json:"side"`
+	StrikePrice          string                   `json:"strikePrice"`
+	Underlying           string                   `json:"underlying"`
+	Unit                 int64                    `json:"unit"`
+	MakerFeeRate         string                   `json:"makerFeeRate"`
+	TakerFeeRate         string                   `json:"takerFeeRate"`
+	MinQty               string                   `json:"minQty"`
+	MaxQty               string                   `json:"maxQty"`
+	InitialMargin        string                   `json:"initialMargin"`
+	MaintenanceMargin    string                   `json:"maintenanceMargin"`
+	MinInitialMargin     string                   `json:"minInitialMargin"`
+	MinMaintenanceMargin string                   `json:"minMaintenanceMargin"`
+	PriceScale           int                      `json:"priceScale"`
+	QuantityScale        int                      `json:"quantityScale"`
+	QuoteAsset           string                   `json:"quoteAsset"`
+}
+
+// Symbol Price Ticker API Endpoint
+const (
+	optionsIndexPriceEndpoint = "/eapi/v1/index"
+)
+
+// OptionsIndexPriceService query the spot index price of an underlying
+type OptionsIndexPriceService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying
+func (s *OptionsIndexPriceService) Underlying(underlying string) *OptionsIndexPriceService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *OptionsIndexPriceService) Do(ctx context.Context, opts ...RequestOption) (res *OptionsIndexPriceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsIndexPriceEndpoint,
+		secType:  secTypeNone,
+	}
+	r.setParam("underlying", s.underlying)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OptionsIndexPriceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OptionsIndexPriceResponse define index price response
+type OptionsIndexPriceResponse struct {
+	Time       int64  `json:"time"`
+	IndexPrice string `json:"indexPrice"`
+}
+
+// Option Mark Price API Endpoint
+const (
+	optionsMarkPriceEndpoint = "/eapi/v1/mark"
+)
+
+// OptionsMarkPriceService query the mark price, implied volatility and Greeks of option symbols
+type OptionsMarkPriceService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *OptionsMarkPriceService) Symbol(symbol string) *OptionsMarkPriceService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *OptionsMarkPriceService) Do(ctx context.Context, opts ...RequestOption) (res []*OptionsMarkPrice, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsMarkPriceEndpoint,
+		secType:  secTypeNone,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OptionsMarkPrice{}, err
+	}
+	res = make([]*OptionsMarkPrice, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OptionsMarkPrice{}, err
+	}
+	return res, nil
+}
+
+// OptionsMarkPrice define the mark price of an option symbol
+type OptionsMarkPrice struct {
+	Symbol    string `json:"symbol"`
+	MarkPrice string `json:"markPrice"`
+	BidIV     string `json:"bidIV"`
+	AskIV     string `json:"askIV"`
+	MarkIV    string `json:"markIV"`
+	OptionGreeks
+	HighPriceLimit   string `json:"highPriceLimit"`
+	LowPriceLimit    string `json:"lowPriceLimit"`
+	RiskFreeInterest string `json:"riskFreeInterest"`
+}
+
+// Order Book API Endpoint
+const (
+	optionsDepthEndpoint = "/eapi/v1/depth"
+)
+
+// OptionsDepthService query the order book of an option symbol
+type OptionsDepthService struct {
+	c      *Client
+	symbol string
+	limit  *int
+}
+
+// Symbol set symbol
+func (s *OptionsDepthService) Symbol(symbol string) *OptionsDepthService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *OptionsDepthService) Limit(limit int) *OptionsDepthService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *OptionsDepthService) Do(ctx context.Context, opts ...RequestOption) (res *OptionsDepthResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsDepthEndpoint,
+		secType:  secTypeNone,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OptionsDepthResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OptionsDepthResponse define options order book response
+type OptionsDepthResponse struct {
+	TransactionTime int64          `json:"T"`
+	UpdateId        int64          `json:"u"`
+	Bids            [][]*big.Float `json:"bids"`
+	Asks            [][]*big.Float `json:"asks"`
+}
+
+// Kline/Candlestick Data API Endpoint
+const (
+	optionsKlinesEndpoint = "/eapi/v1/klines"
+)
+
+// OptionsKlinesService query the klines of an option symbol
+type OptionsKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	startTime *uint64
+	endTime   *uint64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *OptionsKlinesService) Symbol(symbol string) *OptionsKlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *OptionsKlinesService) Interval(interval string) *OptionsKlinesService {
+	s.interval = interval
+	return s
+}
+
+// StartTime set startTime
+func (s *OptionsKlinesService) StartTime(startTime uint64) *OptionsKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *OptionsKlinesService) EndTime(endTime uint64) *OptionsKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *OptionsKlinesService) Limit(limit int) *OptionsKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *OptionsKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*OptionsKline, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsKlinesEndpoint,
+		secType:  secTypeNone,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("interval", s.interval)
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OptionsKline{}, err
+	}
+	res = make([]*OptionsKline, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OptionsKline{}, err
+	}
+	return res, nil
+}
+
+// OptionsKline define an options kline
+type OptionsKline struct {
+	Open        string `json:"open"`
+	High        string `json:"high"`
+	Low         string `json:"low"`
+	Close       string `json:"close"`
+	Volume      string `json:"volume"`
+	Amount      string `json:"amount"`
+	Interval    string `json:"interval"`
+	TradeCount  int64  `json:"tradeCount"`
+	TakerVolume string `json:"takerVolume"`
+	TakerAmount string `json:"takerAmount"`
+	OpenTime    int64  `json:"openTime"`
+	CloseTime   int64  `json:"closeTime"`
+}
+
+// Historical Exercise Records API Endpoint
+const (
+	optionsExerciseHistoryEndpoint = "/eapi/v1/exerciseHistory"
+)
+
+// OptionsExerciseHistoryService query the exercise prices of expired option symbols
+type OptionsExerciseHistoryService struct {
+	c          *Client
+	underlying *string
+	startTime  *uint64
+	endTime    *uint64
+	limit      *int
+}
+
+// Underlying set underlying
+func (s *OptionsExerciseHistoryService) Underlying(underlying string) *OptionsExerciseHistoryService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *OptionsExerciseHistoryService) StartTime(startTime uint64) *OptionsExerciseHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *OptionsExerciseHistoryService) EndTime(endTime uint64) *OptionsExerciseHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *OptionsExerciseHistoryService) Limit(limit int) *OptionsExerciseHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *OptionsExerciseHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*OptionsExerciseHistory, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsExerciseHistoryEndpoint,
+		secType:  secTypeNone,
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OptionsExerciseHistory{}, err
+	}
+	res = make([]*OptionsExerciseHistory, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OptionsExerciseHistory{}, err
+	}
+	return res, nil
+}
+
+// OptionsExerciseHistory define the exercise record of an expired option symbol
+type OptionsExerciseHistory struct {
+	Symbol          string `json:"symbol"`
+	StrikePrice     string `json:"strikePrice"`
+	RealStrikePrice string `json:"realStrikePrice"`
+	ExpiryDate      int64  `json:"expiryDate"`
+	StrikeResult    string `json:"strikeResult"`
+}
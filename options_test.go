@@ -0,0 +1,309 @@
+package binance_connector
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type optionsTestSuite struct {
+	baseTestSuite
+	options *OptionsClient
+}
+
+func TestOptions(t *testing.T) {
+	suite.Run(t, new(optionsTestSuite))
+}
+
+func (s *optionsTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.options = &OptionsClient{Client: s.client.Client}
+}
+
+func (s *optionsTestSuite) assertEndpoint(method, path string) {
+	calls := s.client.Calls
+	s.r().NotEmpty(calls)
+	req := calls[len(calls)-1].Arguments.Get(0).(*http.Request)
+	s.Equal(method, req.Method)
+	s.Equal(path, req.URL.Path)
+}
+
+func (s *optionsTestSuite) TestNewOptionsClient() {
+	s.Equal("https://eapi.binance.com", NewOptionsClient("key", "secret").Client.BaseURL)
+}
+
+func (s *optionsTestSuite) TestMarkPrice() {
+	data := []byte(`[
+		{
+			"symbol": "BTC-200730-9000-C",
+			"markPrice": "1343.2883",
+			"bidIV": "1.40000077",
+			"askIV": "1.50000153",
+			"markIV": "1.45000000",
+			"delta": "0.55937056",
+			"theta": "3739.82509871",
+			"gamma": "0.00010969",
+			"vega": "978.58874732",
+			"highPriceLimit": "1618.241",
+			"lowPriceLimit": "1068.3356",
+			"riskFreeInterest": "0.1"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "BTC-200730-9000-C",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.options.NewOptionsMarkPriceService().Symbol("BTC-200730-9000-C").Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodGet, "/eapi/v1/mark")
+	s.Len(resp, 1)
+	s.Equal("1.45000000", resp[0].MarkIV)
+	s.Equal("0.55937056", resp[0].Delta)
+	s.Equal("978.58874732", resp[0].Vega)
+}
+
+func (s *optionsTestSuite) TestExchangeInfo() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1592387337630,
+		"optionContracts": [
+			{"id": 1, "baseAsset": "BTC", "quoteAsset": "USDT", "underlying": "BTCUSDT", "settleAsset": "USDT"}
+		],
+		"optionAssets": [
+			{"id": 1, "name": "USDT"}
+		],
+		"optionSymbols": [
+			{
+				"contractId": 2,
+				"expiryDate": 1660521600000,
+				"filters": [
+					{"filterType": "PRICE_FILTER", "minPrice": "0.02", "maxPrice": "80000.01", "tickSize": "0.01"}
+				],
+				"id": 17,
+				"symbol": "BTC-220815-50000-C",
+				"side": "CALL",
+				"strikePrice": "50000",
+				"underlying": "BTCUSDT",
+				"unit": 1,
+				"makerFeeRate": "0.0002",
+				"takerFeeRate": "0.0002",
+				"minQty": "0.01",
+				"maxQty": "100",
+				"initialMargin": "0.15",
+				"maintenanceMargin": "0.075",
+				"minInitialMargin": "0.1",
+				"minMaintenanceMargin": "0.05",
+				"priceScale": 2,
+				"quantityScale": 2,
+				"quoteAsset": "USDT"
+			}
+		],
+		"rateLimits": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	resp, err := s.options.NewOptionsExchangeInfoService().Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp.OptionSymbols, 1)
+	symbol := resp.OptionSymbols[0]
+	s.Equal(OptionSideCall, symbol.Side)
+	s.Equal("50000", symbol.StrikePrice)
+	s.Equal(int64(1660521600000), symbol.ExpiryDate)
+	s.Equal("PRICE_FILTER", symbol.Filters[0]["filterType"])
+}
+
+func (s *optionsTestSuite) TestNewOrder() {
+	data := []byte(`{
+		"orderId": 4611875134427365377,
+		"symbol": "BTC-200730-9000-C",
+		"price": "100",
+		"quantity": "1",
+		"side": "BUY",
+		"type": "LIMIT",
+		"createDate": 1592465880683,
+		"reduceOnly": false,
+		"postOnly": false,
+		"mmp": false,
+		"status": "ACCEPTED",
+		"clientOrderId": "hedge-1",
+		"optionSide": "CALL",
+		"quoteAsset": "USDT"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":        "BTC-200730-9000-C",
+			"side":          "BUY",
+			"type":          "LIMIT",
+			"quantity":      "1",
+			"price":         "100",
+			"timeInForce":   "GTC",
+			"clientOrderId": "hedge-1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.options.NewOptionsNewOrderService().Symbol("BTC-200730-9000-C").Side(SideTypeBuy).
+		Type(OrderTypeLimit).Quantity(1).Price(100).TimeInForce(TimeInForceTypeGTC).ClientOrderId("hedge-1").
+		Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodPost, "/eapi/v1/order")
+	s.Equal(int64(4611875134427365377), resp.OrderId)
+	s.Equal(OptionSideCall, resp.OptionSide)
+	s.Equal("ACCEPTED", resp.Status)
+}
+
+func (s *optionsTestSuite) TestBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 4612288550799409153,
+			"symbol": "ETH-220826-1800-C",
+			"price": "100",
+			"quantity": "0.01",
+			"side": "BUY",
+			"type": "LIMIT",
+			"status": "ACCEPTED",
+			"optionSide": "CALL"
+		},
+		{
+			"code": -4001,
+			"msg": "Price less than 0"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"orders": `[{"symbol":"ETH-220826-1800-C","side":"BUY","type":"LIMIT","quantity":"0.01","price":"100","timeInForce":"GTC"},` +
+				`{"symbol":"ETH-220826-1800-P","side":"SELL","type":"LIMIT","quantity":"0.01","price":"-1","timeInForce":"GTC","reduceOnly":true}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.options.NewOptionsBatchOrdersService().Orders(
+		OptionsOrderRequest{Symbol: "ETH-220826-1800-C", Side: SideTypeBuy, Type: OrderTypeLimit, Quantity: 0.01, Price: 100, TimeInForce: TimeInForceTypeGTC},
+		OptionsOrderRequest{Symbol: "ETH-220826-1800-P", Side: SideTypeSell, Type: OrderTypeLimit, Quantity: 0.01, Price: -1, TimeInForce: TimeInForceTypeGTC, ReduceOnly: true},
+	).Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodPost, "/eapi/v1/batchOrders")
+	s.Len(resp, 2)
+	s.Equal(int64(4612288550799409153), resp[0].OrderId)
+	s.Equal(int64(-4001), resp[1].Code)
+}
+
+func (s *optionsTestSuite) TestOptionsOrderRequestDecimals() {
+	data, err := json.Marshal(OptionsOrderRequest{Symbol: "BTC-240628-100000-C", Side: SideTypeBuy, Type: OrderTypeLimit,
+		Quantity: 0.00001, Price: 12500000, TimeInForce: TimeInForceTypeGTC})
+	s.r().NoError(err)
+	s.Equal(`{"symbol":"BTC-240628-100000-C","side":"BUY","type":"LIMIT","quantity":"0.00001","price":"12500000","timeInForce":"GTC"}`, string(data))
+}
+
+func (s *optionsTestSuite) TestBatchCancelOrders() {
+	data := []byte(`[
+		{
+			"orderId": 4611875134427365377,
+			"symbol": "BTC-200730-9000-C",
+			"status": "CANCELLED"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":   "BTC-200730-9000-C",
+			"orderIds": "[4611875134427365377]",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.options.NewOptionsBatchCancelOrdersService().Symbol("BTC-200730-9000-C").
+		OrderIds(4611875134427365377).Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodDelete, "/eapi/v1/batchOrders")
+	s.Equal("CANCELLED", resp[0].Status)
+}
+
+func (s *optionsTestSuite) TestAccount() {
+	data := []byte(`{
+		"asset": [
+			{
+				"asset": "USDT",
+				"marginBalance": "1877.52214415",
+				"equity": "617.77711415",
+				"available": "0",
+				"locked": "2898.92389933",
+				"unrealizedPNL": "222.23697000"
+			}
+		],
+		"greek": [
+			{
+				"underlying": "BTCUSDT",
+				"delta": "-0.05",
+				"gamma": "-0.002",
+				"theta": "-0.05",
+				"vega": "-0.002"
+			}
+		],
+		"time": 1592449455993,
+		"riskLevel": "NORMAL"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+
+	resp, err := s.options.NewOptionsAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodGet, "/eapi/v1/account")
+	s.Equal("1877.52214415", resp.Asset[0].MarginBalance)
+	s.Equal("BTCUSDT", resp.Greek[0].Underlying)
+	s.Equal("-0.002", resp.Greek[0].Gamma)
+	s.Equal("NORMAL", resp.RiskLevel)
+}
+
+func (s *optionsTestSuite) TestPosition() {
+	data := []byte(`[
+		{
+			"entryPrice": "1000",
+			"symbol": "BTC-200730-9000-C",
+			"side": "SHORT",
+			"quantity": "-0.1",
+			"reducibleQty": "0",
+			"markValue": "105.00138",
+			"ror": "-0.05",
+			"unrealizedPNL": "-5.00138",
+			"markPrice": "1050.0138",
+			"strikePrice": "9000",
+			"positionCost": "1000.0000",
+			"expiryDate": 1593511200000,
+			"priceScale": 2,
+			"quantityScale": 2,
+			"optionSide": "CALL",
+			"quoteAsset": "USDT"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	resp, err := s.options.NewOptionsPositionService().Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp, 1)
+	s.Equal("9000", resp[0].StrikePrice)
+	s.Equal(int64(1593511200000), resp[0].ExpiryDate)
+	s.Equal(OptionSideCall, resp[0].OptionSide)
+}
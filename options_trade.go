@@ -0,0 +1,628 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// New Order (TRADE) API Endpoint
+const (
+	optionsNewOrderEndpoint = "/eapi/v1/order"
+)
+
+// OptionsNewOrderService place an option order
+type OptionsNewOrderService struct {
+	c                *Client
+	symbol           string
+	side             SideType
+	orderType        OrderType
+	quantity         float64
+	price            *float64
+	timeInForce      *TimeInForceType
+	reduceOnly       *bool
+	postOnly         *bool
+	newOrderRespType *NewOrderRespType
+	clientOrderId    *string
+	isMmp            *bool
+}
+
+// Symbol set symbol
+func (s *OptionsNewOrderService) Symbol(symbol string) *OptionsNewOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *OptionsNewOrderService) Side(side SideType) *OptionsNewOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *OptionsNewOrderService) Type(orderType OrderType) *OptionsNewOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Quantity set quantity
+func (s *OptionsNewOrderService) Quantity(quantity float64) *OptionsNewOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *OptionsNewOrderService) Price(price float64) *OptionsNewOrderService {
+	s.price = &price
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *OptionsNewOrderService) TimeInForce(timeInForce TimeInForceType) *OptionsNewOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *OptionsNewOrderService) ReduceOnly(reduceOnly bool) *OptionsNewOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// PostOnly set postOnly
+func (s *OptionsNewOrderService) PostOnly(postOnly bool) *OptionsNewOrderService {
+	s.postOnly = &postOnly
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *OptionsNewOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *OptionsNewOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// ClientOrderId set clientOrderId
+func (s *OptionsNewOrderService) ClientOrderId(clientOrderId string) *OptionsNewOrderService {
+	s.clientOrderId = &clientOrderId
+	return s
+}
+
+// IsMmp set isMmp, whether the order is protected by market maker protection
+func (s *OptionsNewOrderService) IsMmp(isMmp bool) *OptionsNewOrderService {
+	s.isMmp = &isMmp
+	return s
+}
+
+// Do send request
+func (s *OptionsNewOrderService) Do(ctx context.Context, opts ...RequestOption) (res *OptionsOrder, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: optionsNewOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("side", s.side)
+	r.setParam("type", s.orderType)
+	r.setParam("quantity", strconv.FormatFloat(s.quantity, 'f', -1, 64))
+	if s.price != nil {
+		r.setParam("price", strconv.FormatFloat(*s.price, 'f', -1, 64))
+	}
+	if s.timeInForce != nil {
+		r.setParam("timeInForce", *s.timeInForce)
+	}
+	if s.reduceOnly != nil {
+		r.setParam("reduceOnly", *s.reduceOnly)
+	}
+	if s.postOnly != nil {
+		r.setParam("postOnly", *s.postOnly)
+	}
+	if s.newOrderRespType != nil {
+		r.setParam("newOrderRespType", *s.newOrderRespType)
+	}
+	if s.clientOrderId != nil {
+		r.setParam("clientOrderId", *s.clientOrderId)
+	}
+	if s.isMmp != nil {
+		r.setParam("isMmp", *s.isMmp)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OptionsOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OptionsOrder define an option order
+type OptionsOrder struct {
+	OrderId       int64           `json:"orderId"`
+	Symbol        string          `json:"symbol"`
+	Price         string          `json:"price"`
+	Quantity      string          `json:"quantity"`
+	ExecutedQty   string          `json:"executedQty"`
+	Fee           string          `json:"fee"`
+	Side          SideType        `json:"side"`
+	Type          OrderType       `json:"type"`
+	TimeInForce   TimeInForceType `json:"timeInForce"`
+	ReduceOnly    bool            `json:"reduceOnly"`
+	PostOnly      bool            `json:"postOnly"`
+	CreateTime    int64           `json:"createTime"`
+	UpdateTime    int64           `json:"updateTime"`
+	Status        string          `json:"status"`
+	AvgPrice      string          `json:"avgPrice"`
+	Source        string          `json:"source"`
+	ClientOrderId string          `json:"clientOrderId"`
+	PriceScale    int             `json:"priceScale"`
+	QuantityScale int             `json:"quantityScale"`
+	OptionSide    OptionSide      `json:"optionSide"`
+	QuoteAsset    string          `json:"quoteAsset"`
+	Mmp           bool            `json:"mmp"`
+}
+
+// Place Multiple Orders (TRADE) API Endpoint
+const (
+	optionsBatchOrdersEndpoint = "/eapi/v1/batchOrders"
+)
+
+// OptionsOrderRequest define an order of OptionsBatchOrdersService
+type OptionsOrderRequest struct {
+	Symbol           string           `json:"symbol"`
+	Side             SideType         `json:"side"`
+	Type             OrderType        `json:"type"`
+	Quantity         float64          `json:"quantity,string"`
+	Price            float64          `json:"price,string,omitempty"`
+	TimeInForce      TimeInForceType  `json:"timeInForce,omitempty"`
+	ReduceOnly       bool             `json:"reduceOnly,omitempty"`
+	PostOnly         bool             `json:"postOnly,omitempty"`
+	NewOrderRespType NewOrderRespType `json:"newOrderRespType,omitempty"`
+	ClientOrderId    string           `json:"clientOrderId,omitempty"`
+	IsMmp            bool             `json:"isMmp,omitempty"`
+}
+
+// MarshalJSON writes Quantity and Price in decimal notation: Binance rejects
+// the exponents of the ",string" encoding of small and large floats
+func (o OptionsOrderRequest) MarshalJSON() ([]byte, error) {
+	var price string
+	if o.Price != 0 {
+		price = strconv.FormatFloat(o.Price, 'f', -1, 64)
+	}
+	return json.Marshal(struct {
+		Symbol           string           `json:"symbol"`
+		Side             SideType         `json:"side"`
+		Type             OrderType        `json:"type"`
+		Quantity         string           `json:"quantity"`
+		Price            string           `json:"price,omitempty"`
+		TimeInForce      TimeInForceType  `json:"timeInForce,omitempty"`
+		ReduceOnly       bool             `json:"reduceOnly,omitempty"`
+		PostOnly         bool             `json:"postOnly,omitempty"`
+		NewOrderRespType NewOrderRespType `json:"newOrderRespType,omitempty"`
+		ClientOrderId    string           `json:"clientOrderId,omitempty"`
+		IsMmp            bool             `json:"isMmp,omitempty"`
+	}{o.Symbol, o.Side, o.Type, strconv.FormatFloat(o.Quantity, 'f', -1, 64), price, o.TimeInForce,
+		o.ReduceOnly, o.PostOnly, o.NewOrderRespType, o.ClientOrderId, o.IsMmp})
+}
+
+// OptionsBatchOrdersService place up to 10 option orders at once
+type OptionsBatchOrdersService struct {
+	c      *Client
+	orders []OptionsOrderRequest
+}
+
+// Orders set orders
+func (s *OptionsBatchOrdersService) Orders(orders ...OptionsOrderRequest) *OptionsBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *OptionsBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*OptionsBatchOrderResponse, err error) {
+	orders, err := json.Marshal(s.orders)
+	if err != nil {
+		return nil, err
+	}
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: optionsBatchOrdersEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("orders", string(orders))
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*OptionsBatchOrderResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OptionsBatchOrderResponse define the result of an order of a batch. Code and
+// Msg are set when the order was rejected.
+type OptionsBatchOrderResponse struct {
+	OptionsOrder
+	Code int64  `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// Cancel Option Order (TRADE) API Endpoint
+const (
+	optionsCancelOrderEndpoint = "/eapi/v1/order"
+)
+
+// OptionsCancelOrderService cancel an active option order
+type OptionsCancelOrderService struct {
+	c             *Client
+	symbol        string
+	orderId       *int64
+	clientOrderId *string
+}
+
+// Symbol set symbol
+func (s *OptionsCancelOrderService) Symbol(symbol string) *OptionsCancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *OptionsCancelOrderService) OrderId(orderId int64) *OptionsCancelOrderService {
+	s.orderId = &orderId
+	return s
+}
+
+// ClientOrderId set clientOrderId
+func (s *OptionsCancelOrderService) ClientOrderId(clientOrderId string) *OptionsCancelOrderService {
+	s.clientOrderId = &clientOrderId
+	return s
+}
+
+// Do send request
+func (s *OptionsCancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *OptionsOrder, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: optionsCancelOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.clientOrderId != nil {
+		r.setParam("clientOrderId", *s.clientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OptionsOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Cancel Multiple Option Orders (TRADE) API Endpoint
+const (
+	optionsBatchCancelOrdersEndpoint = "/eapi/v1/batchOrders"
+)
+
+// OptionsBatchCancelOrdersService cancel up to 10 option orders of a symbol at once
+type OptionsBatchCancelOrdersService struct {
+	c              *Client
+	symbol         string
+	orderIds       []int64
+	clientOrderIds []string
+}
+
+// Symbol set symbol
+func (s *OptionsBatchCancelOrdersService) Symbol(symbol string) *OptionsBatchCancelOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIds set orderIds
+func (s *OptionsBatchCancelOrdersService) OrderIds(orderIds ...int64) *OptionsBatchCancelOrdersService {
+	s.orderIds = orderIds
+	return s
+}
+
+// ClientOrderIds set clientOrderIds
+func (s *OptionsBatchCancelOrdersService) ClientOrderIds(clientOrderIds ...string) *OptionsBatchCancelOrdersService {
+	s.clientOrderIds = clientOrderIds
+	return s
+}
+
+// Do send request
+func (s *OptionsBatchCancelOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*OptionsBatchOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: optionsBatchCancelOrdersEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if len(s.orderIds) > 0 {
+		orderIds, err := json.Marshal(s.orderIds)
+		if err != nil {
+			return nil, err
+		}
+		r.setParam("orderIds", string(orderIds))
+	}
+	if len(s.clientOrderIds) > 0 {
+		clientOrderIds, err := json.Marshal(s.clientOrderIds)
+		if err != nil {
+			return nil, err
+		}
+		r.setParam("clientOrderIds", string(clientOrderIds))
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*OptionsBatchOrderResponse, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Option Position Information (USER_DATA) API Endpoint
+const (
+	optionsPositionEndpoint = "/eapi/v1/position"
+)
+
+// OptionsPositionService query the option positions
+type OptionsPositionService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *OptionsPositionService) Symbol(symbol string) *OptionsPositionService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *OptionsPositionService) Do(ctx context.Context, opts ...RequestOption) (res []*OptionsPosition, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsPositionEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OptionsPosition{}, err
+	}
+	res = make([]*OptionsPosition, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OptionsPosition{}, err
+	}
+	return res, nil
+}
+
+// OptionsPosition define an option position
+type OptionsPosition struct {
+	EntryPrice    string     `json:"entryPrice"`
+	Symbol        string     `json:"symbol"`
+	Side          string     `json:"side"`
+	Quantity      string     `json:"quantity"`
+	ReducibleQty  string     `json:"reducibleQty"`
+	MarkValue     string     `json:"markValue"`
+	Ror           string     `json:"ror"`
+	UnrealizedPNL string     `json:"unrealizedPNL"`
+	MarkPrice     string     `json:"markPrice"`
+	StrikePrice   string     `json:"strikePrice"`
+	PositionCost  string     `json:"positionCost"`
+	ExpiryDate    int64      `json:"expiryDate"`
+	PriceScale    int        `json:"priceScale"`
+	QuantityScale int        `json:"quantityScale"`
+	OptionSide    OptionSide `json:"optionSide"`
+	QuoteAsset    string     `json:"quoteAsset"`
+}
+
+// Option Account Information (TRADE) API Endpoint
+const (
+	optionsAccountEndpoint = "/eapi/v1/account"
+)
+
+// OptionsAccountService query the option account balances and Greeks
+type OptionsAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *OptionsAccountService) Do(ctx context.Context, opts ...RequestOption) (res *OptionsAccountResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsAccountEndpoint,
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OptionsAccountResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OptionsAccountResponse define options account response
+type OptionsAccountResponse struct {
+	Asset []struct {
+		Asset         string `json:"asset"`
+		MarginBalance string `json:"marginBalance"`
+		Equity        string `json:"equity"`
+		Available     string `json:"available"`
+		Locked        string `json:"locked"`
+		UnrealizedPNL string `json:"unrealizedPNL"`
+	} `json:"asset"`
+	Greek []struct {
+		Underlying string `json:"underlying"`
+		OptionGreeks
+	} `json:"greek"`
+	Time      int64  `json:"time"`
+	RiskLevel string `json:"riskLevel"`
+}
+
+// User Exercise Record (USER_DATA) API Endpoint
+const (
+	optionsUserExerciseRecordEndpoint = "/eapi/v1/exerciseRecord"
+)
+
+// OptionsUserExerciseRecordService query the exercise records of the account
+type OptionsUserExerciseRecordService struct {
+	c         *Client
+	symbol    *string
+	startTime *uint64
+	endTime   *uint64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *OptionsUserExerciseRecordService) Symbol(symbol string) *OptionsUserExerciseRecordService {
+	s.symbol = &symbol
+	return s
+}
+
+// StartTime set startTime
+func (s *OptionsUserExerciseRecordService) StartTime(startTime uint64) *OptionsUserExerciseRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *OptionsUserExerciseRecordService) EndTime(endTime uint64) *OptionsUserExerciseRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *OptionsUserExerciseRecordService) Limit(limit int) *OptionsUserExerciseRecordService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *OptionsUserExerciseRecordService) Do(ctx context.Context, opts ...RequestOption) (res []*OptionsUserExerciseRecord, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: optionsUserExerciseRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OptionsUserExerciseRecord{}, err
+	}
+	res = make([]*OptionsUserExerciseRecord, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OptionsUserExerciseRecord{}, err
+	}
+	return res, nil
+}
+
+// OptionsUserExerciseRecord define an exercise record of the account
+type OptionsUserExerciseRecord struct {
+	Id            string     `json:"id"`
+	Currency      string     `json:"currency"`
+	Symbol        string     `json:"symbol"`
+	ExercisePrice string     `json:"exercisePrice"`
+	MarkPrice     string     `json:"markPrice"`
+	Quantity      string     `json:"quantity"`
+	Amount        string     `json:"amount"`
+	Fee           string     `json:"fee"`
+	CreateDate    int64      `json:"createDate"`
+	PriceScale    int        `json:"priceScale"`
+	QuantityScale int        `json:"quantityScale"`
+	OptionSide    OptionSide `json:"optionSide"`
+	PositionSide  string     `json:"positionSide"`
+	QuoteAsset    string     `json:"quoteAsset"`
+}
+
+// Options User Data Stream API Endpoint
+const (
+	optionsListenKeyEndpoint = "/eapi/v1/listenKey"
+)
+
+// OptionsCreateListenKeyService start an options user data stream
+type OptionsCreateListenKeyService struct {
+	c *Client
+}
+
+// Do send request
+func (s *OptionsCreateListenKeyService) Do(ctx context.Context, opts ...RequestOption) (listenKey string, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: optionsListenKeyEndpoint,
+		secType:  secTypeAPIKey,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return "", err
+	}
+	j, err := newJSON(data)
+	if err != nil {
+		return "", err
+	}
+	listenKey = j.Get("listenKey").MustString()
+	return listenKey, nil
+}
+
+// OptionsPingListenKeyService keep an options user data stream alive
+type OptionsPingListenKeyService struct {
+	c *Client
+}
+
+// Do send request
+func (s *OptionsPingListenKeyService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: optionsListenKeyEndpoint,
+		secType:  secTypeAPIKey,
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// OptionsCloseListenKeyService close an options user data stream
+type OptionsCloseListenKeyService struct {
+	c *Client
+}
+
+// Do send request
+func (s *OptionsCloseListenKeyService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: optionsListenKeyEndpoint,
+		secType:  secTypeAPIKey,
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
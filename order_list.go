@@ -0,0 +1,313 @@
+package binance_connector
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrInvalidOrderList is returned by the order list services when the legs
+// do not form a combination accepted by the exchange. The request is not sent.
+var ErrInvalidOrderList = errors.New("invalid order list")
+
+// OrderListLeg describes one order of an OCO, OTO or OTOCO order list.
+// Side and quantity are set on the order list service, as some lists share them between legs.
+type OrderListLeg struct {
+	orderType     string
+	clientOrderId *string
+	price         *float64
+	stopPrice     *float64
+	trailingDelta *int
+	icebergQty    *float64
+	timeInForce   *string
+	strategyId    *int
+	strategyType  *int
+}
+
+// NewOrderListLeg create a leg of the given order type, e.g. LIMIT_MAKER or STOP_LOSS_LIMIT
+func NewOrderListLeg(orderType string) *OrderListLeg {
+	return &OrderListLeg{orderType: orderType}
+}
+
+// ClientOrderId set clientOrderId
+func (l *OrderListLeg) ClientOrderId(clientOrderId string) *OrderListLeg {
+	l.clientOrderId = &clientOrderId
+	return l
+}
+
+// Price set price
+func (l *OrderListLeg) Price(price float64) *OrderListLeg {
+	l.price = &price
+	return l
+}
+
+// StopPrice set stopPrice
+func (l *OrderListLeg) StopPrice(stopPrice float64) *OrderListLeg {
+	l.stopPrice = &stopPrice
+	return l
+}
+
+// TrailingDelta set trailingDelta
+func (l *OrderListLeg) TrailingDelta(trailingDelta int) *OrderListLeg {
+	l.trailingDelta = &trailingDelta
+	return l
+}
+
+// IcebergQty set icebergQty
+func (l *OrderListLeg) IcebergQty(icebergQty float64) *OrderListLeg {
+	l.icebergQty = &icebergQty
+	return l
+}
+
+// TimeInForce set timeInForce
+func (l *OrderListLeg) TimeInForce(timeInForce string) *OrderListLeg {
+	l.timeInForce = &timeInForce
+	return l
+}
+
+// StrategyId set strategyId
+func (l *OrderListLeg) StrategyId(strategyId int) *OrderListLeg {
+	l.strategyId = &strategyId
+	return l
+}
+
+// StrategyType set strategyType
+func (l *OrderListLeg) StrategyType(strategyType int) *OrderListLeg {
+	l.strategyType = &strategyType
+	return l
+}
+
+// validate checks the leg carries the prices its order type needs. name is
+// the parameter prefix of the leg, e.g. "above" or "pendingBelow".
+func (l *OrderListLeg) validate(name string) error {
+	if l == nil {
+		return fmt.Errorf("%w: %s leg is required", ErrInvalidOrderList, name)
+	}
+	var needPrice, needTimeInForce, needStop bool
+	switch l.orderType {
+	case "MARKET":
+	case "LIMIT":
+		needPrice, needTimeInForce = true, true
+	case "LIMIT_MAKER":
+		needPrice = true
+	case "STOP_LOSS", "TAKE_PROFIT":
+		needStop = true
+	case "STOP_LOSS_LIMIT", "TAKE_PROFIT_LIMIT":
+		needPrice, needTimeInForce, needStop = true, true, true
+	default:
+		return fmt.Errorf("%w: unsupported %sType %q", ErrInvalidOrderList, name, l.orderType)
+	}
+	if needPrice && l.price == nil {
+		return fmt.Errorf("%w: %sPrice is required for %s", ErrInvalidOrderList, name, l.orderType)
+	}
+	if needTimeInForce && l.timeInForce == nil {
+		return fmt.Errorf("%w: %sTimeInForce is required for %s", ErrInvalidOrderList, name, l.orderType)
+	}
+	if needStop && l.stopPrice == nil && l.trailingDelta == nil {
+		return fmt.Errorf("%w: %sStopPrice or %sTrailingDelta is required for %s", ErrInvalidOrderList, name, name, l.orderType)
+	}
+	return nil
+}
+
+// setParameters writes the leg into parameters, prefixing every key with name
+func (l *OrderListLeg) setParameters(parameters map[string]string, name string) {
+	parameters[name+"Type"] = l.orderType
+	if l.clientOrderId != nil {
+		parameters[name+"ClientOrderId"] = *l.clientOrderId
+	}
+	if l.price != nil {
+		parameters[name+"Price"] = strconv.FormatFloat(*l.price, 'f', -1, 64)
+	}
+	if l.stopPrice != nil {
+		parameters[name+"StopPrice"] = strconv.FormatFloat(*l.stopPrice, 'f', -1, 64)
+	}
+	if l.trailingDelta != nil {
+		parameters[name+"TrailingDelta"] = strconv.Itoa(*l.trailingDelta)
+	}
+	if l.icebergQty != nil {
+		parameters[name+"IcebergQty"] = strconv.FormatFloat(*l.icebergQty, 'f', -1, 64)
+	}
+	if l.timeInForce != nil {
+		parameters[name+"TimeInForce"] = *l.timeInForce
+	}
+	if l.strategyId != nil {
+		parameters[name+"StrategyId"] = strconv.Itoa(*l.strategyId)
+	}
+	if l.strategyType != nil {
+		parameters[name+"StrategyType"] = strconv.Itoa(*l.strategyType)
+	}
+}
+
+func isTakeProfitLeg(orderType string) bool {
+	return orderType == "LIMIT_MAKER" || orderType == "TAKE_PROFIT" || orderType == "TAKE_PROFIT_LIMIT"
+}
+
+func isStopLossLeg(orderType string) bool {
+	return orderType == "STOP_LOSS" || orderType == "STOP_LOSS_LIMIT"
+}
+
+// validateOCOLegs checks an above/below pair: one leg must take profit and the
+// other stop the loss, with the take profit leg above the market for a SELL
+// and below it for a BUY.
+func validateOCOLegs(side string, above, below *OrderListLeg, abovePrefix, belowPrefix string) error {
+	if err := above.validate(abovePrefix); err != nil {
+		return err
+	}
+	if err := below.validate(belowPrefix); err != nil {
+		return err
+	}
+	var takeProfit, stopLoss *OrderListLeg
+	var takeProfitName, stopLossName string
+	switch side {
+	case "SELL":
+		takeProfit, stopLoss, takeProfitName, stopLossName = above, below, abovePrefix, belowPrefix
+	case "BUY":
+		takeProfit, stopLoss, takeProfitName, stopLossName = below, above, belowPrefix, abovePrefix
+	default:
+		return fmt.Errorf("%w: unsupported side %q", ErrInvalidOrderList, side)
+	}
+	if !isTakeProfitLeg(takeProfit.orderType) {
+		return fmt.Errorf("%w: %sType must be LIMIT_MAKER, TAKE_PROFIT or TAKE_PROFIT_LIMIT for a %s list, got %s",
+			ErrInvalidOrderList, takeProfitName, side, takeProfit.orderType)
+	}
+	if !isStopLossLeg(stopLoss.orderType) {
+		return fmt.Errorf("%w: %sType must be STOP_LOSS or STOP_LOSS_LIMIT for a %s list, got %s",
+			ErrInvalidOrderList, stopLossName, side, stopLoss.orderType)
+	}
+	return nil
+}
+
+// validateWorkingLeg checks the working order of an OTO or OTOCO list, which must rest on the book
+func validateWorkingLeg(side string, working *OrderListLeg) error {
+	if err := working.validate("working"); err != nil {
+		return err
+	}
+	if working.orderType != "LIMIT" && working.orderType != "LIMIT_MAKER" {
+		return fmt.Errorf("%w: workingType must be LIMIT or LIMIT_MAKER, got %s", ErrInvalidOrderList, working.orderType)
+	}
+	if side != "BUY" && side != "SELL" {
+		return fmt.Errorf("%w: unsupported workingSide %q", ErrInvalidOrderList, side)
+	}
+	return nil
+}
+
+type orderListOCOParams struct {
+	symbol                  string
+	listClientOrderId       *string
+	side                    string
+	quantity                float64
+	above                   *OrderListLeg
+	below                   *OrderListLeg
+	newOrderRespType        *string
+	selfTradePreventionMode *string
+}
+
+func (p *orderListOCOParams) parameters() (map[string]string, error) {
+	if err := validateOCOLegs(p.side, p.above, p.below, "above", "below"); err != nil {
+		return nil, err
+	}
+	parameters := map[string]string{
+		"symbol":   p.symbol,
+		"side":     p.side,
+		"quantity": strconv.FormatFloat(p.quantity, 'f', -1, 64),
+	}
+	p.above.setParameters(parameters, "above")
+	p.below.setParameters(parameters, "below")
+	if p.listClientOrderId != nil {
+		parameters["listClientOrderId"] = *p.listClientOrderId
+	}
+	if p.newOrderRespType != nil {
+		parameters["newOrderRespType"] = *p.newOrderRespType
+	}
+	if p.selfTradePreventionMode != nil {
+		parameters["selfTradePreventionMode"] = *p.selfTradePreventionMode
+	}
+	return parameters, nil
+}
+
+type orderListOTOParams struct {
+	symbol                  string
+	listClientOrderId       *string
+	working                 *OrderListLeg
+	workingSide             string
+	workingQuantity         float64
+	pending                 *OrderListLeg
+	pendingSide             string
+	pendingQuantity         float64
+	newOrderRespType        *string
+	selfTradePreventionMode *string
+}
+
+func (p *orderListOTOParams) parameters() (map[string]string, error) {
+	if err := validateWorkingLeg(p.workingSide, p.working); err != nil {
+		return nil, err
+	}
+	if err := p.pending.validate("pending"); err != nil {
+		return nil, err
+	}
+	if p.pendingSide != "BUY" && p.pendingSide != "SELL" {
+		return nil, fmt.Errorf("%w: unsupported pendingSide %q", ErrInvalidOrderList, p.pendingSide)
+	}
+	parameters := map[string]string{
+		"symbol":          p.symbol,
+		"workingSide":     p.workingSide,
+		"workingQuantity": strconv.FormatFloat(p.workingQuantity, 'f', -1, 64),
+		"pendingSide":     p.pendingSide,
+		"pendingQuantity": strconv.FormatFloat(p.pendingQuantity, 'f', -1, 64),
+	}
+	p.working.setParameters(parameters, "working")
+	p.pending.setParameters(parameters, "pending")
+	if p.listClientOrderId != nil {
+		parameters["listClientOrderId"] = *p.listClientOrderId
+	}
+	if p.newOrderRespType != nil {
+		parameters["newOrderRespType"] = *p.newOrderRespType
+	}
+	if p.selfTradePreventionMode != nil {
+		parameters["selfTradePreventionMode"] = *p.selfTradePreventionMode
+	}
+	return parameters, nil
+}
+
+type orderListOTOCOParams struct {
+	symbol                  string
+	listClientOrderId       *string
+	working                 *OrderListLeg
+	workingSide             string
+	workingQuantity         float64
+	pendingSide             string
+	pendingQuantity         float64
+	pendingAbove            *OrderListLeg
+	pendingBelow            *OrderListLeg
+	newOrderRespType        *string
+	selfTradePreventionMode *string
+}
+
+func (p *orderListOTOCOParams) parameters() (map[string]string, error) {
+	if err := validateWorkingLeg(p.workingSide, p.working); err != nil {
+		return nil, err
+	}
+	if err := validateOCOLegs(p.pendingSide, p.pendingAbove, p.pendingBelow, "pendingAbove", "pendingBelow"); err != nil {
+		return nil, err
+	}
+	parameters := map[string]string{
+		"symbol":          p.symbol,
+		"workingSide":     p.workingSide,
+		"workingQuantity": strconv.FormatFloat(p.workingQuantity, 'f', -1, 64),
+		"pendingSide":     p.pendingSide,
+		"pendingQuantity": strconv.FormatFloat(p.pendingQuantity, 'f', -1, 64),
+	}
+	p.working.setParameters(parameters, "working")
+	p.pendingAbove.setParameters(parameters, "pendingAbove")
+	p.pendingBelow.setParameters(parameters, "pendingBelow")
+	if p.listClientOrderId != nil {
+		parameters["listClientOrderId"] = *p.listClientOrderId
+	}
+	if p.newOrderRespType != nil {
+		parameters["newOrderRespType"] = *p.newOrderRespType
+	}
+	if p.selfTradePreventionMode != nil {
+		parameters["selfTradePreventionMode"] = *p.selfTradePreventionMode
+	}
+	return parameters, nil
+}
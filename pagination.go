@@ -0,0 +1,372 @@
+package binance_connector
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+)
+
+// maxHistoryLimit is the maximum number of rows returned by a single klines,
+// aggTrades or historicalTrades call
+const maxHistoryLimit = 1000
+
+// aggTradesMaxWindow is the widest startTime/endTime window accepted by aggTrades
+const aggTradesMaxWindow = time.Hour
+
+// errCodeTooManyRequests is returned by Binance when the request weight limit is exceeded
+const errCodeTooManyRequests = -1003
+
+// pageFunc fetches the next page of a paged result set. It reports more=false
+// once the final page has been returned.
+type pageFunc[T any] func(ctx context.Context) (page []T, more bool, err error)
+
+// Iterator walks a paged result set one record at a time, fetching new pages
+// from the API as the previous one is consumed.
+//
+//	it := client.KlinesRange(ctx, "BTCUSDT", "1m", from, to)
+//	for it.Next() {
+//		kline := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx      context.Context
+	fetch    pageFunc[T]
+	cfg      *iteratorConfig
+	lastCall time.Time
+	buf      []T
+	cur      T
+	more     bool
+	err      error
+}
+
+type iteratorConfig struct {
+	pageInterval time.Duration
+	retries      int
+	backoff      time.Duration
+}
+
+// IteratorOption define option type for Iterator
+type IteratorOption func(*iteratorConfig)
+
+// WithPageInterval sets the minimum delay between two page requests, to keep
+// long backfills within the request weight limit
+func WithPageInterval(interval time.Duration) IteratorOption {
+	return func(c *iteratorConfig) {
+		c.pageInterval = interval
+	}
+}
+
+// WithRateLimitRetry sets how many times a page request rejected for exceeding
+// the request weight limit is retried, and the initial backoff between retries
+func WithRateLimitRetry(retries int, backoff time.Duration) IteratorOption {
+	return func(c *iteratorConfig) {
+		c.retries = retries
+		c.backoff = backoff
+	}
+}
+
+func newIterator[T any](ctx context.Context, fetch pageFunc[T], opts ...IteratorOption) *Iterator[T] {
+	cfg := &iteratorConfig{
+		retries: 3,
+		backoff: time.Second,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return &Iterator[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		cfg:   cfg,
+		more:  true,
+	}
+}
+
+// Next advances the iterator to the next record. It returns false when the
+// result set is exhausted or an error occurred; call Err to tell them apart.
+func (it *Iterator[T]) Next() bool {
+	for len(it.buf) == 0 {
+		if !it.more || it.err != nil {
+			return false
+		}
+		it.buf, it.more, it.err = it.fetchPage()
+		if it.err != nil {
+			return false
+		}
+	}
+	it.cur = it.buf[0]
+	it.buf = it.buf[1:]
+	return true
+}
+
+// Value returns the current record
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the first error encountered while paging, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All drains the iterator and returns every remaining record
+func (it *Iterator[T]) All() (res []T, err error) {
+	for it.Next() {
+		res = append(res, it.Value())
+	}
+	return res, it.Err()
+}
+
+func (it *Iterator[T]) fetchPage() (page []T, more bool, err error) {
+	backoff := it.cfg.backoff
+	for attempt := 0; ; attempt++ {
+		if err = it.wait(); err != nil {
+			return nil, false, err
+		}
+		it.lastCall = time.Now()
+		page, more, err = it.fetch(it.ctx)
+		if err == nil || !isRateLimitError(err) || attempt >= it.cfg.retries {
+			return page, more, err
+		}
+		if err = sleepContext(it.ctx, backoff); err != nil {
+			return nil, false, err
+		}
+		backoff *= 2
+	}
+}
+
+// wait blocks until the configured page interval has elapsed since the last call
+func (it *Iterator[T]) wait() error {
+	if it.cfg.pageInterval <= 0 || it.lastCall.IsZero() {
+		return it.ctx.Err()
+	}
+	return sleepContext(it.ctx, time.Until(it.lastCall.Add(it.cfg.pageInterval)))
+}
+
+func isRateLimitError(err error) bool {
+	var apiErr *handlers.APIError
+	return errors.As(err, &apiErr) && apiErr.Code == errCodeTooManyRequests
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// KlinesRange returns an iterator over every kline of symbol and interval
+// opened between from and to (inclusive), paging through /api/v3/klines
+func (c *Client) KlinesRange(ctx context.Context, symbol string, interval string, from, to time.Time, opts ...IteratorOption) *Iterator[*KlinesResponse] {
+	cursor := uint64(FormatTimestamp(from))
+	end := uint64(FormatTimestamp(to))
+	var last *uint64
+	fetch := func(ctx context.Context) ([]*KlinesResponse, bool, error) {
+		if cursor > end {
+			return nil, false, nil
+		}
+		klines, err := c.NewKlinesService().Symbol(symbol).Interval(interval).
+			StartTime(cursor).EndTime(end).Limit(maxHistoryLimit).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		page := make([]*KlinesResponse, 0, len(klines))
+		for _, k := range klines {
+			if last != nil && k.OpenTime <= *last {
+				continue
+			}
+			openTime := k.OpenTime
+			last = &openTime
+			page = append(page, k)
+		}
+		if last != nil {
+			cursor = *last + 1
+		}
+		return page, len(klines) == maxHistoryLimit && cursor <= end, nil
+	}
+	return newIterator(ctx, fetch, opts...)
+}
+
+// AggTradesRange returns an iterator over every aggregate trade of symbol
+// executed between from and to (inclusive), paging through /api/v3/aggTrades
+func (c *Client) AggTradesRange(ctx context.Context, symbol string, from, to time.Time, opts ...IteratorOption) *Iterator[*AggTradesListResponse] {
+	start := uint64(FormatTimestamp(from))
+	end := uint64(FormatTimestamp(to))
+	var last *uint64
+	fetch := func(ctx context.Context) ([]*AggTradesListResponse, bool, error) {
+		var trades []*AggTradesListResponse
+		var err error
+		windowed := last == nil
+		if windowed {
+			// Locate the first trade by time, one window at a time, then
+			// continue by id so that no trade is skipped inside a busy window
+			trades, start, err = c.firstAggTrades(ctx, symbol, start, end)
+		} else {
+			trades, err = c.NewAggTradesListService().Symbol(symbol).
+				FromId(int(*last + 1)).Limit(maxHistoryLimit).Do(ctx)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		page := make([]*AggTradesListResponse, 0, len(trades))
+		for _, t := range trades {
+			if t.Time > end {
+				return page, false, nil
+			}
+			if last != nil && t.AggTradeId <= *last {
+				continue
+			}
+			id := t.AggTradeId
+			last = &id
+			page = append(page, t)
+		}
+		if last == nil {
+			return page, start <= end, nil
+		}
+		return page, windowed || len(trades) == maxHistoryLimit, nil
+	}
+	return newIterator(ctx, fetch, opts...)
+}
+
+// firstAggTrades fetches the aggregate trades of the first window starting at
+// start. It returns the start of the following window.
+func (c *Client) firstAggTrades(ctx context.Context, symbol string, start, end uint64) ([]*AggTradesListResponse, uint64, error) {
+	windowEnd := start + uint64(aggTradesMaxWindow.Milliseconds()) - 1
+	if windowEnd > end {
+		windowEnd = end
+	}
+	trades, err := c.NewAggTradesListService().Symbol(symbol).
+		StartTime(start).EndTime(windowEnd).Limit(maxHistoryLimit).Do(ctx)
+	if err != nil {
+		return nil, start, err
+	}
+	return trades, windowEnd + 1, nil
+}
+
+// HistoricalTradesRange returns an iterator over every trade of symbol
+// executed between from and to (inclusive), paging through
+// /api/v3/historicalTrades. The first trade id is located with /api/v3/aggTrades.
+func (c *Client) HistoricalTradesRange(ctx context.Context, symbol string, from, to time.Time, opts ...IteratorOption) *Iterator[*RecentTradesListResponse] {
+	end := uint64(FormatTimestamp(to))
+	aggTrades := c.AggTradesRange(ctx, symbol, from, to, opts...)
+	var last *uint64
+	fetch := func(ctx context.Context) ([]*RecentTradesListResponse, bool, error) {
+		fromId := int64(0)
+		if last == nil {
+			if !aggTrades.Next() {
+				return nil, false, aggTrades.Err()
+			}
+			fromId = int64(aggTrades.Value().FirstTradeId)
+		} else {
+			fromId = int64(*last + 1)
+		}
+		trades, err := c.NewHistoricalTradeLookupService().Symbol(symbol).
+			FromId(fromId).Limit(maxHistoryLimit).Do(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		page := make([]*RecentTradesListResponse, 0, len(trades))
+		for _, t := range trades {
+			if t.Time > end {
+				return page, false, nil
+			}
+			if last != nil && t.Id <= *last {
+				continue
+			}
+			id := t.Id
+			last = &id
+			page = append(page, t)
+		}
+		return page, len(trades) == maxHistoryLimit, nil
+	}
+	return newIterator(ctx, fetch, opts...)
+}
+
+// windowPager pages through a history endpoint that caps both the width of
+// the startTime/endTime window and the number of rows returned per call. The
+// requested range is split into windows of at most window width; within a
+// window, pages are requested until one comes back short.
+type windowPager[T any] struct {
+	// window is the widest startTime/endTime span accepted by the endpoint
+	window time.Duration
+	// limit is the maximum number of rows returned per call
+	limit int
+	// key identifies a record; records already yielded are dropped. Optional.
+	key func(T) any
+	// timeOf returns the record time. When set, pages within a window are
+	// requested by moving startTime past the last record instead of by page
+	// number, for endpoints that only support time or id cursors.
+	timeOf func(T) uint64
+	// fetch requests one page of rows within [start, end]. page is zero-based.
+	fetch func(ctx context.Context, start, end uint64, page int) ([]T, error)
+}
+
+func (p *windowPager[T]) iterate(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[T] {
+	windowStart := uint64(FormatTimestamp(from))
+	end := uint64(FormatTimestamp(to))
+	width := uint64(p.window.Milliseconds())
+	cursor := windowStart
+	page := 0
+	seen := make(map[any]struct{})
+	nextWindow := func() {
+		windowStart += width
+		cursor = windowStart
+		page = 0
+		seen = make(map[any]struct{})
+	}
+	fetch := func(ctx context.Context) ([]T, bool, error) {
+		windowEnd := windowStart + width - 1
+		if windowEnd > end {
+			windowEnd = end
+		}
+		if cursor > windowEnd {
+			nextWindow()
+			return nil, windowStart <= end, nil
+		}
+		rows, err := p.fetch(ctx, cursor, windowEnd, page)
+		if err != nil {
+			return nil, false, err
+		}
+		res := make([]T, 0, len(rows))
+		latest := cursor
+		for _, row := range rows {
+			if p.timeOf != nil && p.timeOf(row) > latest {
+				latest = p.timeOf(row)
+			}
+			if p.key != nil {
+				k := p.key(row)
+				if _, ok := seen[k]; ok {
+					continue
+				}
+				seen[k] = struct{}{}
+			}
+			res = append(res, row)
+		}
+		switch {
+		case len(rows) < p.limit:
+			// window exhausted, move on to the next one
+			nextWindow()
+		case p.timeOf != nil:
+			// a full page with nothing new means more than limit rows share
+			// one timestamp; step over it rather than loop forever
+			if latest == cursor && len(res) == 0 {
+				latest++
+			}
+			cursor = latest
+		default:
+			page++
+		}
+		return res, windowStart <= end, nil
+	}
+	return newIterator(ctx, fetch, opts...)
+}
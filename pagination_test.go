@@ -0,0 +1,138 @@
+package binance_connector
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type paginationTestSuite struct {
+	baseTestSuite
+}
+
+func TestPagination(t *testing.T) {
+	suite.Run(t, new(paginationTestSuite))
+}
+
+// mockDoPages mocks one response per call, in order
+func (s *paginationTestSuite) mockDoPages(pages ...[]byte) {
+	s.client.Client.do = s.client.do
+	for _, page := range pages {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(page, http.StatusOK), nil).Once()
+	}
+}
+
+func klinesPage(openTimes ...uint64) []byte {
+	rows := make([]string, 0, len(openTimes))
+	for _, t := range openTimes {
+		rows = append(rows, fmt.Sprintf(`[%d,"1.0","1.1","0.9","1.0","10.0",%d,"10.0",5,"5.0","5.0","0"]`, t, t+59999))
+	}
+	return []byte("[" + strings.Join(rows, ",") + "]")
+}
+
+func (s *paginationTestSuite) TestKlinesRange() {
+	first := make([]uint64, maxHistoryLimit)
+	for i := range first {
+		first[i] = uint64(i) * 60000
+	}
+	last := first[len(first)-1]
+	s.mockDoPages(klinesPage(first...), klinesPage(last, last+60000))
+
+	var startTimes []string
+	s.assertReq(func(r *request) {
+		startTimes = append(startTimes, r.query.Get("startTime"))
+	})
+
+	klines, err := s.client.KlinesRange(newContext(), "BTCUSDT", "1m",
+		time.UnixMilli(0), time.UnixMilli(int64(last+60000))).All()
+	r := s.r()
+	r.NoError(err)
+	r.Len(klines, maxHistoryLimit+1)
+	r.Equal(last+60000, klines[len(klines)-1].OpenTime)
+	r.Equal([]string{"0", fmt.Sprint(last + 1)}, startTimes)
+}
+
+func (s *paginationTestSuite) TestAggTradesRange() {
+	s.mockDoPages(
+		[]byte(`[{"a":1,"f":10,"l":10,"T":1000},{"a":2,"f":11,"l":11,"T":2000}]`),
+		[]byte(`[{"a":3,"f":12,"l":12,"T":3000},{"a":4,"f":13,"l":13,"T":9000}]`),
+	)
+
+	var fromIds []string
+	s.assertReq(func(r *request) {
+		fromIds = append(fromIds, r.query.Get("fromId"))
+	})
+
+	trades, err := s.client.AggTradesRange(newContext(), "BTCUSDT",
+		time.UnixMilli(0), time.UnixMilli(5000)).All()
+	r := s.r()
+	r.NoError(err)
+	r.Len(trades, 3)
+	r.Equal(uint64(3), trades[2].AggTradeId)
+	r.Equal([]string{"", "3"}, fromIds)
+}
+
+func (s *paginationTestSuite) TestIteratorRateLimitRetry() {
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(
+		[]byte(`{"code":-1003,"msg":"Too many requests"}`), http.StatusTooManyRequests), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(klinesPage(0), http.StatusOK), nil).Once()
+
+	klines, err := s.client.KlinesRange(newContext(), "BTCUSDT", "1m",
+		time.UnixMilli(0), time.UnixMilli(60000), WithRateLimitRetry(1, time.Millisecond)).All()
+	r := s.r()
+	r.NoError(err)
+	r.Len(klines, 1)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *paginationTestSuite) TestDepositHistoryRange() {
+	s.mockDoPages(
+		[]byte(`[{"id":"1","coin":"USDT","insertTime":1000}]`),
+		[]byte(`[{"id":"2","coin":"USDT","insertTime":7776000001}]`),
+	)
+
+	var windows [][2]string
+	s.assertReq(func(r *request) {
+		windows = append(windows, [2]string{r.query.Get("startTime"), r.query.Get("endTime")})
+	})
+
+	to := time.UnixMilli(0).Add(100 * 24 * time.Hour)
+	deposits, err := s.client.NewDepositHistoryService().Coin("USDT").
+		Range(newContext(), time.UnixMilli(0), to).All()
+	r := s.r()
+	r.NoError(err)
+	r.Len(deposits, 2)
+	r.Equal([][2]string{
+		{"0", "7775999999"},
+		{"7776000000", "8640000000"},
+	}, windows)
+}
+
+func (s *paginationTestSuite) TestGetAllOrdersRange() {
+	rows := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		rows = append(rows, fmt.Sprintf(`{"symbol":"BTCUSDT","orderId":%d,"time":%d}`, i, i))
+	}
+	s.mockDoPages(
+		[]byte("["+strings.Join(rows, ",")+"]"),
+		[]byte(`[{"symbol":"BTCUSDT","orderId":999,"time":999},{"symbol":"BTCUSDT","orderId":1000,"time":1000}]`),
+	)
+
+	var startTimes []string
+	s.assertReq(func(r *request) {
+		startTimes = append(startTimes, r.query.Get("startTime"))
+	})
+
+	orders, err := s.client.NewGetAllOrdersService().Symbol("BTCUSDT").
+		Range(newContext(), time.UnixMilli(0), time.UnixMilli(3600000)).All()
+	r := s.r()
+	r.NoError(err)
+	r.Len(orders, 1001)
+	r.Equal(int64(1000), orders[1000].OrderId)
+	r.Equal([]string{"0", "999"}, startTimes)
+}
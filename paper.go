@@ -0,0 +1,1017 @@
+package binance_connector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PaperTrader simulates spot order execution against market data, so that
+// strategies written against Client run unchanged in simulation.
+//
+// Route a Client through the simulator by setting its HTTP transport:
+//
+//	paper := NewPaperTrader()
+//	paper.AddSymbol("BTCUSDT", "BTC", "USDT")
+//	paper.SetBalance("USDT", "10000")
+//	client.HTTPClient = &http.Client{Transport: paper.Transport(nil)}
+//
+// CreateOrderService, CancelOrderService, GetOrderService, GetOpenOrdersService,
+// CancelOpenOrdersService and GetAccountService are then answered by the
+// simulator; every other request is passed through. The book is fed by
+// passing DepthHandler or BookTickerHandler to WsDepthServe or
+// WsBookTickerServe, either live or through a Replayer. Simulated orders never
+// consume book liquidity. Balances, fees and quantities are kept as exact
+// decimals, so that repeated fills do not drift from the amounts of the API.
+type PaperTrader struct {
+	mu          sync.Mutex
+	now         func() time.Time
+	symbols     map[string]paperSymbol
+	books       map[string]*paperBook
+	free        map[string]*big.Rat
+	locked      map[string]*big.Rat
+	fees        map[string]paperFee
+	orders      map[int64]*paperOrder
+	nextId      int64
+	nextTradeId int64
+	handlers    []WsUserDataHandler
+}
+
+type paperSymbol struct {
+	base  string
+	quote string
+}
+
+type paperFee struct {
+	maker *big.Rat
+	taker *big.Rat
+}
+
+// rate returns the commission rate of a maker or taker fill, zero if the
+// symbol has no fee
+func (f paperFee) rate(isMaker bool) *big.Rat {
+	rate := f.taker
+	if isMaker {
+		rate = f.maker
+	}
+	if rate == nil {
+		return new(big.Rat)
+	}
+	return rate
+}
+
+type paperLevel struct {
+	price *big.Rat
+	qty   *big.Rat
+}
+
+// paperBook keeps bids highest first and asks lowest first
+type paperBook struct {
+	bids []paperLevel
+	asks []paperLevel
+}
+
+type paperFill struct {
+	price           *big.Rat
+	qty             *big.Rat
+	quoteQty        *big.Rat
+	commission      *big.Rat
+	commissionAsset string
+	tradeId         int64
+	isMaker         bool
+}
+
+type paperOrder struct {
+	symbol        string
+	orderId       int64
+	clientOrderId string
+	side          string
+	orderType     string
+	timeInForce   string
+	price         *big.Rat
+	stopPrice     *big.Rat
+	origQty       *big.Rat
+	quoteOrderQty *big.Rat
+	executedQty   *big.Rat
+	cumQuote      *big.Rat
+	status        string
+	working       bool
+	time          int64
+	updateTime    int64
+	fills         []paperFill
+}
+
+func (o *paperOrder) remaining() *big.Rat {
+	return new(big.Rat).Sub(o.origQty, o.executedQty)
+}
+
+func (o *paperOrder) isOpen() bool {
+	return o.status == "NEW" || o.status == "PARTIALLY_FILLED"
+}
+
+// NewPaperTrader creates a simulator with empty balances and no fees
+func NewPaperTrader() *PaperTrader {
+	return &PaperTrader{
+		now:     time.Now,
+		symbols: make(map[string]paperSymbol),
+		books:   make(map[string]*paperBook),
+		free:    make(map[string]*big.Rat),
+		locked:  make(map[string]*big.Rat),
+		fees:    make(map[string]paperFee),
+		orders:  make(map[int64]*paperOrder),
+	}
+}
+
+// AddSymbol makes symbol tradable
+func (p *PaperTrader) AddSymbol(symbol, baseAsset, quoteAsset string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.symbols[symbol] = paperSymbol{base: baseAsset, quote: quoteAsset}
+}
+
+// SetBalance sets the free balance of asset, e.g. "10000". It panics if
+// amount is not a decimal number.
+func (p *PaperTrader) SetBalance(asset, amount string) {
+	free := mustParsePaper(amount)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.free[asset] = free
+}
+
+// SetTradeFee sets the maker and taker commission rates of symbol, e.g.
+// "0.001". It panics if a rate is not a decimal number.
+func (p *PaperTrader) SetTradeFee(symbol, maker, taker string) {
+	p.setTradeFee(symbol, paperFee{maker: mustParsePaper(maker), taker: mustParsePaper(taker)})
+}
+
+func (p *PaperTrader) setTradeFee(symbol string, fee paperFee) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fees[symbol] = fee
+}
+
+// LoadTradeFees fetches the commission rates of the account of c from
+// TradeFeeService. c must not be routed through the simulator's Transport.
+func (p *PaperTrader) LoadTradeFees(ctx context.Context, c *Client) error {
+	res, err := c.NewTradeFeeService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	for _, fee := range res {
+		maker, ok := parsePaperRat(fee.MakerCommission)
+		if !ok {
+			return fmt.Errorf("invalid maker commission %q of %s", fee.MakerCommission, fee.Symbol)
+		}
+		taker, ok := parsePaperRat(fee.TakerCommission)
+		if !ok {
+			return fmt.Errorf("invalid taker commission %q of %s", fee.TakerCommission, fee.Symbol)
+		}
+		p.setTradeFee(fee.Symbol, paperFee{maker: maker, taker: taker})
+	}
+	return nil
+}
+
+// OnUserData registers a handler for the synthetic executionReport and
+// outboundAccountPosition events of simulated orders
+func (p *PaperTrader) OnUserData(handler WsUserDataHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = append(p.handlers, handler)
+}
+
+// DepthHandler returns a handler applying diff depth events to the book
+func (p *PaperTrader) DepthHandler() WsDepthHandler {
+	return func(event *WsDepthEvent) {
+		p.update(event.Symbol, func(b *paperBook) {
+			for _, l := range event.Bids {
+				b.bids = setLevel(b.bids, l, func(a, b *big.Rat) bool { return a.Cmp(b) > 0 })
+			}
+			for _, l := range event.Asks {
+				b.asks = setLevel(b.asks, l, func(a, b *big.Rat) bool { return a.Cmp(b) < 0 })
+			}
+		})
+	}
+}
+
+// BookTickerHandler returns a handler replacing the book with the best bid
+// and ask of each book ticker event
+func (p *PaperTrader) BookTickerHandler() WsBookTickerHandler {
+	return func(event *WsBookTickerEvent) {
+		p.update(event.Symbol, func(b *paperBook) {
+			b.bids = tickerLevel(event.BestBidPrice, event.BestBidQty)
+			b.asks = tickerLevel(event.BestAskPrice, event.BestAskQty)
+		})
+	}
+}
+
+// LoadOrderBook replaces the book of symbol with a REST snapshot, to seed a
+// book fed by diff depth events
+func (p *PaperTrader) LoadOrderBook(symbol string, snapshot *OrderBookResponse) {
+	p.update(symbol, func(b *paperBook) {
+		b.bids = snapshotLevels(snapshot.Bids)
+		b.asks = snapshotLevels(snapshot.Asks)
+	})
+}
+
+func setLevel(levels []paperLevel, l PriceLevel, better func(a, b *big.Rat) bool) []paperLevel {
+	price, ok := parsePaperRat(l.Price)
+	if !ok {
+		return levels
+	}
+	qty, ok := parsePaperRat(l.Quantity)
+	if !ok {
+		return levels
+	}
+	i := sort.Search(len(levels), func(i int) bool { return !better(levels[i].price, price) })
+	if i < len(levels) && levels[i].price.Cmp(price) == 0 {
+		if qty.Sign() == 0 {
+			return append(levels[:i], levels[i+1:]...)
+		}
+		levels[i].qty = qty
+		return levels
+	}
+	if qty.Sign() == 0 {
+		return levels
+	}
+	levels = append(levels, paperLevel{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = paperLevel{price: price, qty: qty}
+	return levels
+}
+
+func tickerLevel(price, qty string) []paperLevel {
+	p, ok := parsePaperRat(price)
+	if !ok || p.Sign() == 0 {
+		return nil
+	}
+	q, ok := parsePaperRat(qty)
+	if !ok || q.Sign() == 0 {
+		return nil
+	}
+	return []paperLevel{{price: p, qty: q}}
+}
+
+// snapshotLevels converts the levels of a REST snapshot back to the decimals
+// they were parsed from
+func snapshotLevels(rows [][]*big.Float) []paperLevel {
+	levels := make([]paperLevel, 0, len(rows))
+	for _, row := range rows {
+		if len(row) < 2 || row[0] == nil || row[1] == nil {
+			continue
+		}
+		price, ok := parsePaperRat(row[0].Text('f', -1))
+		if !ok {
+			continue
+		}
+		qty, ok := parsePaperRat(row[1].Text('f', -1))
+		if !ok {
+			continue
+		}
+		levels = append(levels, paperLevel{price: price, qty: qty})
+	}
+	return levels
+}
+
+// update changes the book of symbol and executes the orders it triggers
+func (p *PaperTrader) update(symbol string, fn func(b *paperBook)) {
+	p.mu.Lock()
+	b, ok := p.books[symbol]
+	if !ok {
+		b = &paperBook{}
+		p.books[symbol] = b
+	}
+	fn(b)
+	var events []*WsUserDataEvent
+	ids := make([]int64, 0, len(p.orders))
+	for id, o := range p.orders {
+		if o.symbol == symbol && o.isOpen() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		events = append(events, p.trigger(p.orders[id])...)
+	}
+	p.mu.Unlock()
+	p.emit(events)
+}
+
+// trigger activates stop orders and fills resting orders the book crosses
+func (p *PaperTrader) trigger(o *paperOrder) []*WsUserDataEvent {
+	var events []*WsUserDataEvent
+	if o.working {
+		events = p.match(o, true)
+	} else if stopTriggered(o, p.books[o.symbol]) {
+		o.working = true
+		events = p.match(o, false)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	return append(events, p.position(o.symbol))
+}
+
+func stopTriggered(o *paperOrder, b *paperBook) bool {
+	if o.side == "SELL" {
+		return len(b.bids) > 0 && b.bids[0].price.Cmp(o.stopPrice) <= 0
+	}
+	return len(b.asks) > 0 && b.asks[0].price.Cmp(o.stopPrice) >= 0
+}
+
+// crossing returns the opposite levels o would trade against
+func (p *PaperTrader) crossing(o *paperOrder) []paperLevel {
+	b, ok := p.books[o.symbol]
+	if !ok {
+		return nil
+	}
+	levels := b.asks
+	if o.side == "SELL" {
+		levels = b.bids
+	}
+	if o.orderType == "MARKET" {
+		return levels
+	}
+	n := 0
+	for n < len(levels) && (o.side == "BUY" && levels[n].price.Cmp(o.price) <= 0 || o.side == "SELL" && levels[n].price.Cmp(o.price) >= 0) {
+		n++
+	}
+	return levels[:n]
+}
+
+// match fills o against the crossing levels. Resting orders fill as maker at
+// their own price, incoming orders as taker at the book prices.
+func (p *PaperTrader) match(o *paperOrder, resting bool) []*WsUserDataEvent {
+	var events []*WsUserDataEvent
+	levels := p.crossing(o)
+	available := new(big.Rat)
+	for _, l := range levels {
+		available.Add(available, l.qty)
+	}
+	if !resting && o.timeInForce == "FOK" && available.Cmp(o.remaining()) < 0 {
+		p.unlock(o)
+		o.status = "EXPIRED"
+		return append(events, p.report(o, "EXPIRED", nil))
+	}
+	for _, l := range levels {
+		if o.remaining().Sign() <= 0 {
+			break
+		}
+		price := l.price
+		if resting {
+			price = o.price
+		}
+		fill := p.execute(o, minPaper(o.remaining(), l.qty), price, resting)
+		events = append(events, p.report(o, "TRADE", fill))
+	}
+	if o.remaining().Sign() <= 0 {
+		return events
+	}
+	if o.orderType == "MARKET" || o.timeInForce == "IOC" || o.timeInForce == "FOK" {
+		p.unlock(o)
+		o.status = "EXPIRED"
+		events = append(events, p.report(o, "EXPIRED", nil))
+	}
+	return events
+}
+
+// execute books a fill and settles balances, charging the commission on the
+// received asset
+func (p *PaperTrader) execute(o *paperOrder, qty, price *big.Rat, isMaker bool) *paperFill {
+	sym := p.symbols[o.symbol]
+	rate := p.fees[o.symbol].rate(isMaker)
+	quoteQty := new(big.Rat).Mul(qty, price)
+	fill := paperFill{price: price, qty: qty, quoteQty: quoteQty, isMaker: isMaker}
+	if o.side == "BUY" {
+		if o.orderType == "MARKET" {
+			p.sub(p.free, sym.quote, quoteQty)
+		} else {
+			// release the funds reserved at the limit price
+			reserved := new(big.Rat).Mul(qty, o.price)
+			p.sub(p.locked, sym.quote, reserved)
+			p.add(p.free, sym.quote, reserved.Sub(reserved, quoteQty))
+		}
+		fill.commission, fill.commissionAsset = new(big.Rat).Mul(qty, rate), sym.base
+		p.add(p.free, sym.base, new(big.Rat).Sub(qty, fill.commission))
+	} else {
+		if o.orderType == "MARKET" {
+			p.sub(p.free, sym.base, qty)
+		} else {
+			p.sub(p.locked, sym.base, qty)
+		}
+		fill.commission, fill.commissionAsset = new(big.Rat).Mul(quoteQty, rate), sym.quote
+		p.add(p.free, sym.quote, new(big.Rat).Sub(quoteQty, fill.commission))
+	}
+	p.nextTradeId++
+	fill.tradeId = p.nextTradeId
+	o.executedQty.Add(o.executedQty, qty)
+	o.cumQuote.Add(o.cumQuote, quoteQty)
+	o.updateTime = p.timestamp()
+	o.fills = append(o.fills, fill)
+	o.status = "PARTIALLY_FILLED"
+	if o.remaining().Sign() <= 0 {
+		o.status = "FILLED"
+	}
+	return &o.fills[len(o.fills)-1]
+}
+
+// unlock returns the funds reserved for the unfilled part of o
+func (p *PaperTrader) unlock(o *paperOrder) {
+	if o.orderType == "MARKET" {
+		return
+	}
+	sym := p.symbols[o.symbol]
+	asset, amount := sym.base, o.remaining()
+	if o.side == "BUY" {
+		asset, amount = sym.quote, amount.Mul(amount, o.price)
+	}
+	p.sub(p.locked, asset, amount)
+	p.add(p.free, asset, amount)
+}
+
+// bal returns the balance of asset in m, adding it if missing
+func (p *PaperTrader) bal(m map[string]*big.Rat, asset string) *big.Rat {
+	v, ok := m[asset]
+	if !ok {
+		v = new(big.Rat)
+		m[asset] = v
+	}
+	return v
+}
+
+func (p *PaperTrader) add(m map[string]*big.Rat, asset string, amount *big.Rat) {
+	v := p.bal(m, asset)
+	v.Add(v, amount)
+}
+
+func (p *PaperTrader) sub(m map[string]*big.Rat, asset string, amount *big.Rat) {
+	v := p.bal(m, asset)
+	v.Sub(v, amount)
+}
+
+func (p *PaperTrader) timestamp() int64 {
+	return p.now().UnixNano() / int64(time.Millisecond)
+}
+
+// report builds the executionReport of o followed by the balances it touched
+func (p *PaperTrader) report(o *paperOrder, executionType string, fill *paperFill) *WsUserDataEvent {
+	now := p.timestamp()
+	event := &WsUserDataEvent{
+		Event:             UserDataEventTypeExecutionReport,
+		Time:              now,
+		TransactionTime:   now,
+		AccountUpdateTime: now,
+		OrderUpdate: WsOrderUpdate{
+			Symbol:            o.symbol,
+			ClientOrderId:     o.clientOrderId,
+			Side:              o.side,
+			Type:              o.orderType,
+			TimeInForce:       TimeInForceType(o.timeInForce),
+			Volume:            formatPaper(o.origQty),
+			Price:             formatPaper(o.price),
+			StopPrice:         formatPaper(o.stopPrice),
+			OrderListId:       -1,
+			ExecutionType:     executionType,
+			Status:            o.status,
+			RejectReason:      "NONE",
+			Id:                o.orderId,
+			LatestVolume:      formatPaper(nil),
+			FilledVolume:      formatPaper(o.executedQty),
+			LatestPrice:       formatPaper(nil),
+			FeeCost:           formatPaper(nil),
+			TransactionTime:   now,
+			TradeId:           -1,
+			IsInOrderBook:     o.working && o.isOpen(),
+			CreateTime:        o.time,
+			FilledQuoteVolume: formatPaper(o.cumQuote),
+			LatestQuoteVolume: formatPaper(nil),
+			QuoteVolume:       formatPaper(o.quoteOrderQty),
+			WorkingTime:       o.time,
+		},
+	}
+	if fill != nil {
+		u := &event.OrderUpdate
+		u.LatestVolume = formatPaper(fill.qty)
+		u.LatestPrice = formatPaper(fill.price)
+		u.LatestQuoteVolume = formatPaper(fill.quoteQty)
+		u.FeeCost = formatPaper(fill.commission)
+		u.FeeAsset = fill.commissionAsset
+		u.TradeId = fill.tradeId
+		u.IsMaker = fill.isMaker
+	}
+	return event
+}
+
+// position builds the outboundAccountPosition of the assets of symbol
+func (p *PaperTrader) position(symbol string) *WsUserDataEvent {
+	sym := p.symbols[symbol]
+	now := p.timestamp()
+	event := &WsUserDataEvent{
+		Event:             UserDataEventTypeOutboundAccountPosition,
+		Time:              now,
+		AccountUpdateTime: now,
+	}
+	for _, asset := range []string{sym.base, sym.quote} {
+		event.AccountUpdate.WsAccountUpdates = append(event.AccountUpdate.WsAccountUpdates, WsAccountUpdate{
+			Asset:  asset,
+			Free:   formatPaper(p.free[asset]),
+			Locked: formatPaper(p.locked[asset]),
+		})
+	}
+	return event
+}
+
+// emit delivers events to the user data handlers, outside of the lock so that
+// handlers may place orders
+func (p *PaperTrader) emit(events []*WsUserDataEvent) {
+	if len(events) == 0 {
+		return
+	}
+	p.mu.Lock()
+	handlers := append([]WsUserDataHandler(nil), p.handlers...)
+	p.mu.Unlock()
+	for _, event := range events {
+		for _, h := range handlers {
+			h(event)
+		}
+	}
+}
+
+func formatPaper(v *big.Rat) string {
+	if v == nil {
+		return "0.00000000"
+	}
+	return v.FloatString(8)
+}
+
+// parsePaperRat parses a non-negative decimal number
+func parsePaperRat(s string) (*big.Rat, bool) {
+	v, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || v.Sign() < 0 {
+		return nil, false
+	}
+	return v, true
+}
+
+func mustParsePaper(s string) *big.Rat {
+	v, ok := parsePaperRat(s)
+	if !ok {
+		panic(fmt.Sprintf("binance_connector: invalid paper amount %q", s))
+	}
+	return v
+}
+
+func minPaper(a, b *big.Rat) *big.Rat {
+	if a.Cmp(b) <= 0 {
+		return new(big.Rat).Set(a)
+	}
+	return new(big.Rat).Set(b)
+}
+
+// paperError is returned like a REST error, with status 400
+type paperError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"msg"`
+}
+
+var (
+	errPaperMandatoryParam      = &paperError{Code: -1102, Message: "Mandatory parameter was not sent, was empty/null, or malformed."}
+	errPaperInvalidSymbol       = &paperError{Code: -1121, Message: "Invalid symbol."}
+	errPaperInsufficientBalance = &paperError{Code: -2010, Message: "Account has insufficient balance for requested action."}
+	errPaperUnknownOrder        = &paperError{Code: -2011, Message: "Unknown order sent."}
+	errPaperNoSuchOrder         = &paperError{Code: -2013, Message: "Order does not exist."}
+	errPaperWouldTrigger        = &paperError{Code: -2010, Message: "Stop price would trigger immediately."}
+)
+
+// Transport returns an http.RoundTripper answering order and account requests
+// from the simulator and passing every other request to next. If next is
+// nil, http.DefaultTransport is used.
+func (p *PaperTrader) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		route := req.Method + " " + req.URL.Path
+		switch route {
+		case "POST /api/v3/order", "POST /api/v3/order/test", "GET /api/v3/order", "DELETE /api/v3/order",
+			"GET /api/v3/openOrders", "DELETE /api/v3/openOrders", "GET /api/v3/account":
+		default:
+			return next.RoundTrip(req)
+		}
+		params, err := paperParams(req)
+		if err != nil {
+			return nil, err
+		}
+		var res interface{}
+		var apiErr *paperError
+		var events []*WsUserDataEvent
+		switch route {
+		case "POST /api/v3/order":
+			res, events, apiErr = p.placeOrder(params)
+		case "POST /api/v3/order/test":
+			_, apiErr = p.newOrder(params)
+			res = struct{}{}
+		case "GET /api/v3/order":
+			res, apiErr = p.getOrder(params)
+		case "DELETE /api/v3/order":
+			res, events, apiErr = p.cancelOrder(params)
+		case "GET /api/v3/openOrders":
+			res = p.openOrders(params.Get("symbol"))
+		case "DELETE /api/v3/openOrders":
+			res, events, apiErr = p.cancelOpenOrders(params.Get("symbol"))
+		case "GET /api/v3/account":
+			res = p.account()
+		}
+		p.emit(events)
+		status := http.StatusOK
+		if apiErr != nil {
+			status, res = http.StatusBadRequest, apiErr
+		}
+		data, err := json.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(data)),
+			Request:    req,
+		}, nil
+	})
+}
+
+func paperParams(req *http.Request) (url.Values, error) {
+	params := req.URL.Query()
+	if req.Body == nil {
+		return params, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range form {
+		params[k] = append(params[k], v...)
+	}
+	return params, nil
+}
+
+func parsePaperParam(params url.Values, key string) (*big.Rat, *paperError) {
+	v := params.Get(key)
+	if v == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := parsePaperRat(v)
+	if !ok {
+		return nil, errPaperMandatoryParam
+	}
+	return r, nil
+}
+
+// newOrder validates the parameters of a new order
+func (p *PaperTrader) newOrder(params url.Values) (*paperOrder, *paperError) {
+	o := &paperOrder{
+		symbol:        params.Get("symbol"),
+		clientOrderId: params.Get("newClientOrderId"),
+		side:          params.Get("side"),
+		orderType:     params.Get("type"),
+		timeInForce:   params.Get("timeInForce"),
+		executedQty:   new(big.Rat),
+		cumQuote:      new(big.Rat),
+		status:        "NEW",
+	}
+	var apiErr *paperError
+	if o.price, apiErr = parsePaperParam(params, "price"); apiErr != nil {
+		return nil, apiErr
+	}
+	if o.stopPrice, apiErr = parsePaperParam(params, "stopPrice"); apiErr != nil {
+		return nil, apiErr
+	}
+	if o.origQty, apiErr = parsePaperParam(params, "quantity"); apiErr != nil {
+		return nil, apiErr
+	}
+	if o.quoteOrderQty, apiErr = parsePaperParam(params, "quoteOrderQty"); apiErr != nil {
+		return nil, apiErr
+	}
+	p.mu.Lock()
+	_, ok := p.symbols[o.symbol]
+	p.mu.Unlock()
+	if !ok {
+		return nil, errPaperInvalidSymbol
+	}
+	if o.side != "BUY" && o.side != "SELL" {
+		return nil, errPaperMandatoryParam
+	}
+	switch o.orderType {
+	case "LIMIT", "STOP_LOSS_LIMIT":
+		if o.price.Sign() == 0 || o.origQty.Sign() == 0 || o.timeInForce == "" {
+			return nil, errPaperMandatoryParam
+		}
+		if o.orderType == "STOP_LOSS_LIMIT" && o.stopPrice.Sign() == 0 {
+			return nil, errPaperMandatoryParam
+		}
+	case "MARKET":
+		if o.origQty.Sign() == 0 && o.quoteOrderQty.Sign() == 0 {
+			return nil, errPaperMandatoryParam
+		}
+	default:
+		return nil, &paperError{Code: -1116, Message: "Invalid orderType."}
+	}
+	return o, nil
+}
+
+func (p *PaperTrader) placeOrder(params url.Values) (interface{}, []*WsUserDataEvent, *paperError) {
+	o, apiErr := p.newOrder(params)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sym := p.symbols[o.symbol]
+	o.time = p.timestamp()
+	o.updateTime = o.time
+	o.working = o.orderType != "STOP_LOSS_LIMIT"
+
+	switch o.orderType {
+	case "MARKET":
+		if o.origQty.Sign() == 0 {
+			o.origQty = p.marketQtyForQuote(o)
+		}
+		if o.side == "SELL" && p.bal(p.free, sym.base).Cmp(o.origQty) < 0 ||
+			o.side == "BUY" && p.bal(p.free, sym.quote).Cmp(p.marketCost(o)) < 0 {
+			return nil, nil, errPaperInsufficientBalance
+		}
+	default:
+		if o.orderType == "STOP_LOSS_LIMIT" && stopTriggered(o, p.bookOf(o.symbol)) {
+			return nil, nil, errPaperWouldTrigger
+		}
+		asset, amount := sym.base, o.origQty
+		if o.side == "BUY" {
+			asset, amount = sym.quote, new(big.Rat).Mul(o.origQty, o.price)
+		}
+		if p.bal(p.free, asset).Cmp(amount) < 0 {
+			return nil, nil, errPaperInsufficientBalance
+		}
+		p.sub(p.free, asset, amount)
+		p.add(p.locked, asset, amount)
+	}
+
+	p.nextId++
+	o.orderId = p.nextId
+	if o.clientOrderId == "" {
+		o.clientOrderId = fmt.Sprintf("paper%d", o.orderId)
+	}
+	p.orders[o.orderId] = o
+	events := []*WsUserDataEvent{p.report(o, "NEW", nil)}
+	if o.working {
+		events = append(events, p.match(o, false)...)
+	}
+	if o.orderType == "MARKET" && o.executedQty.Sign() == 0 && o.status != "EXPIRED" {
+		o.status = "EXPIRED"
+		events = append(events, p.report(o, "EXPIRED", nil))
+	}
+	events = append(events, p.position(o.symbol))
+
+	respType := params.Get("newOrderRespType")
+	if respType == "" {
+		respType = "ACK"
+		if o.orderType == "LIMIT" || o.orderType == "MARKET" {
+			respType = "FULL"
+		}
+	}
+	return paperPlaceResult(o, respType), events, nil
+}
+
+func (p *PaperTrader) bookOf(symbol string) *paperBook {
+	if b, ok := p.books[symbol]; ok {
+		return b
+	}
+	return &paperBook{}
+}
+
+// marketQtyForQuote converts quoteOrderQty into the base quantity it trades
+// at current book prices
+func (p *PaperTrader) marketQtyForQuote(o *paperOrder) *big.Rat {
+	qty, left := new(big.Rat), new(big.Rat).Set(o.quoteOrderQty)
+	for _, l := range p.crossing(o) {
+		if left.Sign() <= 0 {
+			break
+		}
+		take := minPaper(l.qty, new(big.Rat).Quo(left, l.price))
+		qty.Add(qty, take)
+		left.Sub(left, take.Mul(take, l.price))
+	}
+	return qty
+}
+
+// marketCost returns the quote amount a market buy spends at current prices
+func (p *PaperTrader) marketCost(o *paperOrder) *big.Rat {
+	cost, left := new(big.Rat), new(big.Rat).Set(o.origQty)
+	for _, l := range p.crossing(o) {
+		if left.Sign() <= 0 {
+			break
+		}
+		take := minPaper(l.qty, left)
+		left.Sub(left, take)
+		cost.Add(cost, take.Mul(take, l.price))
+	}
+	return cost
+}
+
+func paperPlaceResult(o *paperOrder, respType string) map[string]interface{} {
+	res := map[string]interface{}{
+		"symbol":        o.symbol,
+		"orderId":       o.orderId,
+		"orderListId":   -1,
+		"clientOrderId": o.clientOrderId,
+		"transactTime":  o.time,
+	}
+	if respType == "ACK" {
+		return res
+	}
+	res["price"] = formatPaper(o.price)
+	res["origQty"] = formatPaper(o.origQty)
+	res["executedQty"] = formatPaper(o.executedQty)
+	res["cummulativeQuoteQty"] = formatPaper(o.cumQuote)
+	res["status"] = o.status
+	res["timeInForce"] = o.timeInForce
+	res["type"] = o.orderType
+	res["side"] = o.side
+	res["workingTime"] = o.time
+	res["selfTradePreventionMode"] = "NONE"
+	if o.stopPrice.Sign() != 0 {
+		res["stopPrice"] = formatPaper(o.stopPrice)
+	}
+	if respType == "FULL" {
+		fills := make([]map[string]interface{}, 0, len(o.fills))
+		for _, f := range o.fills {
+			fills = append(fills, map[string]interface{}{
+				"price":           formatPaper(f.price),
+				"qty":             formatPaper(f.qty),
+				"commission":      formatPaper(f.commission),
+				"commissionAsset": f.commissionAsset,
+				"tradeId":         f.tradeId,
+			})
+		}
+		res["fills"] = fills
+	}
+	return res
+}
+
+func paperOrderFields(o *paperOrder) map[string]interface{} {
+	return map[string]interface{}{
+		"symbol":                  o.symbol,
+		"orderId":                 o.orderId,
+		"orderListId":             -1,
+		"clientOrderId":           o.clientOrderId,
+		"price":                   formatPaper(o.price),
+		"origQty":                 formatPaper(o.origQty),
+		"executedQty":             formatPaper(o.executedQty),
+		"cumulativeQuoteQty":      formatPaper(o.cumQuote),
+		"status":                  o.status,
+		"timeInForce":             o.timeInForce,
+		"type":                    o.orderType,
+		"side":                    o.side,
+		"stopPrice":               formatPaper(o.stopPrice),
+		"time":                    o.time,
+		"updateTime":              o.updateTime,
+		"isWorking":               o.working,
+		"workingTime":             o.time,
+		"origQuoteOrderQty":       formatPaper(o.quoteOrderQty),
+		"selfTradePreventionMode": "NONE",
+	}
+}
+
+// find looks an order up by orderId or origClientOrderId
+func (p *PaperTrader) find(params url.Values) *paperOrder {
+	symbol := params.Get("symbol")
+	if id, err := strconv.ParseInt(params.Get("orderId"), 10, 64); err == nil {
+		if o, ok := p.orders[id]; ok && o.symbol == symbol {
+			return o
+		}
+		return nil
+	}
+	clientOrderId := params.Get("origClientOrderId")
+	for _, o := range p.orders {
+		if o.symbol == symbol && o.clientOrderId == clientOrderId {
+			return o
+		}
+	}
+	return nil
+}
+
+func (p *PaperTrader) getOrder(params url.Values) (interface{}, *paperError) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	o := p.find(params)
+	if o == nil {
+		return nil, errPaperNoSuchOrder
+	}
+	return paperOrderFields(o), nil
+}
+
+func (p *PaperTrader) cancelOrder(params url.Values) (interface{}, []*WsUserDataEvent, *paperError) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	o := p.find(params)
+	if o == nil || !o.isOpen() {
+		return nil, nil, errPaperUnknownOrder
+	}
+	events := p.cancel(o)
+	return paperCancelFields(o), events, nil
+}
+
+func (p *PaperTrader) cancel(o *paperOrder) []*WsUserDataEvent {
+	p.unlock(o)
+	o.status = "CANCELED"
+	o.updateTime = p.timestamp()
+	return []*WsUserDataEvent{p.report(o, "CANCELED", nil), p.position(o.symbol)}
+}
+
+func paperCancelFields(o *paperOrder) map[string]interface{} {
+	res := paperOrderFields(o)
+	res["origClientOrderId"] = o.clientOrderId
+	return res
+}
+
+func (p *PaperTrader) cancelOpenOrders(symbol string) (interface{}, []*WsUserDataEvent, *paperError) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var events []*WsUserDataEvent
+	res := make([]map[string]interface{}, 0)
+	for _, o := range p.sortedOrders(symbol) {
+		if o.isOpen() {
+			events = append(events, p.cancel(o)...)
+			res = append(res, paperCancelFields(o))
+		}
+	}
+	if len(res) == 0 {
+		return nil, nil, errPaperUnknownOrder
+	}
+	return res, events, nil
+}
+
+func (p *PaperTrader) openOrders(symbol string) interface{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	res := make([]map[string]interface{}, 0)
+	for _, o := range p.sortedOrders(symbol) {
+		if o.isOpen() {
+			res = append(res, paperOrderFields(o))
+		}
+	}
+	return res
+}
+
+func (p *PaperTrader) sortedOrders(symbol string) []*paperOrder {
+	res := make([]*paperOrder, 0, len(p.orders))
+	for _, o := range p.orders {
+		if symbol == "" || o.symbol == symbol {
+			res = append(res, o)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].orderId < res[j].orderId })
+	return res
+}
+
+func (p *PaperTrader) account() interface{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	assets := make([]string, 0, len(p.free))
+	for asset := range p.free {
+		assets = append(assets, asset)
+	}
+	for asset := range p.locked {
+		if _, ok := p.free[asset]; !ok {
+			assets = append(assets, asset)
+		}
+	}
+	sort.Strings(assets)
+	balances := make([]Balance, 0, len(assets))
+	for _, asset := range assets {
+		balances = append(balances, Balance{
+			Asset:  asset,
+			Free:   formatPaper(p.free[asset]),
+			Locked: formatPaper(p.locked[asset]),
+		})
+	}
+	return &AccountResponse{
+		CanTrade:    true,
+		UpdateTime:  uint64(p.timestamp()),
+		AccountType: "SPOT",
+		Balances:    balances,
+		Permissions: []string{"SPOT"},
+	}
+}
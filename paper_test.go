@@ -0,0 +1,152 @@
+package binance_connector
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type paperTestSuite struct {
+	suite.Suite
+	paper  *PaperTrader
+	client *Client
+	events []*WsUserDataEvent
+}
+
+func TestPaperTrader(t *testing.T) {
+	suite.Run(t, new(paperTestSuite))
+}
+
+func (s *paperTestSuite) SetupTest() {
+	s.paper = NewPaperTrader()
+	s.paper.AddSymbol("BTCUSDT", "BTC", "USDT")
+	s.paper.SetBalance("USDT", "10000")
+	s.paper.SetBalance("BTC", "1")
+	s.paper.SetTradeFee("BTCUSDT", "0.001", "0.002")
+	s.events = nil
+	s.paper.OnUserData(func(event *WsUserDataEvent) {
+		s.events = append(s.events, event)
+	})
+	s.client = NewClient("", "", "https://api.binance.com")
+	s.client.HTTPClient = &http.Client{Transport: s.paper.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		s.FailNow("unexpected pass-through request", req.URL.String())
+		return nil, nil
+	}))}
+	s.paper.DepthHandler()(&WsDepthEvent{
+		Symbol: "BTCUSDT",
+		Bids:   []Bid{{Price: "99", Quantity: "1"}, {Price: "98", Quantity: "2"}},
+		Asks:   []Ask{{Price: "101", Quantity: "0.5"}, {Price: "102", Quantity: "2"}},
+	})
+}
+
+func (s *paperTestSuite) balances() map[string][2]string {
+	account, err := s.client.NewGetAccountService().Do(newContext())
+	s.Require().NoError(err)
+	res := make(map[string][2]string)
+	for _, b := range account.Balances {
+		res[b.Asset] = [2]string{b.Free, b.Locked}
+	}
+	return res
+}
+
+func (s *paperTestSuite) TestMarketOrder() {
+	r := s.Require()
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side("BUY").Type("MARKET").
+		Quantity(1).Do(newContext())
+	r.NoError(err)
+	order := res.(*CreateOrderResponseFULL)
+	r.Equal("FILLED", order.Status)
+	r.Len(order.Fills, 2)
+	r.Equal("101.00000000", order.Fills[0].Price)
+	r.Equal("0.00100000", order.Fills[0].Commission)
+	r.Equal("BTC", order.Fills[0].CommissionAsset)
+	r.Equal("101.50000000", order.CumulativeQuoteQty)
+
+	r.Equal([2]string{"9898.50000000", "0.00000000"}, s.balances()["USDT"])
+	r.Equal([2]string{"1.99800000", "0.00000000"}, s.balances()["BTC"])
+
+	r.Len(s.events, 4)
+	r.Equal(UserDataEventTypeExecutionReport, s.events[0].Event)
+	r.Equal("NEW", s.events[0].OrderUpdate.ExecutionType)
+	r.Equal("TRADE", s.events[1].OrderUpdate.ExecutionType)
+	r.Equal("0.50000000", s.events[1].OrderUpdate.LatestVolume)
+	r.Equal("FILLED", s.events[2].OrderUpdate.Status)
+	r.Equal(UserDataEventTypeOutboundAccountPosition, s.events[3].Event)
+}
+
+func (s *paperTestSuite) TestLimitOrderRestsAndFills() {
+	r := s.Require()
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side("BUY").Type("LIMIT").
+		TimeInForce("GTC").Price(100).Quantity(2).Do(newContext())
+	r.NoError(err)
+	order := res.(*CreateOrderResponseFULL)
+	r.Equal("NEW", order.Status)
+	r.Equal([2]string{"9800.00000000", "200.00000000"}, s.balances()["USDT"])
+
+	open, err := s.client.NewGetOpenOrdersService().Symbol("BTCUSDT").Do(newContext())
+	r.NoError(err)
+	r.Len(open, 1)
+
+	// the ask moves through the limit price
+	s.paper.DepthHandler()(&WsDepthEvent{
+		Symbol: "BTCUSDT",
+		Asks:   []Ask{{Price: "100", Quantity: "0.5"}},
+	})
+	got, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderId(order.OrderId).Do(newContext())
+	r.NoError(err)
+	r.Equal("PARTIALLY_FILLED", got.Status)
+	r.Equal("0.50000000", got.ExecutedQty)
+	last := s.events[len(s.events)-2].OrderUpdate
+	r.True(last.IsMaker)
+	r.Equal("0.00050000", last.FeeCost)
+
+	canceled, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderId(order.OrderId).Do(newContext())
+	r.NoError(err)
+	r.Equal("CANCELED", canceled.Status)
+	r.Equal([2]string{"9950.00000000", "0.00000000"}, s.balances()["USDT"])
+}
+
+func (s *paperTestSuite) TestStopLossLimit() {
+	r := s.Require()
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side("SELL").Type("STOP_LOSS_LIMIT").
+		TimeInForce("GTC").StopPrice(95).Price(94).Quantity(1).Do(newContext())
+	r.NoError(err)
+	order := res.(*CreateOrderResponseACK)
+
+	s.paper.BookTickerHandler()(&WsBookTickerEvent{
+		Symbol:       "BTCUSDT",
+		BestBidPrice: "95",
+		BestBidQty:   "3",
+		BestAskPrice: "96",
+		BestAskQty:   "3",
+	})
+	got, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderId(order.OrderId).Do(newContext())
+	r.NoError(err)
+	r.Equal("FILLED", got.Status)
+	r.Equal("95.00000000", got.CumulativeQuoteQty)
+	r.Equal([2]string{"10094.81000000", "0.00000000"}, s.balances()["USDT"])
+
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side("SELL").Type("LIMIT").
+		TimeInForce("GTC").Price(200).Quantity(5).Do(newContext())
+	r.Error(err)
+}
+
+func (s *paperTestSuite) TestExactBalances() {
+	r := s.Require()
+	s.paper.SetBalance("BTC", "0.3")
+	for i := 0; i < 3; i++ {
+		_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side("SELL").Type("LIMIT").
+			TimeInForce("GTC").Price(200).Quantity(0.1).Do(newContext())
+		r.NoError(err)
+	}
+	r.Equal([2]string{"0.00000000", "0.30000000"}, s.balances()["BTC"])
+
+	s.paper.DepthHandler()(&WsDepthEvent{
+		Symbol: "BTCUSDT",
+		Bids:   []Bid{{Price: "200", Quantity: "1"}},
+	})
+	r.Equal([2]string{"0.00000000", "0.00000000"}, s.balances()["BTC"])
+	r.Equal([2]string{"10059.94000000", "0.00000000"}, s.balances()["USDT"])
+	r.Panics(func() { s.paper.SetBalance("BTC", "1e") })
+}
@@ -0,0 +1,607 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// PortfolioMarginClient define Portfolio Margin API client. Requests are signed
+// and sent by Client, so Debug, TimeOffset and HTTPClient are set on it.
+type PortfolioMarginClient struct {
+	Client *Client
+}
+
+// NewPortfolioMarginClient create a Portfolio Margin client. The baseURL is
+// optional and defaults to "https://papi.binance.com".
+func NewPortfolioMarginClient(apiKey string, secretKey string, baseURL ...string) *PortfolioMarginClient {
+	url := "https://papi.binance.com"
+
+	if len(baseURL) > 0 {
+		url = baseURL[0]
+	}
+
+	return &PortfolioMarginClient{
+		Client: NewClient(apiKey, secretKey, url),
+	}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginPingService() *PortfolioMarginPingService {
+	return &PortfolioMarginPingService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginAccountService() *PortfolioMarginAccountService {
+	return &PortfolioMarginAccountService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginBalanceService() *PortfolioMarginBalanceService {
+	return &PortfolioMarginBalanceService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginUMPositionRiskService() *PortfolioMarginUMPositionRiskService {
+	return &PortfolioMarginUMPositionRiskService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginCMPositionRiskService() *PortfolioMarginCMPositionRiskService {
+	return &PortfolioMarginCMPositionRiskService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginLoanService() *PortfolioMarginLoanService {
+	return &PortfolioMarginLoanService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginRepayLoanService() *PortfolioMarginRepayLoanService {
+	return &PortfolioMarginRepayLoanService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginRepayFuturesNegativeBalanceService() *PortfolioMarginRepayFuturesNegativeBalanceService {
+	return &PortfolioMarginRepayFuturesNegativeBalanceService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginAutoCollectionService() *PortfolioMarginAutoCollectionService {
+	return &PortfolioMarginAutoCollectionService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginAssetCollectionService() *PortfolioMarginAssetCollectionService {
+	return &PortfolioMarginAssetCollectionService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginUMNewOrderService() *PortfolioMarginUMNewOrderService {
+	return &PortfolioMarginUMNewOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginUMCancelOrderService() *PortfolioMarginUMCancelOrderService {
+	return &PortfolioMarginUMCancelOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginUMQueryOrderService() *PortfolioMarginUMQueryOrderService {
+	return &PortfolioMarginUMQueryOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginUMOpenOrdersService() *PortfolioMarginUMOpenOrdersService {
+	return &PortfolioMarginUMOpenOrdersService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginCMNewOrderService() *PortfolioMarginCMNewOrderService {
+	return &PortfolioMarginCMNewOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginCMCancelOrderService() *PortfolioMarginCMCancelOrderService {
+	return &PortfolioMarginCMCancelOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginCMQueryOrderService() *PortfolioMarginCMQueryOrderService {
+	return &PortfolioMarginCMQueryOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginCMOpenOrdersService() *PortfolioMarginCMOpenOrdersService {
+	return &PortfolioMarginCMOpenOrdersService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginMarginNewOrderService() *PortfolioMarginMarginNewOrderService {
+	return &PortfolioMarginMarginNewOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginMarginCancelOrderService() *PortfolioMarginMarginCancelOrderService {
+	return &PortfolioMarginMarginCancelOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginMarginQueryOrderService() *PortfolioMarginMarginQueryOrderService {
+	return &PortfolioMarginMarginQueryOrderService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginCreateListenKeyService() *PortfolioMarginCreateListenKeyService {
+	return &PortfolioMarginCreateListenKeyService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginPingListenKeyService() *PortfolioMarginPingListenKeyService {
+	return &PortfolioMarginPingListenKeyService{c: p.Client}
+}
+
+func (p *PortfolioMarginClient) NewPortfolioMarginCloseListenKeyService() *PortfolioMarginCloseListenKeyService {
+	return &PortfolioMarginCloseListenKeyService{c: p.Client}
+}
+
+// Test Connectivity API Endpoint
+const (
+	portfolioMarginPingEndpoint = "/papi/v1/ping"
+)
+
+// PortfolioMarginPingService test connectivity to the Portfolio Margin API
+type PortfolioMarginPingService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PortfolioMarginPingService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginPingEndpoint,
+		secType:  secTypeNone,
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// Account Information (USER_DATA) API Endpoint
+const (
+	portfolioMarginAccountEndpoint = "/papi/v1/account"
+)
+
+// PortfolioMarginAccountService query the unified account margin and equity
+type PortfolioMarginAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PortfolioMarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginAccountResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginAccountEndpoint,
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginAccountResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PortfolioMarginAccountResponse define Portfolio Margin account response
+type PortfolioMarginAccountResponse struct {
+	UniMMR                   string `json:"uniMMR"`
+	AccountEquity            string `json:"accountEquity"`
+	ActualEquity             string `json:"actualEquity"`
+	AccountInitialMargin     string `json:"accountInitialMargin"`
+	AccountMaintMargin       string `json:"accountMaintMargin"`
+	AccountStatus            string `json:"accountStatus"`
+	VirtualMaxWithdrawAmount string `json:"virtualMaxWithdrawAmount"`
+	TotalAvailableBalance    string `json:"totalAvailableBalance"`
+	TotalMarginOpenLoss      string `json:"totalMarginOpenLoss"`
+	UpdateTime               int64  `json:"updateTime"`
+}
+
+// Account Balance (USER_DATA) API Endpoint
+const (
+	portfolioMarginBalanceEndpoint = "/papi/v1/balance"
+)
+
+// PortfolioMarginBalanceService query the balances of the margin, UM and CM wallets
+type PortfolioMarginBalanceService struct {
+	c     *Client
+	asset *string
+}
+
+// Asset set asset
+func (s *PortfolioMarginBalanceService) Asset(asset string) *PortfolioMarginBalanceService {
+	s.asset = &asset
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*PortfolioMarginBalance, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginBalanceEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*PortfolioMarginBalance{}, err
+	}
+	// a single object is returned when asset is set
+	if s.asset != nil {
+		data = append(append([]byte("["), data...), ']')
+	}
+	res = make([]*PortfolioMarginBalance, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PortfolioMarginBalance{}, err
+	}
+	return res, nil
+}
+
+// PortfolioMarginBalance define the balance of an asset across the margin, UM and CM wallets
+type PortfolioMarginBalance struct {
+	Asset               string `json:"asset"`
+	TotalWalletBalance  string `json:"totalWalletBalance"`
+	CrossMarginAsset    string `json:"crossMarginAsset"`
+	CrossMarginBorrowed string `json:"crossMarginBorrowed"`
+	CrossMarginFree     string `json:"crossMarginFree"`
+	CrossMarginInterest string `json:"crossMarginInterest"`
+	CrossMarginLocked   string `json:"crossMarginLocked"`
+	UmWalletBalance     string `json:"umWalletBalance"`
+	UmUnrealizedPNL     string `json:"umUnrealizedPNL"`
+	CmWalletBalance     string `json:"cmWalletBalance"`
+	CmUnrealizedPNL     string `json:"cmUnrealizedPNL"`
+	UpdateTime          int64  `json:"updateTime"`
+	NegativeBalance     string `json:"negativeBalance"`
+}
+
+// Query UM Position Information (USER_DATA) API Endpoint
+const (
+	portfolioMarginUMPositionRiskEndpoint = "/papi/v1/um/positionRisk"
+)
+
+// PortfolioMarginUMPositionRiskService query the USDⓈ-M futures positions
+type PortfolioMarginUMPositionRiskService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginUMPositionRiskService) Symbol(symbol string) *PortfolioMarginUMPositionRiskService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginUMPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionRisk, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginUMPositionRiskEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*PositionRisk{}, err
+	}
+	res = make([]*PositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PositionRisk{}, err
+	}
+	return res, nil
+}
+
+// Query CM Position Information (USER_DATA) API Endpoint
+const (
+	portfolioMarginCMPositionRiskEndpoint = "/papi/v1/cm/positionRisk"
+)
+
+// PortfolioMarginCMPositionRiskService query the COIN-M futures positions
+type PortfolioMarginCMPositionRiskService struct {
+	c           *Client
+	marginAsset *string
+	pair        *string
+}
+
+// MarginAsset set marginAsset
+func (s *PortfolioMarginCMPositionRiskService) MarginAsset(marginAsset string) *PortfolioMarginCMPositionRiskService {
+	s.marginAsset = &marginAsset
+	return s
+}
+
+// Pair set pair
+func (s *PortfolioMarginCMPositionRiskService) Pair(pair string) *PortfolioMarginCMPositionRiskService {
+	s.pair = &pair
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginCMPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*PortfolioMarginCMPositionRisk, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginCMPositionRiskEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.marginAsset != nil {
+		r.setParam("marginAsset", *s.marginAsset)
+	}
+	if s.pair != nil {
+		r.setParam("pair", *s.pair)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*PortfolioMarginCMPositionRisk{}, err
+	}
+	res = make([]*PortfolioMarginCMPositionRisk, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PortfolioMarginCMPositionRisk{}, err
+	}
+	return res, nil
+}
+
+// PortfolioMarginCMPositionRisk define a COIN-M futures position
+type PortfolioMarginCMPositionRisk struct {
+	Symbol           string `json:"symbol"`
+	PositionAmt      string `json:"positionAmt"`
+	EntryPrice       string `json:"entryPrice"`
+	MarkPrice        string `json:"markPrice"`
+	UnRealizedProfit string `json:"unRealizedProfit"`
+	LiquidationPrice string `json:"liquidationPrice"`
+	Leverage         string `json:"leverage"`
+	PositionSide     string `json:"positionSide"`
+	UpdateTime       int64  `json:"updateTime"`
+	MaxQty           string `json:"maxQty"`
+	NotionalValue    string `json:"notionalValue"`
+}
+
+// Margin Account Borrow (MARGIN) API Endpoint
+const (
+	portfolioMarginLoanEndpoint = "/papi/v1/marginLoan"
+)
+
+// PortfolioMarginLoanService borrow an asset in the cross margin account
+type PortfolioMarginLoanService struct {
+	c      *Client
+	asset  string
+	amount float64
+}
+
+// Asset set asset
+func (s *PortfolioMarginLoanService) Asset(asset string) *PortfolioMarginLoanService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *PortfolioMarginLoanService) Amount(amount float64) *PortfolioMarginLoanService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginLoanService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginTransactionResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginLoanEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("asset", s.asset)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginTransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Margin Account Repay (MARGIN) API Endpoint
+const (
+	portfolioMarginRepayLoanEndpoint = "/papi/v1/repayLoan"
+)
+
+// PortfolioMarginRepayLoanService repay a cross margin loan
+type PortfolioMarginRepayLoanService struct {
+	c      *Client
+	asset  string
+	amount float64
+}
+
+// Asset set asset
+func (s *PortfolioMarginRepayLoanService) Asset(asset string) *PortfolioMarginRepayLoanService {
+	s.asset = asset
+	return s
+}
+
+// Amount set amount
+func (s *PortfolioMarginRepayLoanService) Amount(amount float64) *PortfolioMarginRepayLoanService {
+	s.amount = amount
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginRepayLoanService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginTransactionResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginRepayLoanEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("asset", s.asset)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginTransactionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PortfolioMarginTransactionResponse define borrow and repay response
+type PortfolioMarginTransactionResponse struct {
+	TranId int64 `json:"tranId"`
+}
+
+// Repay futures Negative Balance (USER_DATA) API Endpoint
+const (
+	portfolioMarginRepayFuturesNegativeBalanceEndpoint = "/papi/v1/repayFuturesNegativeBalance"
+)
+
+// PortfolioMarginRepayFuturesNegativeBalanceService repay the negative UM and CM wallet balances from the margin account
+type PortfolioMarginRepayFuturesNegativeBalanceService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PortfolioMarginRepayFuturesNegativeBalanceService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginMsgResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginRepayFuturesNegativeBalanceEndpoint,
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginMsgResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Fund Auto-collection (TRADE) API Endpoint
+const (
+	portfolioMarginAutoCollectionEndpoint = "/papi/v1/auto-collection"
+)
+
+// PortfolioMarginAutoCollectionService collect the free balances of the UM and CM wallets into the margin account
+type PortfolioMarginAutoCollectionService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PortfolioMarginAutoCollectionService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginMsgResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginAutoCollectionEndpoint,
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginMsgResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Fund Collection by Asset (TRADE) API Endpoint
+const (
+	portfolioMarginAssetCollectionEndpoint = "/papi/v1/asset-collection"
+)
+
+// PortfolioMarginAssetCollectionService collect the free balance of an asset into the margin account
+type PortfolioMarginAssetCollectionService struct {
+	c     *Client
+	asset string
+}
+
+// Asset set asset
+func (s *PortfolioMarginAssetCollectionService) Asset(asset string) *PortfolioMarginAssetCollectionService {
+	s.asset = asset
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginAssetCollectionService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginMsgResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginAssetCollectionEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("asset", s.asset)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginMsgResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PortfolioMarginMsgResponse define the response of the fund management endpoints
+type PortfolioMarginMsgResponse struct {
+	Msg string `json:"msg"`
+}
+
+// Portfolio Margin User Data Stream API Endpoint
+const (
+	portfolioMarginListenKeyEndpoint = "/papi/v1/listenKey"
+)
+
+// PortfolioMarginCreateListenKeyService start a Portfolio Margin user data stream
+type PortfolioMarginCreateListenKeyService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PortfolioMarginCreateListenKeyService) Do(ctx context.Context, opts ...RequestOption) (listenKey string, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginListenKeyEndpoint,
+		secType:  secTypeAPIKey,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return "", err
+	}
+	j, err := newJSON(data)
+	if err != nil {
+		return "", err
+	}
+	listenKey = j.Get("listenKey").MustString()
+	return listenKey, nil
+}
+
+// PortfolioMarginPingListenKeyService keep a Portfolio Margin user data stream alive
+type PortfolioMarginPingListenKeyService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PortfolioMarginPingListenKeyService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: portfolioMarginListenKeyEndpoint,
+		secType:  secTypeAPIKey,
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// PortfolioMarginCloseListenKeyService close a Portfolio Margin user data stream
+type PortfolioMarginCloseListenKeyService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PortfolioMarginCloseListenKeyService) Do(ctx context.Context, opts ...RequestOption) (err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: portfolioMarginListenKeyEndpoint,
+		secType:  secTypeAPIKey,
+	}
+	_, err = s.c.callAPI(ctx, r, opts...)
+	return err
+}
@@ -0,0 +1,244 @@
+package binance_connector
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type portfolioMarginTestSuite struct {
+	baseTestSuite
+	pm *PortfolioMarginClient
+}
+
+func TestPortfolioMargin(t *testing.T) {
+	suite.Run(t, new(portfolioMarginTestSuite))
+}
+
+func (s *portfolioMarginTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.pm = &PortfolioMarginClient{Client: s.client.Client}
+}
+
+func (s *portfolioMarginTestSuite) lastRequest() *http.Request {
+	calls := s.client.Calls
+	s.r().NotEmpty(calls)
+	return calls[len(calls)-1].Arguments.Get(0).(*http.Request)
+}
+
+func (s *portfolioMarginTestSuite) assertEndpoint(method, path string) {
+	req := s.lastRequest()
+	s.Equal(method, req.Method)
+	s.Equal(path, req.URL.Path)
+}
+
+func (s *portfolioMarginTestSuite) TestNewPortfolioMarginClient() {
+	s.Equal("https://papi.binance.com", NewPortfolioMarginClient("key", "secret").Client.BaseURL)
+	s.Equal("https://papi.example.com", NewPortfolioMarginClient("key", "secret", "https://papi.example.com").Client.BaseURL)
+}
+
+func (s *portfolioMarginTestSuite) TestUMNewOrder() {
+	data := []byte(`{
+		"clientOrderId": "testOrder",
+		"cumQty": "0",
+		"cumQuote": "0",
+		"executedQty": "0",
+		"orderId": 22542179,
+		"avgPrice": "0.00000",
+		"origQty": "10",
+		"price": "0",
+		"reduceOnly": false,
+		"side": "BUY",
+		"positionSide": "SHORT",
+		"status": "NEW",
+		"symbol": "BTCUSDT",
+		"timeInForce": "GTD",
+		"type": "LIMIT",
+		"selfTradePreventionMode": "NONE",
+		"goodTillDate": 1693207680000,
+		"updateTime": 1566818724722
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":       "BTCUSDT",
+			"side":         "BUY",
+			"type":         "LIMIT",
+			"positionSide": "SHORT",
+			"timeInForce":  "GTD",
+			"quantity":     "10",
+			"price":        "30000",
+			"goodTillDate": 1693207680000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.pm.NewPortfolioMarginUMNewOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).PositionSide(PositionSideTypeShort).TimeInForce(TimeInForceTypeGTD).
+		Quantity("10").Price("30000").GoodTillDate(1693207680000).Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodPost, "/papi/v1/um/order")
+	s.Equal(int64(22542179), resp.OrderID)
+	s.Equal(OrderStatusTypeNew, resp.Status)
+	s.Equal(int64(1693207680000), resp.GoodTillDate)
+}
+
+func (s *portfolioMarginTestSuite) TestCMQueryOrder() {
+	data := []byte(`{
+		"avgPrice": "0.0",
+		"clientOrderId": "abc",
+		"cumBase": "0",
+		"executedQty": "0",
+		"orderId": 1917641,
+		"origQty": "0.40",
+		"origType": "LIMIT",
+		"price": "0",
+		"reduceOnly": false,
+		"side": "BUY",
+		"status": "NEW",
+		"symbol": "BTCUSD_200925",
+		"pair": "BTCUSD",
+		"positionSide": "SHORT",
+		"time": 1579276756075,
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"updateTime": 1579276756075
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  "BTCUSD_200925",
+			"orderId": 1917641,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.pm.NewPortfolioMarginCMQueryOrderService().Symbol("BTCUSD_200925").OrderId(1917641).Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodGet, "/papi/v1/cm/order")
+	s.Equal(int64(1917641), resp.OrderID)
+	s.Equal("BTCUSD", resp.Pair)
+	s.Equal("0", resp.CumBase)
+}
+
+func (s *portfolioMarginTestSuite) TestMarginNewOrder() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 28,
+		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+		"transactTime": 1507725176595,
+		"price": "1.00000000",
+		"origQty": "10.00000000",
+		"executedQty": "10.00000000",
+		"cummulativeQuoteQty": "10.00000000",
+		"status": "FILLED",
+		"timeInForce": "GTC",
+		"type": "MARKET",
+		"side": "SELL",
+		"fills": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":         "BTCUSDT",
+			"side":           "SELL",
+			"type":           "MARKET",
+			"quantity":       "10",
+			"sideEffectType": "AUTO_REPAY",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.pm.NewPortfolioMarginMarginNewOrderService().Symbol("BTCUSDT").Side("SELL").OrderType("MARKET").
+		Quantity(10).SideEffectType(SideEffectTypeAutoRepay).Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodPost, "/papi/v1/margin/order")
+	s.Equal(int64(28), resp.OrderId)
+	s.Equal("FILLED", resp.Status)
+}
+
+func (s *portfolioMarginTestSuite) TestMarginNewOrderInvalidSideEffectType() {
+	_, err := s.pm.NewPortfolioMarginMarginNewOrderService().Symbol("BTCUSDT").Side("SELL").OrderType("MARKET").
+		SideEffectType("AUTO_BORROW").Do(newContext())
+	s.r().Error(err)
+}
+
+func (s *portfolioMarginTestSuite) TestBalance() {
+	data := []byte(`{
+		"asset": "USDT",
+		"totalWalletBalance": "122607.35137903",
+		"crossMarginAsset": "92.27530794",
+		"crossMarginBorrowed": "10.00000000",
+		"crossMarginFree": "100.00000000",
+		"crossMarginInterest": "0.72469206",
+		"crossMarginLocked": "3.00000000",
+		"umWalletBalance": "0.00000000",
+		"umUnrealizedPNL": "23.72469206",
+		"cmWalletBalance": "23.72469206",
+		"cmUnrealizedPNL": "",
+		"updateTime": 1617939110373,
+		"negativeBalance": "0"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset": "USDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.pm.NewPortfolioMarginBalanceService().Asset("USDT").Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp, 1)
+	s.Equal("122607.35137903", resp[0].TotalWalletBalance)
+	s.Equal("23.72469206", resp[0].CmWalletBalance)
+}
+
+func (s *portfolioMarginTestSuite) TestAccount() {
+	data := []byte(`{
+		"uniMMR": "5167.92171923",
+		"accountEquity": "122607.35137903",
+		"actualEquity": "73.47428058",
+		"accountInitialMargin": "23.72469206",
+		"accountMaintMargin": "23.72469206",
+		"accountStatus": "NORMAL",
+		"virtualMaxWithdrawAmount": "1627523.32459208",
+		"totalAvailableBalance": "",
+		"totalMarginOpenLoss": "",
+		"updateTime": 1657707212154
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.pm.NewPortfolioMarginAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodGet, "/papi/v1/account")
+	s.Equal("5167.92171923", resp.UniMMR)
+	s.Equal("NORMAL", resp.AccountStatus)
+}
+
+func (s *portfolioMarginTestSuite) TestCreateListenKey() {
+	data := []byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	listenKey, err := s.pm.NewPortfolioMarginCreateListenKeyService().Do(newContext())
+	s.r().NoError(err)
+	s.assertEndpoint(http.MethodPost, "/papi/v1/listenKey")
+	s.Equal("dummyAPIKey", s.lastRequest().Header.Get("X-MBX-APIKEY"))
+	s.Equal("pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1", listenKey)
+}
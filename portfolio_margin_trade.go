@@ -0,0 +1,883 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// New UM Order (TRADE) API Endpoint
+const (
+	portfolioMarginUMNewOrderEndpoint = "/papi/v1/um/order"
+)
+
+// PortfolioMarginUMNewOrderService place a USDⓈ-M futures order
+type PortfolioMarginUMNewOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	positionSide            *PositionSideType
+	timeInForce             *TimeInForceType
+	quantity                *string
+	reduceOnly              *bool
+	price                   *string
+	newClientOrderId        *string
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *string
+	goodTillDate            *int64
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginUMNewOrderService) Symbol(symbol string) *PortfolioMarginUMNewOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *PortfolioMarginUMNewOrderService) Side(side SideType) *PortfolioMarginUMNewOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *PortfolioMarginUMNewOrderService) Type(orderType OrderType) *PortfolioMarginUMNewOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// PositionSide set positionSide
+func (s *PortfolioMarginUMNewOrderService) PositionSide(positionSide PositionSideType) *PortfolioMarginUMNewOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *PortfolioMarginUMNewOrderService) TimeInForce(timeInForce TimeInForceType) *PortfolioMarginUMNewOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *PortfolioMarginUMNewOrderService) Quantity(quantity string) *PortfolioMarginUMNewOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *PortfolioMarginUMNewOrderService) ReduceOnly(reduceOnly bool) *PortfolioMarginUMNewOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *PortfolioMarginUMNewOrderService) Price(price string) *PortfolioMarginUMNewOrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderId set newClientOrderId
+func (s *PortfolioMarginUMNewOrderService) NewClientOrderId(newClientOrderId string) *PortfolioMarginUMNewOrderService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *PortfolioMarginUMNewOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *PortfolioMarginUMNewOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *PortfolioMarginUMNewOrderService) SelfTradePreventionMode(selfTradePreventionMode string) *PortfolioMarginUMNewOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// GoodTillDate set goodTillDate
+func (s *PortfolioMarginUMNewOrderService) GoodTillDate(goodTillDate int64) *PortfolioMarginUMNewOrderService {
+	s.goodTillDate = &goodTillDate
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginUMNewOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginUMNewOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("side", s.side)
+	r.setParam("type", s.orderType)
+	if s.positionSide != nil {
+		r.setParam("positionSide", *s.positionSide)
+	}
+	if s.timeInForce != nil {
+		r.setParam("timeInForce", *s.timeInForce)
+	}
+	if s.quantity != nil {
+		r.setParam("quantity", *s.quantity)
+	}
+	if s.reduceOnly != nil {
+		r.setParam("reduceOnly", *s.reduceOnly)
+	}
+	if s.price != nil {
+		r.setParam("price", *s.price)
+	}
+	if s.newClientOrderId != nil {
+		r.setParam("newClientOrderId", *s.newClientOrderId)
+	}
+	if s.newOrderRespType != nil {
+		r.setParam("newOrderRespType", *s.newOrderRespType)
+	}
+	if s.selfTradePreventionMode != nil {
+		r.setParam("selfTradePreventionMode", *s.selfTradePreventionMode)
+	}
+	if s.goodTillDate != nil {
+		r.setParam("goodTillDate", *s.goodTillDate)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Cancel UM Order (TRADE) API Endpoint
+const (
+	portfolioMarginUMCancelOrderEndpoint = "/papi/v1/um/order"
+)
+
+// PortfolioMarginUMCancelOrderService cancel an active USDⓈ-M futures order
+type PortfolioMarginUMCancelOrderService struct {
+	c                 *Client
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginUMCancelOrderService) Symbol(symbol string) *PortfolioMarginUMCancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *PortfolioMarginUMCancelOrderService) OrderId(orderId int64) *PortfolioMarginUMCancelOrderService {
+	s.orderId = &orderId
+	return s
+}
+
+// OrigClientOrderId set origClientOrderId
+func (s *PortfolioMarginUMCancelOrderService) OrigClientOrderId(origClientOrderId string) *PortfolioMarginUMCancelOrderService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginUMCancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: portfolioMarginUMCancelOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Query UM Order (USER_DATA) API Endpoint
+const (
+	portfolioMarginUMQueryOrderEndpoint = "/papi/v1/um/order"
+)
+
+// PortfolioMarginUMQueryOrderService query a USDⓈ-M futures order
+type PortfolioMarginUMQueryOrderService struct {
+	c                 *Client
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginUMQueryOrderService) Symbol(symbol string) *PortfolioMarginUMQueryOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *PortfolioMarginUMQueryOrderService) OrderId(orderId int64) *PortfolioMarginUMQueryOrderService {
+	s.orderId = &orderId
+	return s
+}
+
+// OrigClientOrderId set origClientOrderId
+func (s *PortfolioMarginUMQueryOrderService) OrigClientOrderId(origClientOrderId string) *PortfolioMarginUMQueryOrderService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginUMQueryOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginUMQueryOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Query All Current UM Open Orders (USER_DATA) API Endpoint
+const (
+	portfolioMarginUMOpenOrdersEndpoint = "/papi/v1/um/openOrders"
+)
+
+// PortfolioMarginUMOpenOrdersService list the open USDⓈ-M futures orders
+type PortfolioMarginUMOpenOrdersService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginUMOpenOrdersService) Symbol(symbol string) *PortfolioMarginUMOpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginUMOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginUMOpenOrdersEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Order{}, err
+	}
+	res = make([]*Order, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
+
+// New CM Order (TRADE) API Endpoint
+const (
+	portfolioMarginCMNewOrderEndpoint = "/papi/v1/cm/order"
+)
+
+// PortfolioMarginCMNewOrderService place a COIN-M futures order
+type PortfolioMarginCMNewOrderService struct {
+	c                *Client
+	symbol           string
+	side             SideType
+	orderType        OrderType
+	positionSide     *PositionSideType
+	timeInForce      *TimeInForceType
+	quantity         *string
+	reduceOnly       *bool
+	price            *string
+	newClientOrderId *string
+	newOrderRespType *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginCMNewOrderService) Symbol(symbol string) *PortfolioMarginCMNewOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *PortfolioMarginCMNewOrderService) Side(side SideType) *PortfolioMarginCMNewOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *PortfolioMarginCMNewOrderService) Type(orderType OrderType) *PortfolioMarginCMNewOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// PositionSide set positionSide
+func (s *PortfolioMarginCMNewOrderService) PositionSide(positionSide PositionSideType) *PortfolioMarginCMNewOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *PortfolioMarginCMNewOrderService) TimeInForce(timeInForce TimeInForceType) *PortfolioMarginCMNewOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *PortfolioMarginCMNewOrderService) Quantity(quantity string) *PortfolioMarginCMNewOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *PortfolioMarginCMNewOrderService) ReduceOnly(reduceOnly bool) *PortfolioMarginCMNewOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *PortfolioMarginCMNewOrderService) Price(price string) *PortfolioMarginCMNewOrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderId set newClientOrderId
+func (s *PortfolioMarginCMNewOrderService) NewClientOrderId(newClientOrderId string) *PortfolioMarginCMNewOrderService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *PortfolioMarginCMNewOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *PortfolioMarginCMNewOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginCMNewOrderService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginCMOrder, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginCMNewOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("side", s.side)
+	r.setParam("type", s.orderType)
+	if s.positionSide != nil {
+		r.setParam("positionSide", *s.positionSide)
+	}
+	if s.timeInForce != nil {
+		r.setParam("timeInForce", *s.timeInForce)
+	}
+	if s.quantity != nil {
+		r.setParam("quantity", *s.quantity)
+	}
+	if s.reduceOnly != nil {
+		r.setParam("reduceOnly", *s.reduceOnly)
+	}
+	if s.price != nil {
+		r.setParam("price", *s.price)
+	}
+	if s.newClientOrderId != nil {
+		r.setParam("newClientOrderId", *s.newClientOrderId)
+	}
+	if s.newOrderRespType != nil {
+		r.setParam("newOrderRespType", *s.newOrderRespType)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginCMOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Cancel CM Order (TRADE) API Endpoint
+const (
+	portfolioMarginCMCancelOrderEndpoint = "/papi/v1/cm/order"
+)
+
+// PortfolioMarginCMCancelOrderService cancel an active COIN-M futures order
+type PortfolioMarginCMCancelOrderService struct {
+	c                 *Client
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginCMCancelOrderService) Symbol(symbol string) *PortfolioMarginCMCancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *PortfolioMarginCMCancelOrderService) OrderId(orderId int64) *PortfolioMarginCMCancelOrderService {
+	s.orderId = &orderId
+	return s
+}
+
+// OrigClientOrderId set origClientOrderId
+func (s *PortfolioMarginCMCancelOrderService) OrigClientOrderId(origClientOrderId string) *PortfolioMarginCMCancelOrderService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginCMCancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginCMOrder, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: portfolioMarginCMCancelOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginCMOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Query CM Order (USER_DATA) API Endpoint
+const (
+	portfolioMarginCMQueryOrderEndpoint = "/papi/v1/cm/order"
+)
+
+// PortfolioMarginCMQueryOrderService query a COIN-M futures order
+type PortfolioMarginCMQueryOrderService struct {
+	c                 *Client
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginCMQueryOrderService) Symbol(symbol string) *PortfolioMarginCMQueryOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *PortfolioMarginCMQueryOrderService) OrderId(orderId int64) *PortfolioMarginCMQueryOrderService {
+	s.orderId = &orderId
+	return s
+}
+
+// OrigClientOrderId set origClientOrderId
+func (s *PortfolioMarginCMQueryOrderService) OrigClientOrderId(origClientOrderId string) *PortfolioMarginCMQueryOrderService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginCMQueryOrderService) Do(ctx context.Context, opts ...RequestOption) (res *PortfolioMarginCMOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginCMQueryOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PortfolioMarginCMOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Query All Current CM Open Orders (USER_DATA) API Endpoint
+const (
+	portfolioMarginCMOpenOrdersEndpoint = "/papi/v1/cm/openOrders"
+)
+
+// PortfolioMarginCMOpenOrdersService list the open COIN-M futures orders
+type PortfolioMarginCMOpenOrdersService struct {
+	c      *Client
+	symbol *string
+	pair   *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginCMOpenOrdersService) Symbol(symbol string) *PortfolioMarginCMOpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Pair set pair
+func (s *PortfolioMarginCMOpenOrdersService) Pair(pair string) *PortfolioMarginCMOpenOrdersService {
+	s.pair = &pair
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginCMOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*PortfolioMarginCMOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginCMOpenOrdersEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.pair != nil {
+		r.setParam("pair", *s.pair)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*PortfolioMarginCMOrder{}, err
+	}
+	res = make([]*PortfolioMarginCMOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PortfolioMarginCMOrder{}, err
+	}
+	return res, nil
+}
+
+// PortfolioMarginCMOrder define a COIN-M futures order, sized in contracts and
+// reporting its cumulative base volume
+type PortfolioMarginCMOrder struct {
+	Order
+	Pair    string `json:"pair"`
+	CumBase string `json:"cumBase"`
+}
+
+// New Margin Order (TRADE) API Endpoint
+const (
+	portfolioMarginMarginNewOrderEndpoint = "/papi/v1/margin/order"
+)
+
+// PortfolioMarginMarginNewOrderService place a cross margin order
+type PortfolioMarginMarginNewOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    string
+	orderType               string
+	quantity                *float64
+	quoteOrderQty           *float64
+	price                   *float64
+	stopPrice               *float64
+	newClientOrderId        *string
+	newOrderRespType        *string
+	icebergQty              *float64
+	sideEffectType          *SideEffectType
+	timeInForce             *string
+	selfTradePreventionMode *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginMarginNewOrderService) Symbol(symbol string) *PortfolioMarginMarginNewOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *PortfolioMarginMarginNewOrderService) Side(side string) *PortfolioMarginMarginNewOrderService {
+	s.side = side
+	return s
+}
+
+// OrderType set type
+func (s *PortfolioMarginMarginNewOrderService) OrderType(orderType string) *PortfolioMarginMarginNewOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Quantity set quantity
+func (s *PortfolioMarginMarginNewOrderService) Quantity(quantity float64) *PortfolioMarginMarginNewOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *PortfolioMarginMarginNewOrderService) QuoteOrderQty(quoteOrderQty float64) *PortfolioMarginMarginNewOrderService {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *PortfolioMarginMarginNewOrderService) Price(price float64) *PortfolioMarginMarginNewOrderService {
+	s.price = &price
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *PortfolioMarginMarginNewOrderService) StopPrice(stopPrice float64) *PortfolioMarginMarginNewOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// NewClientOrderId set newClientOrderId
+func (s *PortfolioMarginMarginNewOrderService) NewClientOrderId(newClientOrderId string) *PortfolioMarginMarginNewOrderService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *PortfolioMarginMarginNewOrderService) NewOrderRespType(newOrderRespType string) *PortfolioMarginMarginNewOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// IcebergQty set icebergQty
+func (s *PortfolioMarginMarginNewOrderService) IcebergQty(icebergQty float64) *PortfolioMarginMarginNewOrderService {
+	s.icebergQty = &icebergQty
+	return s
+}
+
+// SideEffectType set sideEffectType
+func (s *PortfolioMarginMarginNewOrderService) SideEffectType(sideEffectType SideEffectType) *PortfolioMarginMarginNewOrderService {
+	s.sideEffectType = &sideEffectType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *PortfolioMarginMarginNewOrderService) TimeInForce(timeInForce string) *PortfolioMarginMarginNewOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *PortfolioMarginMarginNewOrderService) SelfTradePreventionMode(selfTradePreventionMode string) *PortfolioMarginMarginNewOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginMarginNewOrderService) Do(ctx context.Context, opts ...RequestOption) (res *MarginAccountNewOrderResponseFULL, err error) {
+	if s.sideEffectType != nil {
+		switch *s.sideEffectType {
+		case SideEffectTypeNoSideEffect, SideEffectTypeMarginBuy, SideEffectTypeAutoRepay, SideEffectTypeAutoBorrowRepay:
+		default:
+			return nil, fmt.Errorf("invalid sideEffectType %q", *s.sideEffectType)
+		}
+	}
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: portfolioMarginMarginNewOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("side", s.side)
+	r.setParam("type", s.orderType)
+	if s.quantity != nil {
+		r.setParam("quantity", strconv.FormatFloat(*s.quantity, 'f', -1, 64))
+	}
+	if s.quoteOrderQty != nil {
+		r.setParam("quoteOrderQty", strconv.FormatFloat(*s.quoteOrderQty, 'f', -1, 64))
+	}
+	if s.price != nil {
+		r.setParam("price", strconv.FormatFloat(*s.price, 'f', -1, 64))
+	}
+	if s.stopPrice != nil {
+		r.setParam("stopPrice", strconv.FormatFloat(*s.stopPrice, 'f', -1, 64))
+	}
+	if s.newClientOrderId != nil {
+		r.setParam("newClientOrderId", *s.newClientOrderId)
+	}
+	if s.newOrderRespType != nil {
+		r.setParam("newOrderRespType", *s.newOrderRespType)
+	}
+	if s.icebergQty != nil {
+		r.setParam("icebergQty", strconv.FormatFloat(*s.icebergQty, 'f', -1, 64))
+	}
+	if s.sideEffectType != nil {
+		r.setParam("sideEffectType", *s.sideEffectType)
+	}
+	if s.timeInForce != nil {
+		r.setParam("timeInForce", *s.timeInForce)
+	}
+	if s.selfTradePreventionMode != nil {
+		r.setParam("selfTradePreventionMode", *s.selfTradePreventionMode)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginAccountNewOrderResponseFULL)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Cancel Margin Account Order (TRADE) API Endpoint
+const (
+	portfolioMarginMarginCancelOrderEndpoint = "/papi/v1/margin/order"
+)
+
+// PortfolioMarginMarginCancelOrderService cancel an active cross margin order
+type PortfolioMarginMarginCancelOrderService struct {
+	c                 *Client
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+	newClientOrderId  *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginMarginCancelOrderService) Symbol(symbol string) *PortfolioMarginMarginCancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *PortfolioMarginMarginCancelOrderService) OrderId(orderId int64) *PortfolioMarginMarginCancelOrderService {
+	s.orderId = &orderId
+	return s
+}
+
+// OrigClientOrderId set origClientOrderId
+func (s *PortfolioMarginMarginCancelOrderService) OrigClientOrderId(origClientOrderId string) *PortfolioMarginMarginCancelOrderService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+// NewClientOrderId set newClientOrderId
+func (s *PortfolioMarginMarginCancelOrderService) NewClientOrderId(newClientOrderId string) *PortfolioMarginMarginCancelOrderService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginMarginCancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *MarginAccountCancelOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: portfolioMarginMarginCancelOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderId)
+	}
+	if s.newClientOrderId != nil {
+		r.setParam("newClientOrderId", *s.newClientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginAccountCancelOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Query Margin Account Order (USER_DATA) API Endpoint
+const (
+	portfolioMarginMarginQueryOrderEndpoint = "/papi/v1/margin/order"
+)
+
+// PortfolioMarginMarginQueryOrderService query a cross margin order
+type PortfolioMarginMarginQueryOrderService struct {
+	c                 *Client
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+}
+
+// Symbol set symbol
+func (s *PortfolioMarginMarginQueryOrderService) Symbol(symbol string) *PortfolioMarginMarginQueryOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId set orderId
+func (s *PortfolioMarginMarginQueryOrderService) OrderId(orderId int64) *PortfolioMarginMarginQueryOrderService {
+	s.orderId = &orderId
+	return s
+}
+
+// OrigClientOrderId set origClientOrderId
+func (s *PortfolioMarginMarginQueryOrderService) OrigClientOrderId(origClientOrderId string) *PortfolioMarginMarginQueryOrderService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+// Do send request
+func (s *PortfolioMarginMarginQueryOrderService) Do(ctx context.Context, opts ...RequestOption) (res *MarginAccountOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: portfolioMarginMarginQueryOrderEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderId != nil {
+		r.setParam("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderId)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginAccountOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
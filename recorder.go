@@ -0,0 +1,353 @@
+package binance_connector
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// RecordKind define the source of a recorded message
+type RecordKind string
+
+const (
+	RecordKindWebsocket RecordKind = "ws"
+	RecordKindREST      RecordKind = "rest"
+)
+
+// Record define a single line of a recording. Recordings are gzip compressed
+// JSON lines, one Record per line, in the order the messages were received.
+type Record struct {
+	// Time is the receive time in Unix nanoseconds
+	Time int64      `json:"time"`
+	Kind RecordKind `json:"kind"`
+	// Stream is the stream path for websocket records, e.g. "/ws/btcusdt@depth",
+	// or the method, path and query for REST records, e.g. "GET /api/v3/depth?symbol=BTCUSDT"
+	Stream     string          `json:"stream"`
+	StatusCode int             `json:"statusCode,omitempty"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Recorder captures raw websocket stream messages and REST responses to a
+// compressed JSON lines file, to be replayed later with a Replayer
+type Recorder struct {
+	mu  sync.Mutex
+	f   io.WriteCloser
+	gz  *gzip.Writer
+	enc *json.Encoder
+	now func() time.Time
+}
+
+// NewRecorder creates the recording file at path
+func NewRecorder(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return newRecorder(f), nil
+}
+
+func newRecorder(w io.WriteCloser) *Recorder {
+	gz := gzip.NewWriter(w)
+	return &Recorder{
+		f:   w,
+		gz:  gz,
+		enc: json.NewEncoder(gz),
+		now: time.Now,
+	}
+}
+
+// Attach records every message received by websocket streams started after
+// the call, until the returned detach function is called. It wraps the
+// package level websocket transport, so it applies to all stream clients.
+func (rec *Recorder) Attach() (detach func()) {
+	prev := wsServe
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneCh, stopCh chan struct{}, err error) {
+		stream := streamKey(cfg.Endpoint)
+		return prev(cfg, func(message []byte) {
+			if err := rec.write(RecordKindWebsocket, stream, 0, message); err != nil {
+				errHandler(err)
+			}
+			handler(message)
+		}, errHandler)
+	}
+	return func() {
+		wsServe = prev
+	}
+}
+
+// Transport returns an http.RoundTripper that records every response body
+// before handing it back. Use it as the Transport of Client.HTTPClient to
+// capture REST snapshots such as order books. If next is nil,
+// http.DefaultTransport is used.
+func (rec *Recorder) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(data))
+		if err = rec.write(RecordKindREST, restKey(req.Method, req.URL), res.StatusCode, data); err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+}
+
+// Close flushes the recording and closes the file
+func (rec *Recorder) Close() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if err := rec.gz.Close(); err != nil {
+		return err
+	}
+	return rec.f.Close()
+}
+
+func (rec *Recorder) write(kind RecordKind, stream string, statusCode int, data []byte) error {
+	if !json.Valid(data) {
+		// keep the line parseable; non JSON payloads are stored as a string
+		data, _ = json.Marshal(string(data))
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return rec.enc.Encode(&Record{
+		Time:       rec.now().UnixNano(),
+		Kind:       kind,
+		Stream:     stream,
+		StatusCode: statusCode,
+		Data:       data,
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// streamKey strips the scheme and host from a websocket endpoint, so that a
+// recording made against one base URL can be replayed against another
+func streamKey(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	if u.RawQuery != "" {
+		return u.Path + "?" + u.RawQuery
+	}
+	return u.Path
+}
+
+// restKey identifies a REST request independently of its host and of the
+// per-request timestamp and signature
+func restKey(method string, u *url.URL) string {
+	q := u.Query()
+	q.Del(timestampKey)
+	q.Del(signatureKey)
+	q.Del(recvWindowKey)
+	if len(q) == 0 {
+		return method + " " + u.Path
+	}
+	return method + " " + u.Path + "?" + q.Encode()
+}
+
+// Replayer feeds a recording made by Recorder back through the regular stream
+// handlers, e.g. WsDepthHandler, WsKlineHandler or WsUserDataHandler
+type Replayer struct {
+	path string
+	// Speed scales the delay between recorded messages: 1 replays at the
+	// recorded pace, 10 ten times faster. 0 replays as fast as possible.
+	Speed float64
+
+	mu   sync.Mutex
+	subs map[string][]*replaySub
+	rest map[string][]*Record
+}
+
+type replaySub struct {
+	handler    WsHandler
+	errHandler ErrHandler
+	doneCh     chan struct{}
+	stopCh     chan struct{}
+	once       sync.Once
+	stopped    bool
+}
+
+func (s *replaySub) close() {
+	s.once.Do(func() {
+		close(s.doneCh)
+	})
+}
+
+// NewReplayer opens the recording at path. REST responses are loaded up front
+// so that Transport can serve them; websocket messages are streamed by Run.
+func NewReplayer(path string, speed float64) (*Replayer, error) {
+	p := &Replayer{
+		path:  path,
+		Speed: speed,
+		subs:  make(map[string][]*replaySub),
+		rest:  make(map[string][]*Record),
+	}
+	err := p.scan(func(rec *Record) error {
+		if rec.Kind == RecordKindREST {
+			p.rest[rec.Stream] = append(p.rest[rec.Stream], rec)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Attach makes websocket streams started after the call subscribe to the
+// recording instead of dialing Binance, until the returned detach function is
+// called. Messages are delivered once Run is called.
+func (p *Replayer) Attach() (detach func()) {
+	prev := wsServe
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneCh, stopCh chan struct{}, err error) {
+		sub := &replaySub{
+			handler:    handler,
+			errHandler: errHandler,
+			doneCh:     make(chan struct{}),
+			stopCh:     make(chan struct{}),
+		}
+		go func() {
+			select {
+			case <-sub.stopCh:
+				p.mu.Lock()
+				sub.stopped = true
+				p.mu.Unlock()
+				sub.close()
+			case <-sub.doneCh:
+			}
+		}()
+		key := streamKey(cfg.Endpoint)
+		p.mu.Lock()
+		p.subs[key] = append(p.subs[key], sub)
+		p.mu.Unlock()
+		return sub.doneCh, sub.stopCh, nil
+	}
+	return func() {
+		wsServe = prev
+	}
+}
+
+// Transport returns an http.RoundTripper serving the recorded REST responses.
+// Requests are matched on method, path and parameters, ignoring timestamp and
+// signature; repeated requests get the recorded responses in order.
+func (p *Replayer) Transport() http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		key := restKey(req.Method, req.URL)
+		p.mu.Lock()
+		queue := p.rest[key]
+		if len(queue) == 0 {
+			p.mu.Unlock()
+			return nil, fmt.Errorf("no recorded response for %s", key)
+		}
+		rec := queue[0]
+		if len(queue) > 1 {
+			p.rest[key] = queue[1:]
+		}
+		p.mu.Unlock()
+		data := []byte(rec.Data)
+		return &http.Response{
+			StatusCode: rec.StatusCode,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(data)),
+			Request:    req,
+		}, nil
+	})
+}
+
+// Run delivers the recorded websocket messages to the subscribed handlers in
+// recorded order, then closes the done channel of every subscription. Messages
+// of streams nobody subscribed to are skipped.
+func (p *Replayer) Run(ctx context.Context) error {
+	defer p.closeAll()
+	var first int64
+	start := time.Now()
+	return p.scan(func(rec *Record) error {
+		if rec.Kind != RecordKindWebsocket {
+			return nil
+		}
+		if first == 0 {
+			first = rec.Time
+		}
+		if p.Speed > 0 {
+			offset := time.Duration(float64(rec.Time-first) / p.Speed)
+			if err := sleepContext(ctx, time.Until(start.Add(offset))); err != nil {
+				return err
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+		p.mu.Lock()
+		subs := p.subs[rec.Stream]
+		p.mu.Unlock()
+		for _, sub := range subs {
+			p.mu.Lock()
+			stopped := sub.stopped
+			p.mu.Unlock()
+			if !stopped {
+				sub.handler(rec.Data)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *Replayer) closeAll() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, subs := range p.subs {
+		for _, sub := range subs {
+			sub.close()
+		}
+	}
+}
+
+func (p *Replayer) scan(fn func(rec *Record) error) error {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(bufio.NewReader(gz))
+	for {
+		rec := new(Record)
+		err = dec.Decode(rec)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = fn(rec); err != nil {
+			return err
+		}
+	}
+}
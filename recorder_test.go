@@ -0,0 +1,80 @@
+package binance_connector
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type recorderTestSuite struct {
+	suite.Suite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+}
+
+func TestRecorder(t *testing.T) {
+	suite.Run(t, new(recorderTestSuite))
+}
+
+func (s *recorderTestSuite) SetupTest() {
+	s.origWsServe = wsServe
+}
+
+func (s *recorderTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *recorderTestSuite) TestRecordAndReplay() {
+	r := s.Require()
+	path := filepath.Join(s.T().TempDir(), "depth.jsonl.gz")
+	depthMsg := []byte(`{"e":"depthUpdate","E":1,"s":"BTCUSDT","U":10,"u":12,"b":[["100.0","1.5"]],"a":[]}`)
+	bookMsg := []byte(`{"lastUpdateId":9,"bids":[["100.0","1.0"]],"asks":[]}`)
+
+	// stand-in for the network: every stream receives depthMsg once
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneCh, stopCh chan struct{}, err error) {
+		handler(depthMsg)
+		doneCh = make(chan struct{})
+		close(doneCh)
+		return doneCh, make(chan struct{}), nil
+	}
+	rec, err := NewRecorder(path)
+	r.NoError(err)
+	detach := rec.Attach()
+	_, _, err = NewWebsocketStreamClient(false).WsDepthServe("BTCUSDT", func(event *WsDepthEvent) {}, func(err error) {})
+	r.NoError(err)
+	detach()
+
+	client := NewClient("", "", "https://api.binance.com")
+	client.HTTPClient = &http.Client{Transport: rec.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(bookMsg))}, nil
+	}))}
+	_, err = client.NewOrderBookService().Symbol("BTCUSDT").Do(newContext())
+	r.NoError(err)
+	r.NoError(rec.Close())
+
+	// replay against a different base URL
+	replayer, err := NewReplayer(path, 0)
+	r.NoError(err)
+	defer replayer.Attach()()
+
+	var events []*WsDepthEvent
+	doneCh, _, err := NewWebsocketStreamClient(false, "wss://stream.testnet.binance.vision").
+		WsDepthServe("BTCUSDT", func(event *WsDepthEvent) {
+			events = append(events, event)
+		}, func(err error) {})
+	r.NoError(err)
+	r.NoError(replayer.Run(newContext()))
+	<-doneCh
+	r.Len(events, 1)
+	r.Equal(int64(12), events[0].LastUpdateID)
+	r.Equal("1.5", events[0].Bids[0].Quantity)
+
+	client = NewClient("", "", "https://testnet.binance.vision")
+	client.HTTPClient = &http.Client{Transport: replayer.Transport()}
+	book, err := client.NewOrderBookService().Symbol("BTCUSDT").Do(newContext())
+	r.NoError(err)
+	r.Equal(uint64(9), book.LastUpdateId)
+}
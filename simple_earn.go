@@ -0,0 +1,1426 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// Get Simple Earn Flexible Product List (USER_DATA) API Endpoint
+const (
+	simpleEarnFlexibleProductListEndpoint = "/sapi/v1/simple-earn/flexible/list"
+)
+
+// SimpleEarnFlexibleProductListService list the flexible products available to subscribe
+type SimpleEarnFlexibleProductListService struct {
+	c       *Client
+	asset   *string
+	current *int64
+	size    *int64
+}
+
+// Asset set asset
+func (s *SimpleEarnFlexibleProductListService) Asset(asset string) *SimpleEarnFlexibleProductListService {
+	s.asset = &asset
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnFlexibleProductListService) Current(current int64) *SimpleEarnFlexibleProductListService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnFlexibleProductListService) Size(size int64) *SimpleEarnFlexibleProductListService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexibleProductListService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleProductListResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnFlexibleProductListEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleProductListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleProductListResponse define flexible product list response
+type SimpleEarnFlexibleProductListResponse struct {
+	Rows  []*SimpleEarnFlexibleProduct `json:"rows"`
+	Total int64                        `json:"total"`
+}
+
+// SimpleEarnFlexibleProduct define a flexible product
+type SimpleEarnFlexibleProduct struct {
+	Asset                      string            `json:"asset"`
+	LatestAnnualPercentageRate string            `json:"latestAnnualPercentageRate"`
+	TierAnnualPercentageRate   map[string]string `json:"tierAnnualPercentageRate"`
+	AirDropPercentageRate      string            `json:"airDropPercentageRate"`
+	CanPurchase                bool              `json:"canPurchase"`
+	CanRedeem                  bool              `json:"canRedeem"`
+	IsSoldOut                  bool              `json:"isSoldOut"`
+	Hot                        bool              `json:"hot"`
+	MinPurchaseAmount          string            `json:"minPurchaseAmount"`
+	ProductId                  string            `json:"productId"`
+	SubscriptionStartTime      uint64            `json:"subscriptionStartTime"`
+	Status                     string            `json:"status"`
+}
+
+// Get Simple Earn Locked Product List (USER_DATA) API Endpoint
+const (
+	simpleEarnLockedProductListEndpoint = "/sapi/v1/simple-earn/locked/list"
+)
+
+// SimpleEarnLockedProductListService list the locked products available to subscribe
+type SimpleEarnLockedProductListService struct {
+	c       *Client
+	asset   *string
+	current *int64
+	size    *int64
+}
+
+// Asset set asset
+func (s *SimpleEarnLockedProductListService) Asset(asset string) *SimpleEarnLockedProductListService {
+	s.asset = &asset
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnLockedProductListService) Current(current int64) *SimpleEarnLockedProductListService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnLockedProductListService) Size(size int64) *SimpleEarnLockedProductListService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedProductListService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedProductListResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnLockedProductListEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedProductListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedProductListResponse define locked product list response
+type SimpleEarnLockedProductListResponse struct {
+	Rows  []*SimpleEarnLockedProduct `json:"rows"`
+	Total int64                      `json:"total"`
+}
+
+// SimpleEarnLockedProduct define a locked product
+type SimpleEarnLockedProduct struct {
+	ProjectId string `json:"projectId"`
+	Detail    struct {
+		Asset                 string `json:"asset"`
+		RewardAsset           string `json:"rewardAsset"`
+		Duration              int64  `json:"duration"`
+		Renewable             bool   `json:"renewable"`
+		IsSoldOut             bool   `json:"isSoldOut"`
+		Apr                   string `json:"apr"`
+		Status                string `json:"status"`
+		SubscriptionStartTime uint64 `json:"subscriptionStartTime"`
+		ExtraRewardAsset      string `json:"extraRewardAsset"`
+		ExtraRewardAPR        string `json:"extraRewardAPR"`
+	} `json:"detail"`
+	Quota struct {
+		TotalPersonalQuota string `json:"totalPersonalQuota"`
+		Minimum            string `json:"minimum"`
+	} `json:"quota"`
+}
+
+// Subscribe Flexible Product (TRADE) API Endpoint
+const (
+	simpleEarnFlexibleSubscribeEndpoint = "/sapi/v1/simple-earn/flexible/subscribe"
+)
+
+// SimpleEarnFlexibleSubscribeService subscribe an amount to a flexible product
+type SimpleEarnFlexibleSubscribeService struct {
+	c             *Client
+	productId     string
+	amount        float64
+	autoSubscribe *bool
+	sourceAccount *string
+}
+
+// ProductId set productId
+func (s *SimpleEarnFlexibleSubscribeService) ProductId(productId string) *SimpleEarnFlexibleSubscribeService {
+	s.productId = productId
+	return s
+}
+
+// Amount set amount
+func (s *SimpleEarnFlexibleSubscribeService) Amount(amount float64) *SimpleEarnFlexibleSubscribeService {
+	s.amount = amount
+	return s
+}
+
+// AutoSubscribe set autoSubscribe
+func (s *SimpleEarnFlexibleSubscribeService) AutoSubscribe(autoSubscribe bool) *SimpleEarnFlexibleSubscribeService {
+	s.autoSubscribe = &autoSubscribe
+	return s
+}
+
+// SourceAccount set sourceAccount, SPOT, FUND or ALL
+func (s *SimpleEarnFlexibleSubscribeService) SourceAccount(sourceAccount string) *SimpleEarnFlexibleSubscribeService {
+	s.sourceAccount = &sourceAccount
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexibleSubscribeService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnSubscribeResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: simpleEarnFlexibleSubscribeEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("productId", s.productId)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
+	if s.autoSubscribe != nil {
+		r.setParam("autoSubscribe", *s.autoSubscribe)
+	}
+	if s.sourceAccount != nil {
+		r.setParam("sourceAccount", *s.sourceAccount)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnSubscribeResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Subscribe Locked Product (TRADE) API Endpoint
+const (
+	simpleEarnLockedSubscribeEndpoint = "/sapi/v1/simple-earn/locked/subscribe"
+)
+
+// SimpleEarnLockedSubscribeService subscribe an amount to a locked product
+type SimpleEarnLockedSubscribeService struct {
+	c             *Client
+	projectId     string
+	amount        float64
+	autoSubscribe *bool
+	sourceAccount *string
+	redeemTo      *string
+}
+
+// ProjectId set projectId
+func (s *SimpleEarnLockedSubscribeService) ProjectId(projectId string) *SimpleEarnLockedSubscribeService {
+	s.projectId = projectId
+	return s
+}
+
+// Amount set amount
+func (s *SimpleEarnLockedSubscribeService) Amount(amount float64) *SimpleEarnLockedSubscribeService {
+	s.amount = amount
+	return s
+}
+
+// AutoSubscribe set autoSubscribe
+func (s *SimpleEarnLockedSubscribeService) AutoSubscribe(autoSubscribe bool) *SimpleEarnLockedSubscribeService {
+	s.autoSubscribe = &autoSubscribe
+	return s
+}
+
+// SourceAccount set sourceAccount, SPOT, FUND or ALL
+func (s *SimpleEarnLockedSubscribeService) SourceAccount(sourceAccount string) *SimpleEarnLockedSubscribeService {
+	s.sourceAccount = &sourceAccount
+	return s
+}
+
+// RedeemTo set redeemTo, SPOT or FLEXIBLE
+func (s *SimpleEarnLockedSubscribeService) RedeemTo(redeemTo string) *SimpleEarnLockedSubscribeService {
+	s.redeemTo = &redeemTo
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedSubscribeService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnSubscribeResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: simpleEarnLockedSubscribeEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("projectId", s.projectId)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
+	if s.autoSubscribe != nil {
+		r.setParam("autoSubscribe", *s.autoSubscribe)
+	}
+	if s.sourceAccount != nil {
+		r.setParam("sourceAccount", *s.sourceAccount)
+	}
+	if s.redeemTo != nil {
+		r.setParam("redeemTo", *s.redeemTo)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnSubscribeResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnSubscribeResponse define subscribe response. PositionId is only
+// returned for locked products.
+type SimpleEarnSubscribeResponse struct {
+	PurchaseId int64  `json:"purchaseId"`
+	PositionId string `json:"positionId"`
+	Success    bool   `json:"success"`
+}
+
+// Redeem Flexible Product (TRADE) API Endpoint
+const (
+	simpleEarnFlexibleRedeemEndpoint = "/sapi/v1/simple-earn/flexible/redeem"
+)
+
+// SimpleEarnFlexibleRedeemService redeem an amount, or all, of a flexible product
+type SimpleEarnFlexibleRedeemService struct {
+	c           *Client
+	productId   string
+	redeemAll   *bool
+	amount      *float64
+	destAccount *string
+}
+
+// ProductId set productId
+func (s *SimpleEarnFlexibleRedeemService) ProductId(productId string) *SimpleEarnFlexibleRedeemService {
+	s.productId = productId
+	return s
+}
+
+// RedeemAll set redeemAll
+func (s *SimpleEarnFlexibleRedeemService) RedeemAll(redeemAll bool) *SimpleEarnFlexibleRedeemService {
+	s.redeemAll = &redeemAll
+	return s
+}
+
+// Amount set amount
+func (s *SimpleEarnFlexibleRedeemService) Amount(amount float64) *SimpleEarnFlexibleRedeemService {
+	s.amount = &amount
+	return s
+}
+
+// DestAccount set destAccount, SPOT or FUND
+func (s *SimpleEarnFlexibleRedeemService) DestAccount(destAccount string) *SimpleEarnFlexibleRedeemService {
+	s.destAccount = &destAccount
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexibleRedeemService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnRedeemResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: simpleEarnFlexibleRedeemEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("productId", s.productId)
+	if s.redeemAll != nil {
+		r.setParam("redeemAll", *s.redeemAll)
+	}
+	if s.amount != nil {
+		r.setParam("amount", strconv.FormatFloat(*s.amount, 'f', -1, 64))
+	}
+	if s.destAccount != nil {
+		r.setParam("destAccount", *s.destAccount)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnRedeemResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Redeem Locked Product (TRADE) API Endpoint
+const (
+	simpleEarnLockedRedeemEndpoint = "/sapi/v1/simple-earn/locked/redeem"
+)
+
+// SimpleEarnLockedRedeemService redeem a locked position early
+type SimpleEarnLockedRedeemService struct {
+	c          *Client
+	positionId string
+}
+
+// PositionId set positionId
+func (s *SimpleEarnLockedRedeemService) PositionId(positionId string) *SimpleEarnLockedRedeemService {
+	s.positionId = positionId
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedRedeemService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnRedeemResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: simpleEarnLockedRedeemEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("positionId", s.positionId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnRedeemResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnRedeemResponse define redeem response
+type SimpleEarnRedeemResponse struct {
+	RedeemId int64 `json:"redeemId"`
+	Success  bool  `json:"success"`
+}
+
+// Get Flexible Product Position (USER_DATA) API Endpoint
+const (
+	simpleEarnFlexiblePositionEndpoint = "/sapi/v1/simple-earn/flexible/position"
+)
+
+// SimpleEarnFlexiblePositionService query the flexible product positions
+type SimpleEarnFlexiblePositionService struct {
+	c         *Client
+	asset     *string
+	productId *string
+	current   *int64
+	size      *int64
+}
+
+// Asset set asset
+func (s *SimpleEarnFlexiblePositionService) Asset(asset string) *SimpleEarnFlexiblePositionService {
+	s.asset = &asset
+	return s
+}
+
+// ProductId set productId
+func (s *SimpleEarnFlexiblePositionService) ProductId(productId string) *SimpleEarnFlexiblePositionService {
+	s.productId = &productId
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnFlexiblePositionService) Current(current int64) *SimpleEarnFlexiblePositionService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnFlexiblePositionService) Size(size int64) *SimpleEarnFlexiblePositionService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexiblePositionService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexiblePositionResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnFlexiblePositionEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.productId != nil {
+		r.setParam("productId", *s.productId)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexiblePositionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexiblePositionResponse define flexible position response
+type SimpleEarnFlexiblePositionResponse struct {
+	Rows  []*SimpleEarnFlexiblePosition `json:"rows"`
+	Total int64                         `json:"total"`
+}
+
+// SimpleEarnFlexiblePosition define a flexible product position
+type SimpleEarnFlexiblePosition struct {
+	TotalAmount                    string            `json:"totalAmount"`
+	TierAnnualPercentageRate       map[string]string `json:"tierAnnualPercentageRate"`
+	LatestAnnualPercentageRate     string            `json:"latestAnnualPercentageRate"`
+	YesterdayAirdropPercentageRate string            `json:"yesterdayAirdropPercentageRate"`
+	Asset                          string            `json:"asset"`
+	AirDropAsset                   string            `json:"airDropAsset"`
+	CanRedeem                      bool              `json:"canRedeem"`
+	CollateralAmount               string            `json:"collateralAmount"`
+	ProductId                      string            `json:"productId"`
+	YesterdayRealTimeRewards       string            `json:"yesterdayRealTimeRewards"`
+	CumulativeBonusRewards         string            `json:"cumulativeBonusRewards"`
+	CumulativeRealTimeRewards      string            `json:"cumulativeRealTimeRewards"`
+	CumulativeTotalRewards         string            `json:"cumulativeTotalRewards"`
+	AutoSubscribe                  bool              `json:"autoSubscribe"`
+}
+
+// Get Locked Product Position (USER_DATA) API Endpoint
+const (
+	simpleEarnLockedPositionEndpoint = "/sapi/v1/simple-earn/locked/position"
+)
+
+// SimpleEarnLockedPositionService query the locked product positions
+type SimpleEarnLockedPositionService struct {
+	c          *Client
+	asset      *string
+	positionId *string
+	projectId  *string
+	current    *int64
+	size       *int64
+}
+
+// Asset set asset
+func (s *SimpleEarnLockedPositionService) Asset(asset string) *SimpleEarnLockedPositionService {
+	s.asset = &asset
+	return s
+}
+
+// PositionId set positionId
+func (s *SimpleEarnLockedPositionService) PositionId(positionId string) *SimpleEarnLockedPositionService {
+	s.positionId = &positionId
+	return s
+}
+
+// ProjectId set projectId
+func (s *SimpleEarnLockedPositionService) ProjectId(projectId string) *SimpleEarnLockedPositionService {
+	s.projectId = &projectId
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnLockedPositionService) Current(current int64) *SimpleEarnLockedPositionService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnLockedPositionService) Size(size int64) *SimpleEarnLockedPositionService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedPositionService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedPositionResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnLockedPositionEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.positionId != nil {
+		r.setParam("positionId", *s.positionId)
+	}
+	if s.projectId != nil {
+		r.setParam("projectId", *s.projectId)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedPositionResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedPositionResponse define locked position response
+type SimpleEarnLockedPositionResponse struct {
+	Rows  []*SimpleEarnLockedPosition `json:"rows"`
+	Total int64                       `json:"total"`
+}
+
+// SimpleEarnLockedPosition define a locked product position
+type SimpleEarnLockedPosition struct {
+	PositionId            string `json:"positionId"`
+	ParentPositionId      string `json:"parentPositionId"`
+	ProjectId             string `json:"projectId"`
+	Asset                 string `json:"asset"`
+	Amount                string `json:"amount"`
+	PurchaseTime          string `json:"purchaseTime"`
+	Duration              string `json:"duration"`
+	AccrualDays           string `json:"accrualDays"`
+	RewardAsset           string `json:"rewardAsset"`
+	APY                   string `json:"APY"`
+	RewardAmt             string `json:"rewardAmt"`
+	ExtraRewardAsset      string `json:"extraRewardAsset"`
+	ExtraRewardAPR        string `json:"extraRewardAPR"`
+	EstExtraRewardAmt     string `json:"estExtraRewardAmt"`
+	NextPay               string `json:"nextPay"`
+	NextPayDate           string `json:"nextPayDate"`
+	PayPeriod             string `json:"payPeriod"`
+	RedeemAmountEarly     string `json:"redeemAmountEarly"`
+	RewardsEndDate        string `json:"rewardsEndDate"`
+	DeliverDate           string `json:"deliverDate"`
+	RedeemPeriod          string `json:"redeemPeriod"`
+	RedeemingAmt          string `json:"redeemingAmt"`
+	RedeemTo              string `json:"redeemTo"`
+	PartialAmtDeliverDate string `json:"partialAmtDeliverDate"`
+	CanRedeemEarly        bool   `json:"canRedeemEarly"`
+	CanFastRedemption     bool   `json:"canFastRedemption"`
+	AutoSubscribe         bool   `json:"autoSubscribe"`
+	Type                  string `json:"type"`
+	Status                string `json:"status"`
+	CanReStake            bool   `json:"canReStake"`
+}
+
+// Simple Account (USER_DATA) API Endpoint
+const (
+	simpleEarnAccountEndpoint = "/sapi/v1/simple-earn/account"
+)
+
+// SimpleEarnAccountService query the Simple Earn totals of the account
+type SimpleEarnAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *SimpleEarnAccountService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnAccountResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnAccountEndpoint,
+		secType:  secTypeSigned,
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnAccountResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnAccountResponse define simple earn account response
+type SimpleEarnAccountResponse struct {
+	TotalAmountInBTC          string `json:"totalAmountInBTC"`
+	TotalAmountInUSDT         string `json:"totalAmountInUSDT"`
+	TotalFlexibleAmountInBTC  string `json:"totalFlexibleAmountInBTC"`
+	TotalFlexibleAmountInUSDT string `json:"totalFlexibleAmountInUSDT"`
+	TotalLockedInBTC          string `json:"totalLockedInBTC"`
+	TotalLockedInUSDT         string `json:"totalLockedInUSDT"`
+}
+
+// Get Flexible Subscription Record (USER_DATA) API Endpoint
+const (
+	simpleEarnFlexibleSubscriptionRecordEndpoint = "/sapi/v1/simple-earn/flexible/history/subscriptionRecord"
+)
+
+// SimpleEarnFlexibleSubscriptionRecordService query the flexible subscription history
+type SimpleEarnFlexibleSubscriptionRecordService struct {
+	c          *Client
+	productId  *string
+	purchaseId *string
+	asset      *string
+	startTime  *uint64
+	endTime    *uint64
+	current    *int64
+	size       *int64
+}
+
+// ProductId set productId
+func (s *SimpleEarnFlexibleSubscriptionRecordService) ProductId(productId string) *SimpleEarnFlexibleSubscriptionRecordService {
+	s.productId = &productId
+	return s
+}
+
+// PurchaseId set purchaseId
+func (s *SimpleEarnFlexibleSubscriptionRecordService) PurchaseId(purchaseId string) *SimpleEarnFlexibleSubscriptionRecordService {
+	s.purchaseId = &purchaseId
+	return s
+}
+
+// Asset set asset
+func (s *SimpleEarnFlexibleSubscriptionRecordService) Asset(asset string) *SimpleEarnFlexibleSubscriptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *SimpleEarnFlexibleSubscriptionRecordService) StartTime(startTime uint64) *SimpleEarnFlexibleSubscriptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *SimpleEarnFlexibleSubscriptionRecordService) EndTime(endTime uint64) *SimpleEarnFlexibleSubscriptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnFlexibleSubscriptionRecordService) Current(current int64) *SimpleEarnFlexibleSubscriptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnFlexibleSubscriptionRecordService) Size(size int64) *SimpleEarnFlexibleSubscriptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexibleSubscriptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleSubscriptionRecordResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnFlexibleSubscriptionRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.productId != nil {
+		r.setParam("productId", *s.productId)
+	}
+	if s.purchaseId != nil {
+		r.setParam("purchaseId", *s.purchaseId)
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleSubscriptionRecordResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleSubscriptionRecordResponse define flexible subscription record response
+type SimpleEarnFlexibleSubscriptionRecordResponse struct {
+	Rows []struct {
+		Amount         string `json:"amount"`
+		Asset          string `json:"asset"`
+		Time           uint64 `json:"time"`
+		PurchaseId     int64  `json:"purchaseId"`
+		ProductId      string `json:"productId"`
+		Type           string `json:"type"`
+		SourceAccount  string `json:"sourceAccount"`
+		AmtFromSpot    string `json:"amtFromSpot"`
+		AmtFromFunding string `json:"amtFromFunding"`
+		Status         string `json:"status"`
+	} `json:"rows"`
+	Total int64 `json:"total"`
+}
+
+// Get Locked Subscription Record (USER_DATA) API Endpoint
+const (
+	simpleEarnLockedSubscriptionRecordEndpoint = "/sapi/v1/simple-earn/locked/history/subscriptionRecord"
+)
+
+// SimpleEarnLockedSubscriptionRecordService query the locked subscription history
+type SimpleEarnLockedSubscriptionRecordService struct {
+	c          *Client
+	purchaseId *string
+	asset      *string
+	startTime  *uint64
+	endTime    *uint64
+	current    *int64
+	size       *int64
+}
+
+// PurchaseId set purchaseId
+func (s *SimpleEarnLockedSubscriptionRecordService) PurchaseId(purchaseId string) *SimpleEarnLockedSubscriptionRecordService {
+	s.purchaseId = &purchaseId
+	return s
+}
+
+// Asset set asset
+func (s *SimpleEarnLockedSubscriptionRecordService) Asset(asset string) *SimpleEarnLockedSubscriptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *SimpleEarnLockedSubscriptionRecordService) StartTime(startTime uint64) *SimpleEarnLockedSubscriptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *SimpleEarnLockedSubscriptionRecordService) EndTime(endTime uint64) *SimpleEarnLockedSubscriptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnLockedSubscriptionRecordService) Current(current int64) *SimpleEarnLockedSubscriptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnLockedSubscriptionRecordService) Size(size int64) *SimpleEarnLockedSubscriptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedSubscriptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedSubscriptionRecordResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnLockedSubscriptionRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.purchaseId != nil {
+		r.setParam("purchaseId", *s.purchaseId)
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedSubscriptionRecordResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedSubscriptionRecordResponse define locked subscription record response
+type SimpleEarnLockedSubscriptionRecordResponse struct {
+	Rows []struct {
+		PositionId     string `json:"positionId"`
+		PurchaseId     int64  `json:"purchaseId"`
+		ProjectId      string `json:"projectId"`
+		Time           uint64 `json:"time"`
+		Asset          string `json:"asset"`
+		Amount         string `json:"amount"`
+		LockPeriod     string `json:"lockPeriod"`
+		Type           string `json:"type"`
+		SourceAccount  string `json:"sourceAccount"`
+		AmtFromSpot    string `json:"amtFromSpot"`
+		AmtFromFunding string `json:"amtFromFunding"`
+		Status         string `json:"status"`
+	} `json:"rows"`
+	Total int64 `json:"total"`
+}
+
+// Get Flexible Redemption Record (USER_DATA) API Endpoint
+const (
+	simpleEarnFlexibleRedemptionRecordEndpoint = "/sapi/v1/simple-earn/flexible/history/redemptionRecord"
+)
+
+// SimpleEarnFlexibleRedemptionRecordService query the flexible redemption history
+type SimpleEarnFlexibleRedemptionRecordService struct {
+	c         *Client
+	productId *string
+	redeemId  *string
+	asset     *string
+	startTime *uint64
+	endTime   *uint64
+	current   *int64
+	size      *int64
+}
+
+// ProductId set productId
+func (s *SimpleEarnFlexibleRedemptionRecordService) ProductId(productId string) *SimpleEarnFlexibleRedemptionRecordService {
+	s.productId = &productId
+	return s
+}
+
+// RedeemId set redeemId
+func (s *SimpleEarnFlexibleRedemptionRecordService) RedeemId(redeemId string) *SimpleEarnFlexibleRedemptionRecordService {
+	s.redeemId = &redeemId
+	return s
+}
+
+// Asset set asset
+func (s *SimpleEarnFlexibleRedemptionRecordService) Asset(asset string) *SimpleEarnFlexibleRedemptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *SimpleEarnFlexibleRedemptionRecordService) StartTime(startTime uint64) *SimpleEarnFlexibleRedemptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *SimpleEarnFlexibleRedemptionRecordService) EndTime(endTime uint64) *SimpleEarnFlexibleRedemptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnFlexibleRedemptionRecordService) Current(current int64) *SimpleEarnFlexibleRedemptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnFlexibleRedemptionRecordService) Size(size int64) *SimpleEarnFlexibleRedemptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexibleRedemptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleRedemptionRecordResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnFlexibleRedemptionRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.productId != nil {
+		r.setParam("productId", *s.productId)
+	}
+	if s.redeemId != nil {
+		r.setParam("redeemId", *s.redeemId)
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleRedemptionRecordResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleRedemptionRecordResponse define flexible redemption record response
+type SimpleEarnFlexibleRedemptionRecordResponse struct {
+	Rows []struct {
+		Amount      string `json:"amount"`
+		Asset       string `json:"asset"`
+		Time        uint64 `json:"time"`
+		ProductId   string `json:"productId"`
+		RedeemId    int64  `json:"redeemId"`
+		DestAccount string `json:"destAccount"`
+		Status      string `json:"status"`
+	} `json:"rows"`
+	Total int64 `json:"total"`
+}
+
+// Get Locked Redemption Record (USER_DATA) API Endpoint
+const (
+	simpleEarnLockedRedemptionRecordEndpoint = "/sapi/v1/simple-earn/locked/history/redemptionRecord"
+)
+
+// SimpleEarnLockedRedemptionRecordService query the locked redemption history
+type SimpleEarnLockedRedemptionRecordService struct {
+	c          *Client
+	positionId *string
+	redeemId   *string
+	asset      *string
+	startTime  *uint64
+	endTime    *uint64
+	current    *int64
+	size       *int64
+}
+
+// PositionId set positionId
+func (s *SimpleEarnLockedRedemptionRecordService) PositionId(positionId string) *SimpleEarnLockedRedemptionRecordService {
+	s.positionId = &positionId
+	return s
+}
+
+// RedeemId set redeemId
+func (s *SimpleEarnLockedRedemptionRecordService) RedeemId(redeemId string) *SimpleEarnLockedRedemptionRecordService {
+	s.redeemId = &redeemId
+	return s
+}
+
+// Asset set asset
+func (s *SimpleEarnLockedRedemptionRecordService) Asset(asset string) *SimpleEarnLockedRedemptionRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *SimpleEarnLockedRedemptionRecordService) StartTime(startTime uint64) *SimpleEarnLockedRedemptionRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *SimpleEarnLockedRedemptionRecordService) EndTime(endTime uint64) *SimpleEarnLockedRedemptionRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnLockedRedemptionRecordService) Current(current int64) *SimpleEarnLockedRedemptionRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnLockedRedemptionRecordService) Size(size int64) *SimpleEarnLockedRedemptionRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedRedemptionRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedRedemptionRecordResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnLockedRedemptionRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.positionId != nil {
+		r.setParam("positionId", *s.positionId)
+	}
+	if s.redeemId != nil {
+		r.setParam("redeemId", *s.redeemId)
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedRedemptionRecordResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedRedemptionRecordResponse define locked redemption record response
+type SimpleEarnLockedRedemptionRecordResponse struct {
+	Rows []struct {
+		PositionId  string `json:"positionId"`
+		RedeemId    int64  `json:"redeemId"`
+		Time        uint64 `json:"time"`
+		Asset       string `json:"asset"`
+		LockPeriod  string `json:"lockPeriod"`
+		Amount      string `json:"amount"`
+		Type        string `json:"type"`
+		DeliverDate string `json:"deliverDate"`
+		Status      string `json:"status"`
+	} `json:"rows"`
+	Total int64 `json:"total"`
+}
+
+// Get Flexible Rewards History (USER_DATA) API Endpoint
+const (
+	simpleEarnFlexibleRewardsRecordEndpoint = "/sapi/v1/simple-earn/flexible/history/rewardsRecord"
+)
+
+// SimpleEarnFlexibleRewardsRecordService query the flexible rewards history
+type SimpleEarnFlexibleRewardsRecordService struct {
+	c          *Client
+	rewardType string
+	productId  *string
+	asset      *string
+	startTime  *uint64
+	endTime    *uint64
+	current    *int64
+	size       *int64
+}
+
+// Type set type, BONUS, REALTIME or REWARDS
+func (s *SimpleEarnFlexibleRewardsRecordService) Type(rewardType string) *SimpleEarnFlexibleRewardsRecordService {
+	s.rewardType = rewardType
+	return s
+}
+
+// ProductId set productId
+func (s *SimpleEarnFlexibleRewardsRecordService) ProductId(productId string) *SimpleEarnFlexibleRewardsRecordService {
+	s.productId = &productId
+	return s
+}
+
+// Asset set asset
+func (s *SimpleEarnFlexibleRewardsRecordService) Asset(asset string) *SimpleEarnFlexibleRewardsRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *SimpleEarnFlexibleRewardsRecordService) StartTime(startTime uint64) *SimpleEarnFlexibleRewardsRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *SimpleEarnFlexibleRewardsRecordService) EndTime(endTime uint64) *SimpleEarnFlexibleRewardsRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnFlexibleRewardsRecordService) Current(current int64) *SimpleEarnFlexibleRewardsRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnFlexibleRewardsRecordService) Size(size int64) *SimpleEarnFlexibleRewardsRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexibleRewardsRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnFlexibleRewardsRecordResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnFlexibleRewardsRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("type", s.rewardType)
+	if s.productId != nil {
+		r.setParam("productId", *s.productId)
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnFlexibleRewardsRecordResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleRewardsRecordResponse define flexible rewards record response
+type SimpleEarnFlexibleRewardsRecordResponse struct {
+	Rows []struct {
+		Asset     string `json:"asset"`
+		Rewards   string `json:"rewards"`
+		ProjectId string `json:"projectId"`
+		Type      string `json:"type"`
+		Time      uint64 `json:"time"`
+	} `json:"rows"`
+	Total int64 `json:"total"`
+}
+
+// Get Locked Rewards History (USER_DATA) API Endpoint
+const (
+	simpleEarnLockedRewardsRecordEndpoint = "/sapi/v1/simple-earn/locked/history/rewardsRecord"
+)
+
+// SimpleEarnLockedRewardsRecordService query the locked rewards history
+type SimpleEarnLockedRewardsRecordService struct {
+	c          *Client
+	positionId *string
+	asset      *string
+	startTime  *uint64
+	endTime    *uint64
+	current    *int64
+	size       *int64
+}
+
+// PositionId set positionId
+func (s *SimpleEarnLockedRewardsRecordService) PositionId(positionId string) *SimpleEarnLockedRewardsRecordService {
+	s.positionId = &positionId
+	return s
+}
+
+// Asset set asset
+func (s *SimpleEarnLockedRewardsRecordService) Asset(asset string) *SimpleEarnLockedRewardsRecordService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set startTime
+func (s *SimpleEarnLockedRewardsRecordService) StartTime(startTime uint64) *SimpleEarnLockedRewardsRecordService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *SimpleEarnLockedRewardsRecordService) EndTime(endTime uint64) *SimpleEarnLockedRewardsRecordService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current
+func (s *SimpleEarnLockedRewardsRecordService) Current(current int64) *SimpleEarnLockedRewardsRecordService {
+	s.current = &current
+	return s
+}
+
+// Size set size
+func (s *SimpleEarnLockedRewardsRecordService) Size(size int64) *SimpleEarnLockedRewardsRecordService {
+	s.size = &size
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedRewardsRecordService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnLockedRewardsRecordResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnLockedRewardsRecordEndpoint,
+		secType:  secTypeSigned,
+	}
+	if s.positionId != nil {
+		r.setParam("positionId", *s.positionId)
+	}
+	if s.asset != nil {
+		r.setParam("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.setParam("current", *s.current)
+	}
+	if s.size != nil {
+		r.setParam("size", *s.size)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnLockedRewardsRecordResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedRewardsRecordResponse define locked rewards record response
+type SimpleEarnLockedRewardsRecordResponse struct {
+	Rows []struct {
+		PositionId string `json:"positionId"`
+		Time       uint64 `json:"time"`
+		Asset      string `json:"asset"`
+		LockPeriod string `json:"lockPeriod"`
+		Amount     string `json:"amount"`
+		Type       string `json:"type"`
+	} `json:"rows"`
+	Total int64 `json:"total"`
+}
+
+// Get Flexible Personal Left Quota (USER_DATA) API Endpoint
+const (
+	simpleEarnFlexiblePersonalLeftQuotaEndpoint = "/sapi/v1/simple-earn/flexible/personalLeftQuota"
+)
+
+// SimpleEarnFlexiblePersonalLeftQuotaService query how much more of a flexible product the account can subscribe
+type SimpleEarnFlexiblePersonalLeftQuotaService struct {
+	c         *Client
+	productId string
+}
+
+// ProductId set productId
+func (s *SimpleEarnFlexiblePersonalLeftQuotaService) ProductId(productId string) *SimpleEarnFlexiblePersonalLeftQuotaService {
+	s.productId = productId
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnFlexiblePersonalLeftQuotaService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnPersonalLeftQuotaResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnFlexiblePersonalLeftQuotaEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("productId", s.productId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnPersonalLeftQuotaResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Get Locked Personal Left Quota (USER_DATA) API Endpoint
+const (
+	simpleEarnLockedPersonalLeftQuotaEndpoint = "/sapi/v1/simple-earn/locked/personalLeftQuota"
+)
+
+// SimpleEarnLockedPersonalLeftQuotaService query how much more of a locked product the account can subscribe
+type SimpleEarnLockedPersonalLeftQuotaService struct {
+	c         *Client
+	projectId string
+}
+
+// ProjectId set projectId
+func (s *SimpleEarnLockedPersonalLeftQuotaService) ProjectId(projectId string) *SimpleEarnLockedPersonalLeftQuotaService {
+	s.projectId = projectId
+	return s
+}
+
+// Do send request
+func (s *SimpleEarnLockedPersonalLeftQuotaService) Do(ctx context.Context, opts ...RequestOption) (res *SimpleEarnPersonalLeftQuotaResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: simpleEarnLockedPersonalLeftQuotaEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("projectId", s.projectId)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SimpleEarnPersonalLeftQuotaResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnPersonalLeftQuotaResponse define personal left quota response
+type SimpleEarnPersonalLeftQuotaResponse struct {
+	LeftPersonalQuota string `json:"leftPersonalQuota"`
+}
@@ -0,0 +1,268 @@
+package binance_connector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type simpleEarnTestSuite struct {
+	baseTestSuite
+}
+
+func TestSimpleEarn(t *testing.T) {
+	suite.Run(t, new(simpleEarnTestSuite))
+}
+
+func (s *simpleEarnTestSuite) TestFlexibleProductList() {
+	data := []byte(`{
+		"rows": [
+			{
+				"asset": "USDT",
+				"latestAnnualPercentageRate": "0.05",
+				"tierAnnualPercentageRate": {
+					"0-5BTC": "0.05",
+					"5-10BTC": "0.03"
+				},
+				"airDropPercentageRate": "0.05",
+				"canPurchase": true,
+				"canRedeem": true,
+				"isSoldOut": false,
+				"hot": true,
+				"minPurchaseAmount": "0.01",
+				"productId": "USDT001",
+				"subscriptionStartTime": 1646182276000,
+				"status": "PURCHASING"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset":   "USDT",
+			"current": 1,
+			"size":    10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnFlexibleProductListService().Asset("USDT").Current(1).Size(10).Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(1), resp.Total)
+	s.Len(resp.Rows, 1)
+	s.Equal("USDT001", resp.Rows[0].ProductId)
+	s.Equal("0.03", resp.Rows[0].TierAnnualPercentageRate["5-10BTC"])
+	s.True(resp.Rows[0].CanPurchase)
+}
+
+func (s *simpleEarnTestSuite) TestLockedProductList() {
+	data := []byte(`{
+		"rows": [
+			{
+				"projectId": "Axs*90",
+				"detail": {
+					"asset": "AXS",
+					"rewardAsset": "AXS",
+					"duration": 90,
+					"renewable": true,
+					"isSoldOut": true,
+					"apr": "1.2069",
+					"status": "CREATED",
+					"subscriptionStartTime": 1646182276000,
+					"extraRewardAsset": "BNB",
+					"extraRewardAPR": "0.23"
+				},
+				"quota": {
+					"totalPersonalQuota": "2",
+					"minimum": "0.001"
+				}
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset": "AXS",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnLockedProductListService().Asset("AXS").Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp.Rows, 1)
+	s.Equal("Axs*90", resp.Rows[0].ProjectId)
+	s.Equal(int64(90), resp.Rows[0].Detail.Duration)
+	s.Equal("2", resp.Rows[0].Quota.TotalPersonalQuota)
+}
+
+func (s *simpleEarnTestSuite) TestFlexibleSubscribe() {
+	data := []byte(`{
+		"purchaseId": 40607,
+		"success": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"productId":     "USDT001",
+			"amount":        "100.5",
+			"autoSubscribe": true,
+			"sourceAccount": "FUND",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnFlexibleSubscribeService().ProductId("USDT001").Amount(100.5).
+		AutoSubscribe(true).SourceAccount("FUND").Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(40607), resp.PurchaseId)
+	s.True(resp.Success)
+}
+
+func (s *simpleEarnTestSuite) TestLockedSubscribe() {
+	data := []byte(`{
+		"purchaseId": 40607,
+		"positionId": "12345",
+		"success": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"projectId": "Axs*90",
+			"amount":    "1",
+			"redeemTo":  "FLEXIBLE",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnLockedSubscribeService().ProjectId("Axs*90").Amount(1).
+		RedeemTo("FLEXIBLE").Do(newContext())
+	s.r().NoError(err)
+	s.Equal("12345", resp.PositionId)
+	s.True(resp.Success)
+}
+
+func (s *simpleEarnTestSuite) TestFlexibleRedeem() {
+	data := []byte(`{
+		"redeemId": 40607,
+		"success": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"productId":   "USDT001",
+			"redeemAll":   true,
+			"destAccount": "SPOT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnFlexibleRedeemService().ProductId("USDT001").RedeemAll(true).
+		DestAccount("SPOT").Do(newContext())
+	s.r().NoError(err)
+	s.Equal(int64(40607), resp.RedeemId)
+	s.True(resp.Success)
+}
+
+func (s *simpleEarnTestSuite) TestFlexiblePosition() {
+	data := []byte(`{
+		"rows": [
+			{
+				"totalAmount": "75.46000000",
+				"tierAnnualPercentageRate": {
+					"0-5BTC": "0.05"
+				},
+				"latestAnnualPercentageRate": "0.02599895",
+				"yesterdayAirdropPercentageRate": "0.02599895",
+				"asset": "USDT",
+				"airDropAsset": "BETH",
+				"canRedeem": true,
+				"collateralAmount": "232.23123213",
+				"productId": "USDT001",
+				"yesterdayRealTimeRewards": "0.10293829",
+				"cumulativeBonusRewards": "0.22759183",
+				"cumulativeRealTimeRewards": "0.22759183",
+				"cumulativeTotalRewards": "0.45459183",
+				"autoSubscribe": true
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"asset": "USDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnFlexiblePositionService().Asset("USDT").Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp.Rows, 1)
+	s.Equal("75.46000000", resp.Rows[0].TotalAmount)
+	s.Equal("0.45459183", resp.Rows[0].CumulativeTotalRewards)
+}
+
+func (s *simpleEarnTestSuite) TestFlexibleRewardsRecord() {
+	data := []byte(`{
+		"rows": [
+			{
+				"asset": "BUSD",
+				"rewards": "0.00006408",
+				"projectId": "USDT001",
+				"type": "BONUS",
+				"time": 1577233578000
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"type":      "BONUS",
+			"startTime": 1577233578000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnFlexibleRewardsRecordService().Type("BONUS").StartTime(1577233578000).
+		Do(newContext())
+	s.r().NoError(err)
+	s.Len(resp.Rows, 1)
+	s.Equal("0.00006408", resp.Rows[0].Rewards)
+	s.Equal(uint64(1577233578000), resp.Rows[0].Time)
+}
+
+func (s *simpleEarnTestSuite) TestLockedPersonalLeftQuota() {
+	data := []byte(`{
+		"leftPersonalQuota": "1000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"projectId": "Axs*90",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewSimpleEarnLockedPersonalLeftQuotaService().ProjectId("Axs*90").Do(newContext())
+	s.r().NoError(err)
+	s.Equal("1000", resp.LeftPersonalQuota)
+}
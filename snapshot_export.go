@@ -0,0 +1,349 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LedgerKind define the kind of an AccountLedgerEntry
+type LedgerKind string
+
+// Ledger entry kinds, in the order of entries with the same time
+const (
+	// LedgerKindBalance is the balance of an asset in a daily snapshot
+	LedgerKindBalance LedgerKind = "BALANCE"
+	// LedgerKindPosition is a futures position in a daily snapshot
+	LedgerKindPosition LedgerKind = "POSITION"
+	// LedgerKindTotal is the net value in BTC of a spot or margin daily snapshot
+	LedgerKindTotal LedgerKind = "TOTAL"
+	// LedgerKindDividend is a distribution of AssetDividendRecordService
+	LedgerKindDividend LedgerKind = "DIVIDEND"
+	// LedgerKindDust is a conversion of DustLogService, one entry debits the
+	// converted asset and one credits BNB
+	LedgerKindDust LedgerKind = "DUST"
+	// LedgerKindIncome is a record of FuturesGetIncomeService
+	LedgerKindIncome LedgerKind = "INCOME"
+)
+
+var ledgerKinds = []LedgerKind{
+	LedgerKindBalance, LedgerKindPosition, LedgerKindTotal, LedgerKindDividend, LedgerKindDust, LedgerKindIncome,
+}
+
+// AccountLedgerEntry define a row of the ledger written by
+// AccountSnapshotExporter. Amounts are the strings returned by the API.
+// Snapshot entries carry balances, record entries carry the Amount credited,
+// negative when debited.
+type AccountLedgerEntry struct {
+	Time          int64      `json:"time"`
+	Kind          LedgerKind `json:"kind"`
+	Market        string     `json:"market"` // AccountSnapshotTypeSpot, AccountSnapshotTypeMargin or AccountSnapshotTypeFutures
+	Asset         string     `json:"asset,omitempty"`
+	Symbol        string     `json:"symbol,omitempty"`
+	Free          string     `json:"free,omitempty"`
+	Locked        string     `json:"locked,omitempty"`
+	Borrowed      string     `json:"borrowed,omitempty"`
+	Interest      string     `json:"interest,omitempty"`
+	NetAsset      string     `json:"netAsset,omitempty"`
+	WalletBalance string     `json:"walletBalance,omitempty"`
+	MarginBalance string     `json:"marginBalance,omitempty"`
+	Amount        string     `json:"amount,omitempty"`
+	Fee           string     `json:"fee,omitempty"`
+	Price         string     `json:"price,omitempty"` // mark price of a position
+	UnrealizedPnl string     `json:"unrealizedPnl,omitempty"`
+	Id            string     `json:"id,omitempty"`
+	Info          string     `json:"info,omitempty"`
+}
+
+// ledgerColumns are the CSV columns of WriteLedgerCSV
+var ledgerColumns = []string{"time", "kind", "market", "asset", "symbol", "free", "locked", "borrowed", "interest",
+	"netAsset", "walletBalance", "marginBalance", "amount", "fee", "price", "unrealizedPnl", "id", "info"}
+
+func (e *AccountLedgerEntry) record() []string {
+	return []string{time.UnixMilli(e.Time).UTC().Format("2006-01-02T15:04:05.000Z"), string(e.Kind), e.Market, e.Asset,
+		e.Symbol, e.Free, e.Locked, e.Borrowed, e.Interest, e.NetAsset, e.WalletBalance, e.MarginBalance, e.Amount,
+		e.Fee, e.Price, e.UnrealizedPnl, e.Id, e.Info}
+}
+
+// LedgerFormat define the format of AccountSnapshotExporter.Export
+type LedgerFormat string
+
+// Ledger formats
+const (
+	LedgerFormatCSV  LedgerFormat = "csv"
+	LedgerFormatJSON LedgerFormat = "json"
+)
+
+// AccountSnapshotExporter builds daily balance ledgers for accounting from
+// the daily snapshots of GetAccountSnapshotService, merged with the records
+// of AssetDividendRecordService, DustLogService and FuturesGetIncomeService.
+// Every source is read over the whole date range, split into the windows
+// accepted by its endpoint.
+//
+// Binance keeps the daily snapshots of the last month only: a longer history
+// is built by exporting every month and appending the ledgers.
+type AccountSnapshotExporter struct {
+	// Markets are the snapshot market types exported, AccountSnapshotTypeSpot by default
+	Markets []string
+	// Dividends and Dust include the dividend and dust conversion records, true by default
+	Dividends bool
+	Dust      bool
+	// FuturesClient reads the futures income, it needs the
+	// https://fapi.binance.com base URL. The income is skipped when nil.
+	FuturesClient *Client
+	// Options are given to the iterators reading the sources
+	Options []IteratorOption
+
+	c *Client
+}
+
+// NewAccountSnapshotExporter create an AccountSnapshotExporter reading with c
+func NewAccountSnapshotExporter(c *Client) *AccountSnapshotExporter {
+	return &AccountSnapshotExporter{
+		Markets:   []string{AccountSnapshotTypeSpot},
+		Dividends: true,
+		Dust:      true,
+		c:         c,
+	}
+}
+
+// Entries read the ledger entries between from and to, sorted by time. When
+// some sources cannot be read, the entries of the other sources are returned
+// with an error joining the failures.
+func (e *AccountSnapshotExporter) Entries(ctx context.Context, from, to time.Time) ([]*AccountLedgerEntry, error) {
+	var sources []func(context.Context, time.Time, time.Time) ([]*AccountLedgerEntry, error)
+	var names []string
+	for _, market := range e.Markets {
+		sources = append(sources, func(ctx context.Context, from, to time.Time) ([]*AccountLedgerEntry, error) {
+			return e.snapshots(ctx, market, from, to)
+		})
+		names = append(names, market+" snapshots")
+	}
+	if e.Dividends {
+		sources = append(sources, e.dividends)
+		names = append(names, "dividends")
+	}
+	if e.Dust {
+		sources = append(sources, e.dust)
+		names = append(names, "dust")
+	}
+	if e.FuturesClient != nil {
+		sources = append(sources, e.income)
+		names = append(names, "futures income")
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		entries []*AccountLedgerEntry
+		errs    []error
+	)
+	for i, source := range sources {
+		wg.Add(1)
+		go func(i int, source func(context.Context, time.Time, time.Time) ([]*AccountLedgerEntry, error)) {
+			defer wg.Done()
+			res, err := source(ctx, from, to)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", names[i], err))
+			}
+			entries = append(entries, res...)
+		}(i, source)
+	}
+	wg.Wait()
+	sortLedger(entries)
+	return entries, errors.Join(errs...)
+}
+
+// Export write the ledger entries between from and to to w. Nothing is
+// written when a source cannot be read.
+func (e *AccountSnapshotExporter) Export(ctx context.Context, w io.Writer, format LedgerFormat, from, to time.Time) error {
+	if format != LedgerFormatCSV && format != LedgerFormatJSON {
+		return fmt.Errorf("unknown ledger format %q", format)
+	}
+	entries, err := e.Entries(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	if format == LedgerFormatCSV {
+		return WriteLedgerCSV(w, entries)
+	}
+	return WriteLedgerJSON(w, entries)
+}
+
+// WriteLedgerCSV write entries to w as CSV with a header row. Times are
+// written in UTC.
+func WriteLedgerCSV(w io.Writer, entries []*AccountLedgerEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ledgerColumns); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := cw.Write(entry.record()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteLedgerJSON write entries to w as a JSON array
+func WriteLedgerJSON(w io.Writer, entries []*AccountLedgerEntry) error {
+	if entries == nil {
+		entries = []*AccountLedgerEntry{}
+	}
+	return json.NewEncoder(w).Encode(entries)
+}
+
+func (e *AccountSnapshotExporter) snapshots(ctx context.Context, market string, from, to time.Time) ([]*AccountLedgerEntry, error) {
+	var res []*AccountLedgerEntry
+	it := e.c.NewGetAccountSnapshotService().MarketType(market).Range(ctx, from, to, e.Options...)
+	for it.Next() {
+		entries, err := snapshotEntries(it.Value())
+		if err != nil {
+			return res, err
+		}
+		res = append(res, entries...)
+	}
+	return res, it.Err()
+}
+
+// snapshotEntries returns the entries of a snapshot, leaving out zero balances
+func snapshotEntries(snapshot *AccountSnapshot) ([]*AccountLedgerEntry, error) {
+	var res []*AccountLedgerEntry
+	var amounts amountParser
+	t, market := int64(snapshot.UpdateTime), snapshot.Type
+	switch market {
+	case AccountSnapshotTypeSpot:
+		data, err := snapshot.SpotData()
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range data.Balances {
+			if !amounts.zero(b.Free, b.Locked) {
+				res = append(res, &AccountLedgerEntry{Time: t, Kind: LedgerKindBalance, Market: market, Asset: b.Asset, Free: b.Free, Locked: b.Locked})
+			}
+		}
+		res = append(res, &AccountLedgerEntry{Time: t, Kind: LedgerKindTotal, Market: market, Asset: "BTC", Amount: data.TotalAssetOfBtc})
+	case AccountSnapshotTypeMargin:
+		data, err := snapshot.MarginData()
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range data.UserAssets {
+			if !amounts.zero(a.Free, a.Locked, a.Borrowed, a.Interest) {
+				res = append(res, &AccountLedgerEntry{Time: t, Kind: LedgerKindBalance, Market: market, Asset: a.Asset, Free: a.Free,
+					Locked: a.Locked, Borrowed: a.Borrowed, Interest: a.Interest, NetAsset: a.NetAsset})
+			}
+		}
+		res = append(res, &AccountLedgerEntry{Time: t, Kind: LedgerKindTotal, Market: market, Asset: "BTC", Amount: data.TotalNetAssetOfBtc,
+			Borrowed: data.TotalLiabilityOfBtc, Info: "marginLevel=" + data.MarginLevel})
+	case AccountSnapshotTypeFutures:
+		data, err := snapshot.FuturesData()
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range data.Assets {
+			if !amounts.zero(a.WalletBalance, a.MarginBalance) {
+				res = append(res, &AccountLedgerEntry{Time: t, Kind: LedgerKindBalance, Market: market, Asset: a.Asset,
+					WalletBalance: a.WalletBalance, MarginBalance: a.MarginBalance})
+			}
+		}
+		for _, p := range data.Position {
+			if !amounts.zero(p.PositionAmt) {
+				res = append(res, &AccountLedgerEntry{Time: t, Kind: LedgerKindPosition, Market: market, Symbol: p.Symbol,
+					Amount: p.PositionAmt, Price: p.MarkPrice, UnrealizedPnl: p.UnRealizedProfit, Info: "entryPrice=" + p.EntryPrice})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown snapshot type %q", market)
+	}
+	if amounts.err != nil {
+		return nil, fmt.Errorf("snapshot of %s: %w", time.UnixMilli(t).UTC().Format(time.DateOnly), amounts.err)
+	}
+	return res, nil
+}
+
+func (e *AccountSnapshotExporter) dividends(ctx context.Context, from, to time.Time) ([]*AccountLedgerEntry, error) {
+	var res []*AccountLedgerEntry
+	it := e.c.NewAssetDividendRecordService().Range(ctx, from, to, e.Options...)
+	for it.Next() {
+		d := it.Value()
+		res = append(res, &AccountLedgerEntry{Time: int64(d.DivTime), Kind: LedgerKindDividend, Market: AccountSnapshotTypeSpot,
+			Asset: d.Asset, Amount: d.Amount, Id: strconv.FormatInt(d.TranId, 10), Info: d.EnInfo})
+	}
+	return res, it.Err()
+}
+
+func (e *AccountSnapshotExporter) dust(ctx context.Context, from, to time.Time) ([]*AccountLedgerEntry, error) {
+	var res []*AccountLedgerEntry
+	it := e.c.NewDustLogService().Range(ctx, from, to, e.Options...)
+	for it.Next() {
+		for _, d := range it.Value().UserAssetDribbletDetails {
+			id := strconv.FormatInt(d.TransId, 10)
+			amount, err := negateAmount(d.Amount)
+			if err != nil {
+				return res, fmt.Errorf("dust conversion %s: %w", id, err)
+			}
+			res = append(res,
+				&AccountLedgerEntry{Time: int64(d.OperateTime), Kind: LedgerKindDust, Market: AccountSnapshotTypeSpot,
+					Asset: d.FromAsset, Amount: amount, Id: id},
+				&AccountLedgerEntry{Time: int64(d.OperateTime), Kind: LedgerKindDust, Market: AccountSnapshotTypeSpot,
+					Asset: "BNB", Amount: d.TransferedAmount, Fee: d.ServiceChargeAmount, Id: id, Info: "from " + d.FromAsset})
+		}
+	}
+	return res, it.Err()
+}
+
+func (e *AccountSnapshotExporter) income(ctx context.Context, from, to time.Time) ([]*AccountLedgerEntry, error) {
+	var res []*AccountLedgerEntry
+	it := e.FuturesClient.NewFuturesGetIncomeService().Range(ctx, from, to, e.Options...)
+	for it.Next() {
+		i := it.Value()
+		res = append(res, &AccountLedgerEntry{Time: i.Time, Kind: LedgerKindIncome, Market: AccountSnapshotTypeFutures,
+			Asset: i.Asset, Symbol: i.Symbol, Amount: i.Income, Id: strconv.FormatInt(i.TranID, 10), Info: i.IncomeType})
+	}
+	return res, it.Err()
+}
+
+func sortLedger(entries []*AccountLedgerEntry) {
+	order := make(map[LedgerKind]int, len(ledgerKinds))
+	for i, kind := range ledgerKinds {
+		order[kind] = i
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		switch {
+		case a.Time != b.Time:
+			return a.Time < b.Time
+		case a.Market != b.Market:
+			return a.Market > b.Market // SPOT, MARGIN, FUTURES
+		case a.Kind != b.Kind:
+			return order[a.Kind] < order[b.Kind]
+		case a.Symbol != b.Symbol:
+			return a.Symbol < b.Symbol
+		}
+		return a.Asset < b.Asset
+	})
+}
+
+// negateAmount negates a decimal string without converting it to a float
+func negateAmount(amount string) (string, error) {
+	var amounts amountParser
+	switch {
+	case amounts.zero(amount):
+		return amount, amounts.err
+	case strings.HasPrefix(amount, "-"):
+		return amount[1:], nil
+	}
+	return "-" + amount, nil
+}
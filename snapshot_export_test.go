@@ -0,0 +1,208 @@
+package binance_connector
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+	"github.com/stretchr/testify/suite"
+)
+
+type snapshotExportTestSuite struct {
+	suite.Suite
+	exchange *snapshotExchange
+	exporter *AccountSnapshotExporter
+	from     time.Time
+}
+
+func TestAccountSnapshotExporter(t *testing.T) {
+	suite.Run(t, new(snapshotExportTestSuite))
+}
+
+// snapshotExchange fakes the snapshot and record endpoints, with a daily
+// snapshot at the end of every day
+type snapshotExchange struct {
+	mu      sync.Mutex
+	calls   map[string]int
+	failing string // path answered with an error
+}
+
+// queryRange returns the startTime and endTime of req
+func queryRange(req *http.Request) (start, end int64) {
+	start, _ = strconv.ParseInt(req.URL.Query().Get("startTime"), 10, 64)
+	end, _ = strconv.ParseInt(req.URL.Query().Get("endTime"), 10, 64)
+	return start, end
+}
+
+func (e *snapshotExchange) client() *Client {
+	routes := fakeRoutes{
+		"/sapi/v1/accountSnapshot": func(req *http.Request) (interface{}, error) {
+			start, end := queryRange(req)
+			res := AccountSnapshotResponse{Code: 200, Msg: "success"}
+			day := time.UnixMilli(start).UTC().Truncate(24 * time.Hour)
+			for ; FormatTimestamp(day) <= end; day = day.Add(24 * time.Hour) {
+				t := FormatTimestamp(day.Add(24*time.Hour - time.Millisecond))
+				if t < start || t > end {
+					continue
+				}
+				snapshot := &AccountSnapshot{Type: req.URL.Query().Get("type"), UpdateTime: uint64(t)}
+				switch snapshot.Type {
+				case AccountSnapshotTypeSpot:
+					snapshot.Data, _ = json.Marshal(SpotSnapshotData{TotalAssetOfBtc: "0.5", Balances: []SpotSnapshotBalance{
+						{Asset: "BTC", Free: "0.4", Locked: "0.1"},
+						{Asset: "LTC", Free: "0.00000000", Locked: "0.00000000"},
+					}})
+				case AccountSnapshotTypeFutures:
+					snapshot.Data, _ = json.Marshal(FuturesSnapshotData{
+						Assets: []FuturesSnapshotAsset{{Asset: "USDT", MarginBalance: "110", WalletBalance: "100"}},
+						Position: []FuturesSnapshotPosition{{Symbol: "BTCUSDT", PositionAmt: "0.01", EntryPrice: "30000",
+							MarkPrice: "31000", UnRealizedProfit: "10"}},
+					})
+				}
+				res.SnapshotVos = append(res.SnapshotVos, snapshot)
+			}
+			return res, nil
+		},
+		"/sapi/v1/asset/assetDividend": func(req *http.Request) (interface{}, error) {
+			start, end := queryRange(req)
+			t := FormatTimestamp(time.Date(2023, 1, 10, 2, 0, 0, 0, time.UTC))
+			res := AssetDividendRecordResponse{}
+			if t >= start && t <= end {
+				res.Rows = []*AssetDividendRecord{{Id: 1, TranId: 11, Asset: "BNB", Amount: "0.001", DivTime: uint64(t), EnInfo: "BNB Vault"}}
+			}
+			return res, nil
+		},
+		"/sapi/v1/asset/dribblet": func(req *http.Request) (interface{}, error) {
+			start, end := queryRange(req)
+			t := FormatTimestamp(time.Date(2023, 2, 1, 3, 0, 0, 0, time.UTC))
+			res := DustLogResponse{}
+			if t >= start && t <= end {
+				res.UserAssetDribblets = []*DustLogDribblet{{TransId: 7, OperateTime: uint64(t), UserAssetDribbletDetails: []DustLogDetail{
+					{TransId: 7, FromAsset: "LTC", Amount: "0.002", TransferedAmount: "0.0001", ServiceChargeAmount: "0.000002", OperateTime: uint64(t)},
+				}}}
+			}
+			return res, nil
+		},
+		"/fapi/v1/income": func(req *http.Request) (interface{}, error) {
+			start, end := queryRange(req)
+			t := FormatTimestamp(time.Date(2023, 1, 20, 8, 0, 0, 0, time.UTC))
+			res := []*Income{}
+			if t >= start && t <= end {
+				res = append(res, &Income{Symbol: "BTCUSDT", IncomeType: "FUNDING_FEE", Income: "-0.5", Asset: "USDT", Time: t, TranID: 99})
+			}
+			return res, nil
+		},
+	}
+	return newFakeClient(func(req *http.Request) (interface{}, error) {
+		e.mu.Lock()
+		e.calls[req.URL.Path]++
+		failing := req.URL.Path == e.failing
+		e.mu.Unlock()
+		if failing {
+			return nil, &handlers.APIError{Code: -1000, Message: "An unknown error occurred while processing the request."}
+		}
+		return routes.serve(req)
+	})
+}
+
+func (s *snapshotExportTestSuite) SetupTest() {
+	s.exchange = &snapshotExchange{calls: make(map[string]int)}
+	s.exporter = NewAccountSnapshotExporter(s.exchange.client())
+	s.from = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *snapshotExportTestSuite) TestEntries() {
+	r := s.Require()
+	s.exporter.Markets = []string{AccountSnapshotTypeSpot, AccountSnapshotTypeFutures}
+	s.exporter.FuturesClient = s.exchange.client()
+
+	entries, err := s.exporter.Entries(context.Background(), s.from, s.from.AddDate(0, 0, 70).Add(-time.Millisecond))
+	r.NoError(err)
+	// 70 days read in 30 day windows
+	r.Equal(3*2, s.exchange.calls["/sapi/v1/accountSnapshot"])
+
+	count := make(map[LedgerKind]int)
+	for _, entry := range entries {
+		count[entry.Kind]++
+	}
+	// the zero LTC balance is left out
+	r.Equal(map[LedgerKind]int{LedgerKindBalance: 140, LedgerKindPosition: 70, LedgerKindTotal: 70,
+		LedgerKindDividend: 1, LedgerKindDust: 2, LedgerKindIncome: 1}, count)
+
+	first := entries[:4]
+	r.Equal(AccountLedgerEntry{Time: FormatTimestamp(s.from.Add(24*time.Hour - time.Millisecond)), Kind: LedgerKindBalance,
+		Market: AccountSnapshotTypeSpot, Asset: "BTC", Free: "0.4", Locked: "0.1"}, *first[0])
+	r.Equal(LedgerKindTotal, first[1].Kind)
+	r.Equal("0.5", first[1].Amount)
+	r.Equal(AccountSnapshotTypeFutures, first[2].Market)
+	r.Equal("100", first[2].WalletBalance)
+	r.Equal(LedgerKindPosition, first[3].Kind)
+	r.Equal("31000", first[3].Price)
+
+	for i := 1; i < len(entries); i++ {
+		r.LessOrEqual(entries[i-1].Time, entries[i].Time)
+	}
+	var dust []*AccountLedgerEntry
+	for _, entry := range entries {
+		if entry.Kind == LedgerKindDust {
+			dust = append(dust, entry)
+		}
+	}
+	r.Equal("0.0001", dust[0].Amount)
+	r.Equal("0.000002", dust[0].Fee)
+	r.Equal("LTC", dust[1].Asset)
+	r.Equal("-0.002", dust[1].Amount)
+}
+
+func (s *snapshotExportTestSuite) TestPartialFailure() {
+	r := s.Require()
+	s.exchange.failing = "/sapi/v1/asset/dribblet"
+	entries, err := s.exporter.Entries(context.Background(), s.from, s.from.AddDate(0, 0, 15))
+	r.ErrorContains(err, "dust")
+	r.Len(entries, 15*2+1)
+
+	var buf bytes.Buffer
+	r.Error(s.exporter.Export(context.Background(), &buf, LedgerFormatCSV, s.from, s.from.AddDate(0, 0, 15)))
+	r.Zero(buf.Len())
+}
+
+func (s *snapshotExportTestSuite) TestInvalidAmount() {
+	r := s.Require()
+	snapshot := &AccountSnapshot{Type: AccountSnapshotTypeSpot, UpdateTime: uint64(FormatTimestamp(s.from))}
+	snapshot.Data, _ = json.Marshal(SpotSnapshotData{Balances: []SpotSnapshotBalance{{Asset: "BTC", Free: "0.4", Locked: "-"}}})
+	_, err := snapshotEntries(snapshot)
+	r.EqualError(err, `snapshot of 2023-01-01: invalid amount "-"`)
+
+	_, err = negateAmount("0.1.0")
+	r.Error(err)
+}
+
+func (s *snapshotExportTestSuite) TestExport() {
+	r := s.Require()
+	to := s.from.AddDate(0, 0, 10)
+
+	var buf bytes.Buffer
+	r.NoError(s.exporter.Export(context.Background(), &buf, LedgerFormatCSV, s.from, to))
+	rows, err := csv.NewReader(&buf).ReadAll()
+	r.NoError(err)
+	r.Equal(ledgerColumns, rows[0])
+	r.Len(rows, 1+10*2+1)
+	r.Equal([]string{"2023-01-01T23:59:59.999Z", "BALANCE", "SPOT", "BTC", "", "0.4", "0.1", "", "", "", "", "", "", "", "", "", "", ""}, rows[1])
+	// the dividend of January 10 precedes the snapshot of the day
+	r.Equal("DIVIDEND", rows[len(rows)-3][1])
+
+	buf.Reset()
+	r.NoError(s.exporter.Export(context.Background(), &buf, LedgerFormatJSON, s.from, to))
+	var entries []*AccountLedgerEntry
+	r.NoError(json.Unmarshal(buf.Bytes(), &entries))
+	r.Len(entries, 10*2+1)
+
+	r.Error(s.exporter.Export(context.Background(), &buf, "xml", s.from, to))
+}
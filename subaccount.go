@@ -3,8 +3,10 @@ package binance_connector
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 // Create a Virtual Sub-account(For Master Account)
@@ -85,16 +87,16 @@ func (s *QuerySubAccountListService) Do(ctx context.Context, opts ...RequestOpti
 		secType:  secTypeSigned,
 	}
 	if s.email != nil {
-		r.setParam("email", s.email)
+		r.setParam("email", *s.email)
 	}
 	if s.isFreeze != nil {
-		r.setParam("isFreeze", s.isFreeze)
+		r.setParam("isFreeze", *s.isFreeze)
 	}
 	if s.page != nil {
-		r.setParam("page", s.page)
+		r.setParam("page", *s.page)
 	}
 	if s.limit != nil {
-		r.setParam("limit", s.limit)
+		r.setParam("limit", *s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -184,10 +186,10 @@ func (s *QuerySubAccountSpotAssetTransferHistoryService) Do(ctx context.Context,
 		r.setParam("endTime", s.endTime)
 	}
 	if s.page != nil {
-		r.setParam("page", s.page)
+		r.setParam("page", *s.page)
 	}
 	if s.limit != nil {
-		r.setParam("limit", s.limit)
+		r.setParam("limit", *s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -273,10 +275,10 @@ func (s *QuerySubAccountFuturesAssetTransferHistoryService) Do(ctx context.Conte
 		r.setParam("endTime", s.endTime)
 	}
 	if s.page != nil {
-		r.setParam("page", s.page)
+		r.setParam("page", *s.page)
 	}
 	if s.limit != nil {
-		r.setParam("limit", s.limit)
+		r.setParam("limit", *s.limit)
 	}
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -590,7 +592,9 @@ func (s *GetSubAccountDepositHistoryService) Do(ctx context.Context, opts ...Req
 		secType:  secTypeSigned,
 	}
 	r.setParam("email", s.email)
-	r.setParam("coin", s.coin)
+	if s.coin != "" {
+		r.setParam("coin", s.coin)
+	}
 	if s.status != nil {
 		r.setParam("status", *s.status)
 	}
@@ -618,22 +622,60 @@ func (s *GetSubAccountDepositHistoryService) Do(ctx context.Context, opts ...Req
 	return res, nil
 }
 
+// Range returns an iterator over every deposit of the sub-account made
+// between from and to, splitting the range into 7 day windows
+func (s *GetSubAccountDepositHistoryService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*SubAccountDeposit] {
+	p := &windowPager[*SubAccountDeposit]{
+		window: 7 * 24 * time.Hour,
+		limit:  500,
+		key:    func(d *SubAccountDeposit) any { return d.Id },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*SubAccountDeposit, error) {
+			svc := *s
+			res, err := svc.StartTime(start).EndTime(end).Offset(int64(page * 500)).Limit(500).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return res.DepositList, nil
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 type GetSubAccountDepositHistoryResp struct {
-	DepositList []struct {
-		Id            int64  `json:"id"`
-		Amount        string `json:"amount"`
-		Coin          string `json:"coin"`
-		Network       string `json:"network"`
-		Status        int64  `json:"status"`
-		Address       string `json:"address"`
-		AddressTag    string `json:"addressTag"`
-		TxId          string `json:"txId"`
-		InsertTime    uint64 `json:"insertTime"`
-		TransferType  int64  `json:"transferType"`
-		ConfirmTimes  string `json:"confirmTimes"`
-		UnlockConfirm int64  `json:"unlockConfirm"`
-		WalletType    int    `json:"walletType"`
+	DepositList []*SubAccountDeposit `json:"depositList"`
+}
+
+// UnmarshalJSON accepts both the plain array returned by the endpoint and an
+// object with a depositList field
+func (r *GetSubAccountDepositHistoryResp) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, &r.DepositList)
+	}
+	var resp struct {
+		DepositList []*SubAccountDeposit `json:"depositList"`
 	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+	r.DepositList = resp.DepositList
+	return nil
+}
+
+// SubAccountDeposit define a deposit of GetSubAccountDepositHistoryResp
+type SubAccountDeposit struct {
+	Id            int64  `json:"id"`
+	Amount        string `json:"amount"`
+	Coin          string `json:"coin"`
+	Network       string `json:"network"`
+	Status        int64  `json:"status"`
+	Address       string `json:"address"`
+	AddressTag    string `json:"addressTag"`
+	TxId          string `json:"txId"`
+	InsertTime    uint64 `json:"insertTime"`
+	TransferType  int64  `json:"transferType"`
+	ConfirmTimes  string `json:"confirmTimes"`
+	UnlockConfirm int64  `json:"unlockConfirm"`
+	WalletType    int    `json:"walletType"`
 }
 
 // Get Sub-account's Status on Margin/Futures (For Master Account)
@@ -682,6 +724,23 @@ type GetSubAccountStatusResp struct {
 	Mobile           int64  `json:"mobile"`
 }
 
+// UnmarshalJSON accepts both a single status and the array of statuses
+// returned by the endpoint, keeping the first one
+func (r *GetSubAccountStatusResp) UnmarshalJSON(data []byte) error {
+	type status GetSubAccountStatusResp
+	if len(data) > 0 && data[0] == '[' {
+		var statuses []status
+		if err := json.Unmarshal(data, &statuses); err != nil {
+			return err
+		}
+		if len(statuses) > 0 {
+			*r = GetSubAccountStatusResp(statuses[0])
+		}
+		return nil
+	}
+	return json.Unmarshal(data, (*status)(r))
+}
+
 // Enable Margin for Sub-account (For Master Account)
 const (
 	enableMarginForSubAccountEndpoint = "/sapi/v1/sub-account/margin/enable"
@@ -1052,7 +1111,7 @@ func (s *FuturesTransferForSubAccountService) Do(ctx context.Context, opts ...Re
 	}
 	r.setParam("email", s.email)
 	r.setParam("asset", s.asset)
-	r.setParam("amount", s.amount)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
 	r.setParam("type", s.transferType)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -1079,7 +1138,7 @@ type MarginTransferForSubAccountService struct {
 	c            *Client
 	email        string
 	asset        string
-	amount       float32
+	amount       float64
 	transferType int
 }
 
@@ -1093,7 +1152,7 @@ func (s *MarginTransferForSubAccountService) Asset(asset string) *MarginTransfer
 	return s
 }
 
-func (s *MarginTransferForSubAccountService) Amount(amount float32) *MarginTransferForSubAccountService {
+func (s *MarginTransferForSubAccountService) Amount(amount float64) *MarginTransferForSubAccountService {
 	s.amount = amount
 	return s
 }
@@ -1111,7 +1170,7 @@ func (s *MarginTransferForSubAccountService) Do(ctx context.Context, opts ...Req
 	}
 	r.setParam("email", s.email)
 	r.setParam("asset", s.asset)
-	r.setParam("amount", s.amount)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
 	r.setParam("type", s.transferType)
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -1389,7 +1448,7 @@ func (s *UniversalTransferService) Do(ctx context.Context, opts ...RequestOption
 		r.setParam("symbol", *s.symbol)
 	}
 	r.setParam("asset", s.asset)
-	r.setParam("amount", s.amount)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -1497,6 +1556,22 @@ func (s *QueryUniversalTransferHistoryService) Do(ctx context.Context, opts ...R
 	return res, nil
 }
 
+// Range returns an iterator over every universal transfer made between from
+// and to, splitting the range into the 30 day windows accepted by the endpoint
+func (s *QueryUniversalTransferHistoryService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*InternalUniversalTransfer] {
+	p := &windowPager[*InternalUniversalTransfer]{
+		window: 30 * 24 * time.Hour,
+		limit:  500,
+		key:    func(t *InternalUniversalTransfer) any { return t.TranId },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*InternalUniversalTransfer, error) {
+			svc := *s
+			res, err := svc.StartTime(start).EndTime(end).Page(page + 1).Limit(500).Do(ctx)
+			return res.Result, err
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 type QueryUniversalTransferHistoryResp struct {
 	Result     []*InternalUniversalTransfer `json:"result"`
 	TotalCount int                          `json:"totalCount"`
@@ -1871,12 +1946,25 @@ func (s *GetIPRestrictionForSubAccountAPIKeyService) Do(ctx context.Context, opt
 }
 
 type GetIPRestrictionForSubAccountAPIKeyResp struct {
-	IpRestrict string `json:"ipRestrict"`
-	IpList     []struct {
-		Ip string `json:"ip"`
-	} `json:"ipList"`
-	UpdateTime uint64 `json:"updateTime"`
-	ApiKey     string `json:"apiKey"`
+	IpRestrict string               `json:"ipRestrict"`
+	IpList     []SubAccountAPIKeyIP `json:"ipList"`
+	UpdateTime uint64               `json:"updateTime"`
+	ApiKey     string               `json:"apiKey"`
+}
+
+// SubAccountAPIKeyIP define an IP of the IP list of a sub-account API key
+type SubAccountAPIKeyIP struct {
+	Ip string `json:"ip"`
+}
+
+// UnmarshalJSON accepts both the plain IP strings returned by the endpoints
+// and objects with an ip field
+func (ip *SubAccountAPIKeyIP) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &ip.Ip)
+	}
+	type subAccountAPIKeyIP SubAccountAPIKeyIP
+	return json.Unmarshal(data, (*subAccountAPIKeyIP)(ip))
 }
 
 // Delete IP List For a Sub-account API Key (For Master Account)
@@ -1931,12 +2019,10 @@ func (s *DeleteIPListForSubAccountAPIKeyService) Do(ctx context.Context, opts ..
 }
 
 type DeleteIPListForSubAccountAPIKeyResp struct {
-	IpRestrict string `json:"ipRestrict"`
-	IpList     []struct {
-		Ip string `json:"ip"`
-	} `json:"ipList"`
-	UpdateTime uint64 `json:"updateTime"`
-	ApiKey     string `json:"apiKey"`
+	IpRestrict string               `json:"ipRestrict"`
+	IpList     []SubAccountAPIKeyIP `json:"ipList"`
+	UpdateTime uint64               `json:"updateTime"`
+	ApiKey     string               `json:"apiKey"`
 }
 
 // Update IP Restriction for Sub-Account API key (For Master Account)
@@ -1975,7 +2061,7 @@ func (s *UpdateIPRestrictionForSubAccountAPIKeyService) IpAddress(ipAddress stri
 
 func (s *UpdateIPRestrictionForSubAccountAPIKeyService) Do(ctx context.Context, opts ...RequestOption) (res *UpdateIPRestrictionForSubAccountAPIKeyResp, err error) {
 	r := &request{
-		method:   http.MethodPut,
+		method:   http.MethodPost,
 		endpoint: updateIPRestrictionForSubAccountAPIKeyEndpoint,
 		secType:  secTypeSigned,
 	}
@@ -1998,12 +2084,10 @@ func (s *UpdateIPRestrictionForSubAccountAPIKeyService) Do(ctx context.Context,
 }
 
 type UpdateIPRestrictionForSubAccountAPIKeyResp struct {
-	Status string `json:"status"`
-	IpList []struct {
-		Ip string `json:"ip"`
-	} `json:"ipList"`
-	UpdateTime uint64 `json:"updateTime"`
-	ApiKey     string `json:"apiKey"`
+	Status     string               `json:"status"`
+	IpList     []SubAccountAPIKeyIP `json:"ipList"`
+	UpdateTime uint64               `json:"updateTime"`
+	ApiKey     string               `json:"apiKey"`
 }
 
 // Deposit Assets Into The Managed Sub-account（For Investor Master Account）
@@ -2770,3 +2854,257 @@ type GetManagedSubAccountDepositAddressResp struct {
 	Tag     string `json:"tag"`
 	Url     string `json:"url"`
 }
+
+// Enable Options for Sub-account (For Master Account) (USER_DATA)
+const (
+	enableOptionsForSubAccountEndpoint = "/sapi/v1/sub-account/eoptions/enable"
+)
+
+type EnableOptionsForSubAccountService struct {
+	c     *Client
+	email string
+}
+
+func (s *EnableOptionsForSubAccountService) Email(email string) *EnableOptionsForSubAccountService {
+	s.email = email
+	return s
+}
+
+func (s *EnableOptionsForSubAccountService) Do(ctx context.Context, opts ...RequestOption) (res *EnableOptionsForSubAccountResp, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: enableOptionsForSubAccountEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("email", s.email)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(EnableOptionsForSubAccountResp)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type EnableOptionsForSubAccountResp struct {
+	Email             string `json:"email"`
+	IsEOptionsEnabled bool   `json:"isEOptionsEnabled"`
+}
+
+// Move Position for Sub-account (For Master Account) (USER_DATA)
+const (
+	moveFuturesPositionForSubAccountEndpoint = "/sapi/v1/sub-account/futures/move-position"
+)
+
+// MovePositionOrderArg define a position moved by MoveFuturesPositionForSubAccountService
+type MovePositionOrderArg struct {
+	Symbol       string
+	Quantity     float64
+	PositionSide PositionSideType
+}
+
+type MoveFuturesPositionForSubAccountService struct {
+	c             *Client
+	fromUserEmail string
+	toUserEmail   string
+	productType   string
+	orderArgs     []MovePositionOrderArg
+}
+
+func (s *MoveFuturesPositionForSubAccountService) FromUserEmail(fromUserEmail string) *MoveFuturesPositionForSubAccountService {
+	s.fromUserEmail = fromUserEmail
+	return s
+}
+
+func (s *MoveFuturesPositionForSubAccountService) ToUserEmail(toUserEmail string) *MoveFuturesPositionForSubAccountService {
+	s.toUserEmail = toUserEmail
+	return s
+}
+
+// ProductType set productType, UM or CM
+func (s *MoveFuturesPositionForSubAccountService) ProductType(productType string) *MoveFuturesPositionForSubAccountService {
+	s.productType = productType
+	return s
+}
+
+// OrderArgs set the positions to move, at most 10
+func (s *MoveFuturesPositionForSubAccountService) OrderArgs(orderArgs ...MovePositionOrderArg) *MoveFuturesPositionForSubAccountService {
+	s.orderArgs = orderArgs
+	return s
+}
+
+func (s *MoveFuturesPositionForSubAccountService) Do(ctx context.Context, opts ...RequestOption) (res *MoveFuturesPositionForSubAccountResp, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: moveFuturesPositionForSubAccountEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("fromUserEmail", s.fromUserEmail)
+	r.setParam("toUserEmail", s.toUserEmail)
+	r.setParam("productType", s.productType)
+	for i, arg := range s.orderArgs {
+		r.setParam(fmt.Sprintf("orderArgs[%d].symbol", i), arg.Symbol)
+		r.setParam(fmt.Sprintf("orderArgs[%d].quantity", i), strconv.FormatFloat(arg.Quantity, 'f', -1, 64))
+		r.setParam(fmt.Sprintf("orderArgs[%d].positionSide", i), arg.PositionSide)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MoveFuturesPositionForSubAccountResp)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type MoveFuturesPositionForSubAccountResp struct {
+	MovePositionOrders []struct {
+		FromUserEmail string `json:"fromUserEmail"`
+		ToUserEmail   string `json:"toUserEmail"`
+		ProductType   string `json:"productType"`
+		Symbol        string `json:"symbol"`
+		PriceType     string `json:"priceType"`
+		Price         string `json:"price"`
+		Quantity      string `json:"quantity"`
+		PositionSide  string `json:"positionSide"`
+		Side          string `json:"side"`
+		Success       bool   `json:"success"`
+	} `json:"movePositionOrders"`
+}
+
+// Get Move Position History for Sub-account (For Master Account) (USER_DATA)
+const (
+	queryMoveFuturesPositionHistoryForSubAccountEndpoint = "/sapi/v1/sub-account/futures/move-position"
+)
+
+type QueryMoveFuturesPositionHistoryForSubAccountService struct {
+	c         *Client
+	symbol    string
+	startTime *uint64
+	endTime   *uint64
+	page      *int
+	row       *int
+}
+
+func (s *QueryMoveFuturesPositionHistoryForSubAccountService) Symbol(symbol string) *QueryMoveFuturesPositionHistoryForSubAccountService {
+	s.symbol = symbol
+	return s
+}
+
+func (s *QueryMoveFuturesPositionHistoryForSubAccountService) StartTime(startTime uint64) *QueryMoveFuturesPositionHistoryForSubAccountService {
+	s.startTime = &startTime
+	return s
+}
+
+func (s *QueryMoveFuturesPositionHistoryForSubAccountService) EndTime(endTime uint64) *QueryMoveFuturesPositionHistoryForSubAccountService {
+	s.endTime = &endTime
+	return s
+}
+
+func (s *QueryMoveFuturesPositionHistoryForSubAccountService) Page(page int) *QueryMoveFuturesPositionHistoryForSubAccountService {
+	s.page = &page
+	return s
+}
+
+func (s *QueryMoveFuturesPositionHistoryForSubAccountService) Row(row int) *QueryMoveFuturesPositionHistoryForSubAccountService {
+	s.row = &row
+	return s
+}
+
+func (s *QueryMoveFuturesPositionHistoryForSubAccountService) Do(ctx context.Context, opts ...RequestOption) (res *QueryMoveFuturesPositionHistoryForSubAccountResp, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: queryMoveFuturesPositionHistoryForSubAccountEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.page != nil {
+		r.setParam("page", *s.page)
+	}
+	if s.row != nil {
+		r.setParam("row", *s.row)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(QueryMoveFuturesPositionHistoryForSubAccountResp)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type QueryMoveFuturesPositionHistoryForSubAccountResp struct {
+	FutureMovePositionOrderVoList []struct {
+		FromUserEmail string `json:"fromUserEmail"`
+		ToUserEmail   string `json:"toUserEmail"`
+		ProductType   string `json:"productType"`
+		Symbol        string `json:"symbol"`
+		Price         string `json:"price"`
+		Quantity      string `json:"quantity"`
+		PositionSide  string `json:"positionSide"`
+		Side          string `json:"side"`
+		TimeStamp     uint64 `json:"timeStamp"`
+	} `json:"futureMovePositionOrderVoList"`
+	Total int64 `json:"total"`
+}
+
+// Query Sub-account API Key IP Restriction Third Party List (For Master Account) (USER_DATA)
+const (
+	getIPRestrictionThirdPartyListForSubAccountAPIKeyEndpoint = "/sapi/v1/sub-account/apiRestrictions/ipRestriction/thirdPartyList"
+)
+
+type GetIPRestrictionThirdPartyListForSubAccountAPIKeyService struct {
+	c                *Client
+	email            string
+	subAccountApiKey string
+}
+
+func (s *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService) Email(email string) *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService {
+	s.email = email
+	return s
+}
+
+func (s *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService) SubAccountApiKey(subAccountApiKey string) *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService {
+	s.subAccountApiKey = subAccountApiKey
+	return s
+}
+
+func (s *GetIPRestrictionThirdPartyListForSubAccountAPIKeyService) Do(ctx context.Context, opts ...RequestOption) (res []*GetIPRestrictionThirdPartyListForSubAccountAPIKeyResp, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: getIPRestrictionThirdPartyListForSubAccountAPIKeyEndpoint,
+		secType:  secTypeSigned,
+	}
+	r.setParam("email", s.email)
+	r.setParam("subAccountApiKey", s.subAccountApiKey)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*GetIPRestrictionThirdPartyListForSubAccountAPIKeyResp, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetIPRestrictionThirdPartyListForSubAccountAPIKeyResp struct {
+	ApiKey         string               `json:"apiKey"`
+	ThirdPartyName string               `json:"thirdPartyName"`
+	IpList         []SubAccountAPIKeyIP `json:"ipList"`
+}
@@ -0,0 +1,457 @@
+package binance_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// subAccountListLimit is the maximum page size of QuerySubAccountListService
+const subAccountListLimit = 200
+
+// Universal transfer account types of the wallets SubAccountFleet can rebalance
+const (
+	AccountTypeSpot       = "SPOT"
+	AccountTypeUSDTFuture = "USDT_FUTURE"
+	AccountTypeCoinFuture = "COIN_FUTURE"
+	AccountTypeMargin     = "MARGIN"
+)
+
+// SubAccountFleet runs queries and transfers across the sub-accounts of a
+// master account, at most Concurrency sub-accounts at a time
+type SubAccountFleet struct {
+	// Concurrency bounds the sub-accounts queried at once, 8 by default
+	Concurrency int
+
+	c *Client
+}
+
+// NewSubAccountFleet create a SubAccountFleet for the master account of c
+func NewSubAccountFleet(c *Client) *SubAccountFleet {
+	return &SubAccountFleet{Concurrency: 8, c: c}
+}
+
+// List every sub-account, following the pages of QuerySubAccountListService
+func (f *SubAccountFleet) List(ctx context.Context) ([]SubAccount, error) {
+	var res []SubAccount
+	for page := 1; ; page++ {
+		list, err := f.c.NewQuerySubAccountListService().Page(page).Limit(subAccountListLimit).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, list.SubAccounts...)
+		if len(list.SubAccounts) < subAccountListLimit {
+			return res, nil
+		}
+	}
+}
+
+// Emails returns the emails of every sub-account
+func (f *SubAccountFleet) Emails(ctx context.Context) ([]string, error) {
+	subAccounts, err := f.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	emails := make([]string, len(subAccounts))
+	for i, subAccount := range subAccounts {
+		emails[i] = subAccount.Email
+	}
+	return emails, nil
+}
+
+// Each runs fn for every email, at most Concurrency at a time, and returns
+// the errors joined, each prefixed with its email. Emails not started when
+// ctx is done fail with the error of ctx.
+func (f *SubAccountFleet) Each(ctx context.Context, emails []string, fn func(ctx context.Context, email string) error) error {
+	return f.each(ctx, emails, func(ctx context.Context, i int) error {
+		return fn(ctx, emails[i])
+	})
+}
+
+// each runs fn for every index of labels, at most Concurrency at a time
+func (f *SubAccountFleet) each(ctx context.Context, labels []string, fn func(ctx context.Context, i int) error) error {
+	concurrency := f.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, concurrency)
+	fail := func(label string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, fmt.Errorf("%s: %w", label, err))
+	}
+	for i, label := range labels {
+		select {
+		case <-ctx.Done():
+			fail(label, ctx.Err())
+			continue
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(i int, label string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, i); err != nil {
+				fail(label, err)
+			}
+		}(i, label)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Statuses returns the margin and futures status of every email. The
+// statuses read are returned with the errors of the others.
+func (f *SubAccountFleet) Statuses(ctx context.Context, emails []string) (map[string]*GetSubAccountStatusResp, error) {
+	var mu sync.Mutex
+	res := make(map[string]*GetSubAccountStatusResp, len(emails))
+	err := f.Each(ctx, emails, func(ctx context.Context, email string) error {
+		status, err := f.c.NewGetSubAccountStatusService().Email(email).Do(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		res[email] = status
+		return nil
+	})
+	return res, err
+}
+
+// FleetBalance define the balance of an asset
+type FleetBalance struct {
+	Asset  string
+	Free   float64
+	Locked float64
+}
+
+// FleetBalances define the spot balances of sub-accounts
+type FleetBalances struct {
+	Accounts map[string][]FleetBalance // by email
+	Total    []FleetBalance            // summed across the sub-accounts, sorted by asset
+}
+
+// Balances returns the spot balances of every email read with
+// QuerySubAccountAssetsService, and their sum by asset. The balances read are
+// returned with the errors of the others.
+func (f *SubAccountFleet) Balances(ctx context.Context, emails []string) (*FleetBalances, error) {
+	var mu sync.Mutex
+	res := &FleetBalances{Accounts: make(map[string][]FleetBalance, len(emails))}
+	err := f.Each(ctx, emails, func(ctx context.Context, email string) error {
+		assets, err := f.c.NewQuerySubAccountAssetsService().Email(email).Do(ctx)
+		if err != nil {
+			return err
+		}
+		var balances []FleetBalance
+		var amounts amountParser
+		for _, b := range assets.Balances {
+			balance := FleetBalance{Asset: b.Asset, Free: amounts.parse(b.Free), Locked: amounts.parse(b.Locked)}
+			if balance.Free != 0 || balance.Locked != 0 {
+				balances = append(balances, balance)
+			}
+		}
+		if amounts.err != nil {
+			return amounts.err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		res.Accounts[email] = balances
+		return nil
+	})
+
+	total := make(map[string]*FleetBalance)
+	for _, balances := range res.Accounts {
+		for _, b := range balances {
+			sum, ok := total[b.Asset]
+			if !ok {
+				sum = &FleetBalance{Asset: b.Asset}
+				total[b.Asset] = sum
+			}
+			sum.Free += b.Free
+			sum.Locked += b.Locked
+		}
+	}
+	for _, sum := range total {
+		res.Total = append(res.Total, *sum)
+	}
+	sort.Slice(res.Total, func(i, j int) bool { return res.Total[i].Asset < res.Total[j].Asset })
+	return res, err
+}
+
+// SubAccountAPIKey define an API key of a sub-account
+type SubAccountAPIKey struct {
+	Email  string
+	ApiKey string
+}
+
+// IPLists returns the IP list of every key, by API key. The lists read are
+// returned with the errors of the others.
+func (f *SubAccountFleet) IPLists(ctx context.Context, keys []SubAccountAPIKey) (map[string][]string, error) {
+	var mu sync.Mutex
+	res := make(map[string][]string, len(keys))
+	err := f.each(ctx, apiKeyLabels(keys), func(ctx context.Context, i int) error {
+		restriction, err := f.c.NewGetIPRestrictionForSubAccountAPIKeyService().
+			Email(keys[i].Email).SubAccountApiKey(keys[i].ApiKey).Do(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		res[keys[i].ApiKey] = ipStrings(restriction.IpList)
+		return nil
+	})
+	return res, err
+}
+
+// ApplyIPList restricts every key to the IPs of ips: the missing IPs are
+// added and the other IPs of the key deleted. The IP lists applied are
+// returned by API key, with the errors of the other keys.
+func (f *SubAccountFleet) ApplyIPList(ctx context.Context, keys []SubAccountAPIKey, ips []string) (map[string][]string, error) {
+	if len(ips) == 0 {
+		return nil, errors.New("empty IP list")
+	}
+	var mu sync.Mutex
+	res := make(map[string][]string, len(keys))
+	err := f.each(ctx, apiKeyLabels(keys), func(ctx context.Context, i int) error {
+		key := keys[i]
+		// status 2 restricts the key to the trusted IPs
+		updated, err := f.c.NewUpdateIPRestrictionForSubAccountAPIKeyService().Email(key.Email).
+			SubAccountApiKey(key.ApiKey).Status("2").IpAddress(strings.Join(ips, ",")).Do(ctx)
+		if err != nil {
+			return err
+		}
+		keep := make(map[string]bool, len(ips))
+		for _, ip := range ips {
+			keep[ip] = true
+		}
+		var extra []string
+		for _, ip := range ipStrings(updated.IpList) {
+			if !keep[ip] {
+				extra = append(extra, ip)
+			}
+		}
+		if len(extra) > 0 {
+			if _, err = f.c.NewDeleteIPListForSubAccountAPIKeyService().Email(key.Email).
+				SubAccountApiKey(key.ApiKey).IpAddress(strings.Join(extra, ",")).Do(ctx); err != nil {
+				return err
+			}
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		res[key.ApiKey] = append([]string(nil), ips...)
+		return nil
+	})
+	return res, err
+}
+
+func apiKeyLabels(keys []SubAccountAPIKey) []string {
+	labels := make([]string, len(keys))
+	for i, key := range keys {
+		labels[i] = key.Email + " " + key.ApiKey
+	}
+	return labels
+}
+
+func ipStrings(list []SubAccountAPIKeyIP) []string {
+	res := make([]string, len(list))
+	for i, ip := range list {
+		res[i] = ip.Ip
+	}
+	return res
+}
+
+// RebalancePlan keeps Amount of Asset in the AccountType wallet of every
+// sub-account, moving the difference from or to the Source wallet of the
+// master account. No more than the maxWithdrawAmount of a futures wallet is
+// moved out of it.
+type RebalancePlan struct {
+	Asset       string
+	Amount      float64
+	AccountType string // AccountTypeSpot, AccountTypeUSDTFuture, AccountTypeCoinFuture or AccountTypeMargin
+	// Source is the wallet of the master account, AccountTypeSpot by default
+	Source string
+	// Emails are the sub-accounts rebalanced, all of them when empty
+	Emails []string
+	// MinTransfer skips the differences smaller than it
+	MinTransfer float64
+}
+
+// RebalanceTransfer define a universal transfer of a RebalancePlan. An empty
+// email is the master account.
+type RebalanceTransfer struct {
+	FromEmail       string
+	ToEmail         string
+	FromAccountType string
+	ToAccountType   string
+	Asset           string
+	Amount          float64
+	// TranId is set once the transfer is done
+	TranId int
+}
+
+func (t *RebalanceTransfer) String() string {
+	account := func(email string) string {
+		if email == "" {
+			return "master"
+		}
+		return email
+	}
+	return fmt.Sprintf("%s %s -> %s %s: %s %s", account(t.FromEmail), t.FromAccountType,
+		account(t.ToEmail), t.ToAccountType, strconv.FormatFloat(t.Amount, 'f', -1, 64), t.Asset)
+}
+
+// Plan reads the balance of every sub-account of plan and returns the
+// transfers that carry it out, without sending them
+func (f *SubAccountFleet) Plan(ctx context.Context, plan RebalancePlan) ([]*RebalanceTransfer, error) {
+	if plan.Source == "" {
+		plan.Source = AccountTypeSpot
+	}
+	emails := plan.Emails
+	if len(emails) == 0 {
+		var err error
+		if emails, err = f.Emails(ctx); err != nil {
+			return nil, err
+		}
+	}
+	var mu sync.Mutex
+	balances := make(map[string]float64, len(emails))
+	available := make(map[string]float64, len(emails))
+	err := f.Each(ctx, emails, func(ctx context.Context, email string) error {
+		balance, free, err := f.balance(ctx, email, plan.AccountType, plan.Asset)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		balances[email], available[email] = balance, free
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// excess funds go back to the master account first, so that they can
+	// fund the sub-accounts below the target
+	var withdrawals, deposits []*RebalanceTransfer
+	for _, email := range emails {
+		// amounts are rounded to the 8 decimals accepted by the transfer endpoints
+		diff := math.Round((plan.Amount-balances[email])*1e8) / 1e8
+		if diff < 0 {
+			// no more than the available amount is transferred out
+			diff = math.Max(diff, -math.Floor(available[email]*1e8)/1e8)
+		}
+		if diff == 0 || (plan.MinTransfer > 0 && math.Abs(diff) < plan.MinTransfer) {
+			continue
+		}
+		if diff < 0 {
+			withdrawals = append(withdrawals, &RebalanceTransfer{FromEmail: email, FromAccountType: plan.AccountType,
+				ToAccountType: plan.Source, Asset: plan.Asset, Amount: -diff})
+			continue
+		}
+		deposits = append(deposits, &RebalanceTransfer{ToEmail: email, FromAccountType: plan.Source,
+			ToAccountType: plan.AccountType, Asset: plan.Asset, Amount: diff})
+	}
+	return append(withdrawals, deposits...), nil
+}
+
+// Rebalance carries out plan. With dryRun the transfers are returned without
+// being sent. Otherwise they are sent one after the other, and the transfers
+// done are returned with the first error.
+func (f *SubAccountFleet) Rebalance(ctx context.Context, plan RebalancePlan, dryRun bool) ([]*RebalanceTransfer, error) {
+	transfers, err := f.Plan(ctx, plan)
+	if err != nil || dryRun {
+		return transfers, err
+	}
+	for i, t := range transfers {
+		svc := f.c.NewUniversalTransferService().FromAccountType(t.FromAccountType).ToAccountType(t.ToAccountType).
+			Asset(t.Asset).Amount(t.Amount)
+		if t.FromEmail != "" {
+			svc.FromEmail(t.FromEmail)
+		}
+		if t.ToEmail != "" {
+			svc.ToEmail(t.ToEmail)
+		}
+		res, err := svc.Do(ctx)
+		if err != nil {
+			return transfers[:i], fmt.Errorf("%s: %w", t, err)
+		}
+		t.TranId = res.TranId
+	}
+	return transfers, nil
+}
+
+// balance returns the amount of asset in the accountType wallet of email,
+// and the part of it that can be transferred out: the free amount of the spot
+// and margin wallets, the wallet balance and maxWithdrawAmount of the futures
+// wallets
+func (f *SubAccountFleet) balance(ctx context.Context, email, accountType, asset string) (balance, available float64, err error) {
+	var amounts amountParser
+	switch accountType {
+	case AccountTypeSpot:
+		assets, err := f.c.NewQuerySubAccountAssetsService().Email(email).Do(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, b := range assets.Balances {
+			if b.Asset == asset {
+				balance = amounts.parse(b.Free)
+				available = balance
+			}
+		}
+	case AccountTypeUSDTFuture:
+		res, err := f.c.NewGetDetailOnSubAccountFuturesAccountV2Service().Email(email).FuturesType(1).Do(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		futures, ok := res.(*GetDetailOnSubAccountFuturesAccountV2USDTResp)
+		if !ok {
+			return 0, 0, fmt.Errorf("unexpected USD-M futures account response %T", res)
+		}
+		for _, b := range futures.FutureAccountResp.Assets {
+			if b.Asset == asset {
+				balance, available = amounts.parse(b.WalletBalance), amounts.parse(b.MaxWithdrawAmount)
+			}
+		}
+	case AccountTypeCoinFuture:
+		res, err := f.c.NewGetDetailOnSubAccountFuturesAccountV2Service().Email(email).FuturesType(2).Do(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		futures, ok := res.(*GetDetailOnSubAccountFuturesAccountV2COINResp)
+		if !ok {
+			return 0, 0, fmt.Errorf("unexpected COIN-M futures account response %T", res)
+		}
+		for _, b := range futures.DeliveryAccountResp.Assets {
+			if b.Asset == asset {
+				balance, available = amounts.parse(b.WalletBalance), amounts.parse(b.MaxWithdrawAmount)
+			}
+		}
+	case AccountTypeMargin:
+		res, err := f.c.NewGetDetailOnSubAccountMarginAccountService().Email(email).Do(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, b := range res.MarginUserAssetVoList {
+			if b.Asset == asset {
+				balance = amounts.parse(b.Free)
+				available = balance
+			}
+		}
+	default:
+		return 0, 0, fmt.Errorf("cannot rebalance the %s wallet", accountType)
+	}
+	if amounts.err != nil {
+		return 0, 0, amounts.err
+	}
+	return balance, available, nil
+}
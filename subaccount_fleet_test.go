@@ -0,0 +1,242 @@
+package binance_connector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type subAccountFleetTestSuite struct {
+	suite.Suite
+	exchange *fleetExchange
+	fleet    *SubAccountFleet
+}
+
+func TestSubAccountFleet(t *testing.T) {
+	suite.Run(t, new(subAccountFleetTestSuite))
+}
+
+// fleetExchange fakes the sub-account endpoints used by SubAccountFleet
+type fleetExchange struct {
+	mu        sync.Mutex
+	emails    []string
+	spot      map[string]string // USDT spot balance by email
+	futures   map[string]string // USDT futures wallet balance by email
+	withdraw  map[string]string // USDT futures maxWithdrawAmount by email, the wallet balance by default
+	transfers []url.Values
+	ipLists   map[string][]string // by API key
+	inFlight  int32
+	maxFlight int32
+}
+
+func (e *fleetExchange) client() *Client {
+	ipRestriction := func(req *http.Request) (interface{}, error) {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		query := req.URL.Query()
+		key := query.Get("subAccountApiKey")
+		switch req.Method {
+		case http.MethodPost:
+			for _, ip := range strings.Split(query.Get("ipAddress"), ",") {
+				if !slices.Contains(e.ipLists[key], ip) {
+					e.ipLists[key] = append(e.ipLists[key], ip)
+				}
+			}
+		case http.MethodDelete:
+			for _, ip := range strings.Split(query.Get("ipAddress"), ",") {
+				e.ipLists[key] = slices.DeleteFunc(e.ipLists[key], func(s string) bool { return s == ip })
+			}
+		}
+		return map[string]any{"ipRestrict": "true", "status": "2", "ipList": e.ipLists[key], "apiKey": key}, nil
+	}
+	routes := fakeRoutes{
+		"/sapi/v1/sub-account/list": func(req *http.Request) (interface{}, error) {
+			page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			var subAccounts []SubAccount
+			for i := (page - 1) * limit; i < len(e.emails) && i < page*limit; i++ {
+				subAccounts = append(subAccounts, SubAccount{Email: e.emails[i]})
+			}
+			return SubAccountListResp{SubAccounts: subAccounts}, nil
+		},
+		"/sapi/v3/sub-account/assets": func(req *http.Request) (interface{}, error) {
+			email := req.URL.Query().Get("email")
+			return fmt.Sprintf(`{"balances":[{"asset":"USDT","free":"%s","locked":"1"},{"asset":"BTC","free":"0","locked":"0"}]}`, e.spot[email]), nil
+		},
+		"/sapi/v2/sub-account/futures/account": func(req *http.Request) (interface{}, error) {
+			email := req.URL.Query().Get("email")
+			withdraw, ok := e.withdraw[email]
+			if !ok {
+				withdraw = e.futures[email]
+			}
+			return fmt.Sprintf(`{"futureAccountResp":{"email":"%s","assets":[{"asset":"USDT","walletBalance":"%s","maxWithdrawAmount":"%s"}]}}`,
+				email, e.futures[email], withdraw), nil
+		},
+		"/sapi/v1/sub-account/status": func(req *http.Request) (interface{}, error) {
+			return fmt.Sprintf(`[{"email":"%s","isFuturesEnabled":true}]`, req.URL.Query().Get("email")), nil
+		},
+		"/sapi/v1/sub-account/subaccountApi/ipRestriction":        ipRestriction,
+		"/sapi/v2/sub-account/subaccountApi/ipRestriction":        ipRestriction,
+		"/sapi/v1/sub-account/subaccountApi/ipRestriction/ipList": ipRestriction,
+		"/sapi/v1/asset/universalTransfer": func(req *http.Request) (interface{}, error) {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			e.transfers = append(e.transfers, req.URL.Query())
+			return fmt.Sprintf(`{"tranId":%d}`, len(e.transfers)), nil
+		},
+	}
+	return newFakeClient(func(req *http.Request) (interface{}, error) {
+		n := atomic.AddInt32(&e.inFlight, 1)
+		defer atomic.AddInt32(&e.inFlight, -1)
+		e.mu.Lock()
+		if n > e.maxFlight {
+			e.maxFlight = n
+		}
+		e.mu.Unlock()
+		time.Sleep(time.Millisecond)
+		return routes.serve(req)
+	})
+}
+
+func (s *subAccountFleetTestSuite) SetupTest() {
+	s.exchange = &fleetExchange{spot: make(map[string]string), futures: make(map[string]string), withdraw: make(map[string]string),
+		ipLists: make(map[string][]string)}
+	for i := 0; i < 250; i++ {
+		s.exchange.emails = append(s.exchange.emails, fmt.Sprintf("sub%d@test.com", i))
+	}
+	s.fleet = NewSubAccountFleet(s.exchange.client())
+	s.fleet.Concurrency = 4
+}
+
+func (s *subAccountFleetTestSuite) TestList() {
+	r := s.Require()
+	subAccounts, err := s.fleet.List(context.Background())
+	r.NoError(err)
+	r.Len(subAccounts, 250)
+	r.Equal("sub249@test.com", subAccounts[249].Email)
+}
+
+func (s *subAccountFleetTestSuite) TestBalances() {
+	r := s.Require()
+	emails, err := s.fleet.Emails(context.Background())
+	r.NoError(err)
+	for _, email := range emails {
+		s.exchange.spot[email] = "10"
+	}
+
+	balances, err := s.fleet.Balances(context.Background(), emails)
+	r.NoError(err)
+	r.Len(balances.Accounts, 250)
+	r.Equal([]FleetBalance{{Asset: "USDT", Free: 10, Locked: 1}}, balances.Accounts["sub7@test.com"])
+	r.Equal([]FleetBalance{{Asset: "USDT", Free: 2500, Locked: 250}}, balances.Total)
+	r.LessOrEqual(s.exchange.maxFlight, int32(4))
+
+	statuses, err := s.fleet.Statuses(context.Background(), emails[:3])
+	r.NoError(err)
+	r.Len(statuses, 3)
+	r.Equal("sub2@test.com", statuses["sub2@test.com"].Email)
+	r.True(statuses["sub2@test.com"].IsFuturesEnabled)
+}
+
+func (s *subAccountFleetTestSuite) TestBalancesInvalidAmount() {
+	r := s.Require()
+	s.exchange.spot["a@test.com"], s.exchange.spot["b@test.com"] = "10", "ten"
+
+	balances, err := s.fleet.Balances(context.Background(), []string{"a@test.com", "b@test.com"})
+	r.EqualError(err, `b@test.com: invalid amount "ten"`)
+	r.Equal([]FleetBalance{{Asset: "USDT", Free: 10, Locked: 1}}, balances.Total)
+}
+
+func (s *subAccountFleetTestSuite) TestEachReturnsErrorsByEmail() {
+	r := s.Require()
+	err := s.fleet.Each(context.Background(), []string{"a@test.com", "b@test.com"}, func(ctx context.Context, email string) error {
+		if email == "b@test.com" {
+			return fmt.Errorf("not allowed")
+		}
+		return nil
+	})
+	r.EqualError(err, "b@test.com: not allowed")
+}
+
+func (s *subAccountFleetTestSuite) TestRebalance() {
+	r := s.Require()
+	plan := RebalancePlan{
+		Asset:       "USDT",
+		Amount:      1000,
+		AccountType: AccountTypeUSDTFuture,
+		Emails:      []string{"a@test.com", "b@test.com", "c@test.com", "d@test.com"},
+		MinTransfer: 1,
+	}
+	s.exchange.futures = map[string]string{"a@test.com": "400", "b@test.com": "1500.5", "c@test.com": "999.5", "d@test.com": ""}
+
+	transfers, err := s.fleet.Rebalance(context.Background(), plan, true)
+	r.NoError(err)
+	r.Empty(s.exchange.transfers)
+	r.Len(transfers, 3)
+	r.Equal("b@test.com USDT_FUTURE -> master SPOT: 500.5 USDT", transfers[0].String())
+	r.Equal("master SPOT -> a@test.com USDT_FUTURE: 600 USDT", transfers[1].String())
+	r.Equal("master SPOT -> d@test.com USDT_FUTURE: 1000 USDT", transfers[2].String())
+
+	transfers, err = s.fleet.Rebalance(context.Background(), plan, false)
+	r.NoError(err)
+	r.Len(s.exchange.transfers, 3)
+	r.Equal(3, transfers[2].TranId)
+	r.Equal(url.Values{
+		"fromAccountType": {"USDT_FUTURE"}, "toAccountType": {"SPOT"}, "fromEmail": {"b@test.com"},
+		"asset": {"USDT"}, "amount": {"500.5"},
+	}, withoutSignature(s.exchange.transfers[0]))
+	r.Equal("a@test.com", s.exchange.transfers[1].Get("toEmail"))
+	r.Empty(s.exchange.transfers[1].Get("fromEmail"))
+}
+
+func (s *subAccountFleetTestSuite) TestRebalanceFuturesMaxWithdraw() {
+	r := s.Require()
+	plan := RebalancePlan{Asset: "USDT", Amount: 1000, AccountType: AccountTypeUSDTFuture,
+		Emails: []string{"a@test.com", "b@test.com", "c@test.com"}}
+	// margin of open positions cannot be transferred out
+	s.exchange.futures = map[string]string{"a@test.com": "1500", "b@test.com": "1500", "c@test.com": "1200"}
+	s.exchange.withdraw = map[string]string{"a@test.com": "200.123456789", "b@test.com": "800", "c@test.com": "0"}
+
+	transfers, err := s.fleet.Plan(context.Background(), plan)
+	r.NoError(err)
+	r.Len(transfers, 2)
+	r.Equal("a@test.com USDT_FUTURE -> master SPOT: 200.12345678 USDT", transfers[0].String())
+	r.Equal("b@test.com USDT_FUTURE -> master SPOT: 500 USDT", transfers[1].String())
+}
+
+func (s *subAccountFleetTestSuite) TestApplyIPList() {
+	r := s.Require()
+	keys := []SubAccountAPIKey{{Email: "a@test.com", ApiKey: "key-a"}, {Email: "b@test.com", ApiKey: "key-b"}}
+	s.exchange.ipLists["key-a"] = []string{"1.1.1.1", "9.9.9.9"}
+
+	applied, err := s.fleet.ApplyIPList(context.Background(), keys, []string{"1.1.1.1", "2.2.2.2"})
+	r.NoError(err)
+	r.Equal([]string{"1.1.1.1", "2.2.2.2"}, applied["key-b"])
+
+	lists, err := s.fleet.IPLists(context.Background(), keys)
+	r.NoError(err)
+	r.Equal(map[string][]string{"key-a": {"1.1.1.1", "2.2.2.2"}, "key-b": {"1.1.1.1", "2.2.2.2"}}, lists)
+
+	_, err = s.fleet.ApplyIPList(context.Background(), keys, nil)
+	r.Error(err)
+}
+
+func withoutSignature(v url.Values) url.Values {
+	res := url.Values{}
+	for k, values := range v {
+		if k != timestampKey && k != signatureKey {
+			res[k] = values
+		}
+	}
+	return res
+}
@@ -1593,3 +1593,130 @@ func (s *subAccountTestSuite) TestManagedSubaccountDepositAddressService() {
 	r.Equal("", res.Tag, "Tag")
 	r.Equal("https://etherscan.io/address/0x206c22d833bb0bb2102da6b7c7d4c3eb14bcf73d", res.Url, "URL")
 }
+
+func (s *subAccountTestSuite) TestEnableOptionsForSubAccount() {
+	data := []byte(`{"email":"alice@test.com","isEOptionsEnabled":true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{"email": "alice@test.com"})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewEnableOptionsForSubAccountService().Email("alice@test.com").Do(context.Background())
+	s.r().NoError(err)
+	s.Equal("alice@test.com", resp.Email)
+	s.True(resp.IsEOptionsEnabled)
+}
+
+func (s *subAccountTestSuite) TestMoveFuturesPositionForSubAccount() {
+	data := []byte(`{
+		"movePositionOrders": [
+			{
+				"fromUserEmail": "alice@test.com",
+				"toUserEmail": "bob@test.com",
+				"productType": "UM",
+				"symbol": "BTCUSDT",
+				"priceType": "MARK_PRICE",
+				"price": "97139.00000000",
+				"quantity": "0.001",
+				"positionSide": "BOTH",
+				"side": "SELL",
+				"success": true
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"fromUserEmail":             "alice@test.com",
+			"toUserEmail":               "bob@test.com",
+			"productType":               "UM",
+			"orderArgs[0].symbol":       "BTCUSDT",
+			"orderArgs[0].quantity":     "0.001",
+			"orderArgs[0].positionSide": "BOTH",
+			"orderArgs[1].symbol":       "ETHUSDT",
+			"orderArgs[1].quantity":     "0.00001",
+			"orderArgs[1].positionSide": "LONG",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewMoveFuturesPositionForSubAccountService().FromUserEmail("alice@test.com").
+		ToUserEmail("bob@test.com").ProductType("UM").
+		OrderArgs(
+			MovePositionOrderArg{Symbol: "BTCUSDT", Quantity: 0.001, PositionSide: PositionSideTypeBoth},
+			MovePositionOrderArg{Symbol: "ETHUSDT", Quantity: 0.00001, PositionSide: PositionSideTypeLong},
+		).
+		Do(context.Background())
+	s.r().NoError(err)
+	s.Len(resp.MovePositionOrders, 1)
+	s.Equal("MARK_PRICE", resp.MovePositionOrders[0].PriceType)
+	s.Equal("0.001", resp.MovePositionOrders[0].Quantity)
+	s.True(resp.MovePositionOrders[0].Success)
+}
+
+func (s *subAccountTestSuite) TestQueryMoveFuturesPositionHistoryForSubAccount() {
+	data := []byte(`{
+		"futureMovePositionOrderVoList": [
+			{
+				"fromUserEmail": "alice@test.com",
+				"toUserEmail": "bob@test.com",
+				"productType": "UM",
+				"symbol": "BTCUSDT",
+				"price": "105025.50981609",
+				"quantity": "0.00100000",
+				"positionSide": "BOTH",
+				"side": "SELL",
+				"timeStamp": 1737544712000
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{"symbol": "BTCUSDT", "startTime": 1737544000000, "page": 1, "row": 10})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewQueryMoveFuturesPositionHistoryForSubAccountService().Symbol("BTCUSDT").
+		StartTime(1737544000000).Page(1).Row(10).Do(context.Background())
+	s.r().NoError(err)
+	s.Equal(int64(1), resp.Total)
+	s.Len(resp.FutureMovePositionOrderVoList, 1)
+	s.Equal(uint64(1737544712000), resp.FutureMovePositionOrderVoList[0].TimeStamp)
+	s.Equal("SELL", resp.FutureMovePositionOrderVoList[0].Side)
+}
+
+func (s *subAccountTestSuite) TestQueryMoveFuturesPositionHistoryForSubAccountUnpaged() {
+	data := []byte(`{"futureMovePositionOrderVoList":[],"total":0}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{"symbol": "BTCUSDT"})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewQueryMoveFuturesPositionHistoryForSubAccountService().Symbol("BTCUSDT").Do(context.Background())
+	s.r().NoError(err)
+	s.Zero(resp.Total)
+}
+
+func (s *subAccountTestSuite) TestGetIPRestrictionThirdPartyListForSubAccountAPIKey() {
+	data := []byte(`[{"apiKey":"h-1234567890","thirdPartyName":"Example","ipList":["1.2.3.4","5.6.7.8"]}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{"email": "alice@test.com", "subAccountApiKey": "h-1234567890"})
+		s.assertRequestEqual(e, r)
+	})
+
+	resp, err := s.client.NewGetIPRestrictionThirdPartyListForSubAccountAPIKeyService().Email("alice@test.com").
+		SubAccountApiKey("h-1234567890").Do(context.Background())
+	s.r().NoError(err)
+	s.Len(resp, 1)
+	s.Equal("Example", resp[0].ThirdPartyName)
+	s.Equal([]SubAccountAPIKeyIP{{Ip: "1.2.3.4"}, {Ip: "5.6.7.8"}}, resp[0].IpList)
+}
@@ -0,0 +1,540 @@
+package binance_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Errors returned by TransferOrchestrator
+var (
+	ErrTransferRoute       = errors.New("transfer not supported between these wallets")
+	ErrTransferUnconfirmed = errors.New("transfer status unknown")
+	// ErrTransferUnverifiable is returned for a transfer of unknown status
+	// within a sub-account, whose endpoints have no history
+	ErrTransferUnverifiable = errors.New("transfer status cannot be verified")
+	ErrTransferFailed       = errors.New("transfer failed")
+	ErrTransferInProgress   = errors.New("transfer in progress")
+)
+
+// Wallet define a wallet of the master account, or of the sub-account Email
+type Wallet struct {
+	Kind   WalletKind
+	Symbol string // isolated margin pair, required with WalletKindIsolatedMargin
+	Email  string // sub-account, the master account when empty
+}
+
+func (w Wallet) String() string {
+	s := string(w.Kind)
+	if w.Symbol != "" {
+		s += " " + w.Symbol
+	}
+	if w.Email != "" {
+		s = w.Email + " " + s
+	}
+	return s
+}
+
+// TransferStatus define the status of a transfer made by TransferOrchestrator
+type TransferStatus string
+
+// Transfer statuses
+const (
+	TransferStatusPending   TransferStatus = "PENDING"
+	TransferStatusConfirmed TransferStatus = "CONFIRMED"
+	TransferStatusFailed    TransferStatus = "FAILED"
+	// TransferStatusUnknown is the status of a transfer sent without a
+	// response, which the history did not show yet
+	TransferStatusUnknown TransferStatus = "UNKNOWN"
+	// TransferStatusUnverifiable is the status of a transfer within a
+	// sub-account sent without a response. It is never looked up nor sent
+	// again; check the balances of the sub-account instead.
+	TransferStatusUnverifiable TransferStatus = "UNVERIFIABLE"
+)
+
+// Final returns true for the statuses a transfer does not leave
+func (s TransferStatus) Final() bool {
+	return s == TransferStatusConfirmed || s == TransferStatusFailed || s == TransferStatusUnverifiable
+}
+
+// WalletTransfer define a transfer made by TransferOrchestrator
+type WalletTransfer struct {
+	ClientTranId string
+	From         Wallet
+	To           Wallet
+	Asset        string
+	Amount       float64
+	Endpoint     string // the endpoint the transfer is sent to
+	TranId       int64
+	Status       TransferStatus
+	SentAt       time.Time
+
+	sending bool
+}
+
+// TransferOrchestrator moves assets between the spot, funding, cross margin,
+// isolated margin, USDⓈ-M and COIN-M wallets of the master account and its
+// sub-accounts, picking the endpoint of each pair of wallets:
+//
+//   - within the master account, UserUniversalTransferService
+//   - within a sub-account, FuturesTransferForSubAccountService or
+//     MarginTransferForSubAccountService
+//   - between accounts, UniversalTransferService
+//
+// Every transfer has a clientTranId and is followed in the matching history
+// until it is confirmed. Transfers within a sub-account have no history on
+// Binance: they are confirmed by the response of their endpoint only. Transfers
+// are recorded by clientTranId, so that a transfer retried with
+// TransferWithId after a timeout is not sent twice.
+type TransferOrchestrator struct {
+	// PollInterval between history queries while a transfer is pending, 2s by default
+	PollInterval time.Duration
+
+	c         *Client
+	mu        sync.Mutex
+	transfers map[string]*WalletTransfer
+	now       func() time.Time
+}
+
+// NewTransferOrchestrator create a TransferOrchestrator sending transfers with c
+func NewTransferOrchestrator(c *Client) *TransferOrchestrator {
+	return &TransferOrchestrator{
+		PollInterval: 2 * time.Second,
+		c:            c,
+		transfers:    make(map[string]*WalletTransfer),
+		now:          time.Now,
+	}
+}
+
+// Transfer move amount of asset between two wallets with a new clientTranId
+// and wait until the transfer is confirmed. The transfer is returned with
+// the error when its status is not known; retry it with TransferWithId and
+// its ClientTranId.
+func (o *TransferOrchestrator) Transfer(ctx context.Context, from, to Wallet, asset string, amount float64) (*WalletTransfer, error) {
+	return o.TransferWithId(ctx, strings.ReplaceAll(getUUID(), "-", ""), from, to, asset, amount)
+}
+
+// TransferWithId move amount of asset between two wallets with clientTranId
+// and wait until the transfer is confirmed. A clientTranId already used is
+// not sent again: a transfer confirmed or pending is waited for, and a
+// transfer of unknown status is looked up in the history. Only transfers
+// with a sub-account, whose clientTranId is known to Binance, are sent
+// again when they are not found there. Transfers within the master account
+// of unknown status stay unknown until they appear in the history, as it
+// lags behind the transfers; they are never sent again.
+//
+// Transfers within a sub-account cannot be looked up: when their response is
+// lost they become TransferStatusUnverifiable with ErrTransferUnverifiable,
+// and are never sent again.
+//
+// Transfers within the master account have no clientTranId on Binance.
+// Their history is matched on the asset and amount of transfers sent since
+// the first attempt, which cannot tell apart identical transfers sent
+// outside of the TransferOrchestrator.
+func (o *TransferOrchestrator) TransferWithId(ctx context.Context, clientTranId string, from, to Wallet, asset string, amount float64) (*WalletTransfer, error) {
+	if asset == "" || amount <= 0 {
+		return nil, fmt.Errorf("invalid transfer of %v %s", amount, asset)
+	}
+	route, err := newTransferRoute(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	o.mu.Lock()
+	t, ok := o.transfers[clientTranId]
+	if ok && (t.From != from || t.To != to || t.Asset != asset || t.Amount != amount) {
+		o.mu.Unlock()
+		return nil, fmt.Errorf("clientTranId %s is used by the transfer of %v %s from %s to %s", clientTranId, t.Amount, t.Asset, t.From, t.To)
+	}
+	if ok && t.sending {
+		o.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrTransferInProgress, clientTranId)
+	}
+	if !ok {
+		t = &WalletTransfer{ClientTranId: clientTranId, From: from, To: to, Asset: asset, Amount: amount, Endpoint: route.endpoint()}
+		o.transfers[clientTranId] = t
+	}
+	t.sending = true
+	status := t.Status
+	o.mu.Unlock()
+	defer func() {
+		o.mu.Lock()
+		t.sending = false
+		o.mu.Unlock()
+	}()
+
+	if status == TransferStatusUnknown {
+		found, err := o.lookup(ctx, route, t)
+		if err != nil {
+			return o.copy(t), err
+		}
+		if !found && route.kind == transferRouteSubAccount {
+			status = ""
+		}
+	}
+	if status == "" {
+		if err = o.send(ctx, route, t); err != nil {
+			return o.copy(t), err
+		}
+	}
+	return o.wait(ctx, route, t)
+}
+
+// Wait poll the history until the transfer with clientTranId is confirmed or failed
+func (o *TransferOrchestrator) Wait(ctx context.Context, clientTranId string) (*WalletTransfer, error) {
+	o.mu.Lock()
+	t, ok := o.transfers[clientTranId]
+	o.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown transfer %s", clientTranId)
+	}
+	route, err := newTransferRoute(t.From, t.To)
+	if err != nil {
+		return nil, err
+	}
+	return o.wait(ctx, route, t)
+}
+
+// Transfers returns the recorded transfers, to be saved and given to
+// Restore after a restart
+func (o *TransferOrchestrator) Transfers() []*WalletTransfer {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	res := make([]*WalletTransfer, 0, len(o.transfers))
+	for _, t := range o.transfers {
+		c := *t
+		c.sending = false
+		res = append(res, &c)
+	}
+	return res
+}
+
+// Restore record transfers returned by Transfers
+func (o *TransferOrchestrator) Restore(transfers ...*WalletTransfer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, t := range transfers {
+		c := *t
+		c.sending = false
+		o.transfers[t.ClientTranId] = &c
+	}
+}
+
+func (o *TransferOrchestrator) send(ctx context.Context, route *transferRoute, t *WalletTransfer) error {
+	o.mu.Lock()
+	if t.SentAt.IsZero() {
+		t.SentAt = o.now()
+	}
+	o.mu.Unlock()
+
+	var (
+		tranId int64
+		status = TransferStatusPending
+		err    error
+	)
+	switch route.kind {
+	case transferRouteUser:
+		svc := o.c.NewUserUniversalTransferService().TransferType(route.transferType).Asset(t.Asset).Amount(t.Amount)
+		if t.From.Kind == WalletKindIsolatedMargin {
+			svc.FromSymbol(t.From.Symbol)
+		}
+		if t.To.Kind == WalletKindIsolatedMargin {
+			svc.ToSymbol(t.To.Symbol)
+		}
+		var res *UserUniversalTransferResponse
+		if res, err = svc.Do(ctx); err == nil {
+			tranId = res.TranId
+		}
+	case transferRouteSubAccount:
+		svc := o.c.NewUniversalTransferService().FromAccountType(route.fromAccountType).ToAccountType(route.toAccountType).
+			ClientTranId(t.ClientTranId).Asset(t.Asset).Amount(t.Amount)
+		if t.From.Email != "" {
+			svc.FromEmail(t.From.Email)
+		}
+		if t.To.Email != "" {
+			svc.ToEmail(t.To.Email)
+		}
+		if route.symbol != "" {
+			svc.Symbol(route.symbol)
+		}
+		var res *UniversalTransferResp
+		if res, err = svc.Do(ctx); err == nil {
+			tranId = int64(res.TranId)
+		}
+	case transferRouteSubAccountFutures:
+		var res *FuturesTransferForSubAccountResp
+		res, err = o.c.NewFuturesTransferForSubAccountService().Email(t.From.Email).Asset(t.Asset).Amount(t.Amount).
+			TransferType(route.typeCode).Do(ctx)
+		if err == nil {
+			tranId, status = int64(res.TxnId), TransferStatusConfirmed
+		}
+	case transferRouteSubAccountMargin:
+		var res *MarginTransferForSubAccountResp
+		res, err = o.c.NewMarginTransferForSubAccountService().Email(t.From.Email).Asset(t.Asset).Amount(t.Amount).
+			TransferType(route.typeCode).Do(ctx)
+		if err == nil {
+			tranId, status = int64(res.TxnId), TransferStatusConfirmed
+		}
+	}
+
+	if err != nil && !statusUnknown(err) {
+		// rejected, the clientTranId can be sent again
+		o.mu.Lock()
+		delete(o.transfers, t.ClientTranId)
+		o.mu.Unlock()
+		return err
+	}
+	o.mu.Lock()
+	switch {
+	case err == nil:
+		t.TranId, t.Status = tranId, status
+	case route.verifiable():
+		t.Status = TransferStatusUnknown
+	default:
+		t.Status = TransferStatusUnverifiable
+	}
+	o.mu.Unlock()
+	if err == nil {
+		return nil
+	}
+	if !route.verifiable() {
+		return fmt.Errorf("%w: %s: %v", ErrTransferUnverifiable, t.ClientTranId, err)
+	}
+
+	found, lookupErr := o.lookup(ctx, route, t)
+	if lookupErr != nil || !found {
+		return fmt.Errorf("%w: %s: %v", ErrTransferUnconfirmed, t.ClientTranId, err)
+	}
+	return nil
+}
+
+func (o *TransferOrchestrator) wait(ctx context.Context, route *transferRoute, t *WalletTransfer) (*WalletTransfer, error) {
+	for {
+		o.mu.Lock()
+		status := t.Status
+		o.mu.Unlock()
+		switch status {
+		case TransferStatusConfirmed:
+			return o.copy(t), nil
+		case TransferStatusFailed:
+			return o.copy(t), fmt.Errorf("%w: %s", ErrTransferFailed, t.ClientTranId)
+		case TransferStatusUnknown:
+			return o.copy(t), fmt.Errorf("%w: %s", ErrTransferUnconfirmed, t.ClientTranId)
+		case TransferStatusUnverifiable:
+			return o.copy(t), fmt.Errorf("%w: %s", ErrTransferUnverifiable, t.ClientTranId)
+		}
+		select {
+		case <-ctx.Done():
+			return o.copy(t), ctx.Err()
+		case <-time.After(o.PollInterval):
+		}
+		if _, err := o.lookup(ctx, route, t); err != nil {
+			return o.copy(t), err
+		}
+	}
+}
+
+// lookup update t from the history and returns true when t was found. It
+// returns ErrTransferUnverifiable for the routes without a history.
+func (o *TransferOrchestrator) lookup(ctx context.Context, route *transferRoute, t *WalletTransfer) (bool, error) {
+	if !route.verifiable() {
+		return false, fmt.Errorf("%w: %s", ErrTransferUnverifiable, t.ClientTranId)
+	}
+	o.mu.Lock()
+	tranId, sentAt := t.TranId, t.SentAt
+	o.mu.Unlock()
+	startTime := uint64(FormatTimestamp(sentAt.Add(-time.Minute)))
+
+	switch route.kind {
+	case transferRouteUser:
+		svc := o.c.NewUserUniversalTransferHistoryService().TransferType(route.transferType).StartTime(startTime).Size(100)
+		if t.From.Kind == WalletKindIsolatedMargin {
+			svc.FromSymbol(t.From.Symbol)
+		}
+		if t.To.Kind == WalletKindIsolatedMargin {
+			svc.ToSymbol(t.To.Symbol)
+		}
+		res, err := svc.Do(ctx)
+		if err != nil {
+			return false, err
+		}
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		claimed := make(map[int64]bool, len(o.transfers))
+		for _, other := range o.transfers {
+			if other != t && other.TranId != 0 {
+				claimed[other.TranId] = true
+			}
+		}
+		for _, row := range res.Rows {
+			if tranId != 0 && row.TranId != tranId {
+				continue
+			}
+			if tranId == 0 {
+				amount, err := parseAmount(row.Amount)
+				if err != nil {
+					return false, fmt.Errorf("transfer %d: %w", row.TranId, err)
+				}
+				if claimed[row.TranId] || row.Asset != t.Asset || math.Abs(amount-t.Amount) > 1e-12 {
+					continue
+				}
+			}
+			t.TranId, t.Status = row.TranId, transferStatus(row.Status)
+			return true, nil
+		}
+	case transferRouteSubAccount:
+		res, err := o.c.NewQueryUniversalTransferHistoryService().ClientTranId(t.ClientTranId).StartTime(startTime).Do(ctx)
+		if err != nil {
+			return false, err
+		}
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		for _, row := range res.Result {
+			if row.ClientTranId == t.ClientTranId {
+				t.TranId, t.Status = row.TranId, transferStatus(row.Status)
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (o *TransferOrchestrator) copy(t *WalletTransfer) *WalletTransfer {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	c := *t
+	c.sending = false
+	return &c
+}
+
+// transferStatus maps the statuses of the transfer histories
+func transferStatus(status string) TransferStatus {
+	switch status {
+	case "CONFIRMED", "SUCCESS":
+		return TransferStatusConfirmed
+	case "FAILED", "FAILURE":
+		return TransferStatusFailed
+	}
+	return TransferStatusPending
+}
+
+const (
+	transferRouteUser = iota
+	transferRouteSubAccount
+	transferRouteSubAccountFutures
+	transferRouteSubAccountMargin
+)
+
+// transferRoute define the endpoint and parameters of a transfer
+type transferRoute struct {
+	kind            int
+	transferType    string // UserUniversalTransferService type
+	typeCode        int    // FuturesTransferForSubAccountService or MarginTransferForSubAccountService type
+	fromAccountType string
+	toAccountType   string
+	symbol          string
+}
+
+// userTransferWallets are the wallet names of UserUniversalTransferService types
+var userTransferWallets = map[WalletKind]string{
+	WalletKindSpot:           "MAIN",
+	WalletKindFunding:        "FUNDING",
+	WalletKindCrossMargin:    "MARGIN",
+	WalletKindIsolatedMargin: "ISOLATEDMARGIN",
+	WalletKindFutures:        "UMFUTURE",
+	WalletKindCoinFutures:    "CMFUTURE",
+}
+
+// userTransferTypes are the UserUniversalTransferService types between the
+// wallets of userTransferWallets
+var userTransferTypes = map[string]bool{
+	"MAIN_FUNDING": true, "MAIN_UMFUTURE": true, "MAIN_CMFUTURE": true, "MAIN_MARGIN": true,
+	"FUNDING_MAIN": true, "FUNDING_UMFUTURE": true, "FUNDING_CMFUTURE": true, "FUNDING_MARGIN": true,
+	"UMFUTURE_MAIN": true, "UMFUTURE_FUNDING": true, "UMFUTURE_MARGIN": true,
+	"CMFUTURE_MAIN": true, "CMFUTURE_FUNDING": true, "CMFUTURE_MARGIN": true,
+	"MARGIN_MAIN": true, "MARGIN_FUNDING": true, "MARGIN_UMFUTURE": true, "MARGIN_CMFUTURE": true,
+	"MARGIN_ISOLATEDMARGIN": true, "ISOLATEDMARGIN_MARGIN": true, "ISOLATEDMARGIN_ISOLATEDMARGIN": true,
+}
+
+// subAccountTransferTypes are the UniversalTransferService account types
+var subAccountTransferTypes = map[WalletKind]string{
+	WalletKindSpot:           AccountTypeSpot,
+	WalletKindCrossMargin:    AccountTypeMargin,
+	WalletKindIsolatedMargin: "ISOLATED_MARGIN",
+	WalletKindFutures:        AccountTypeUSDTFuture,
+	WalletKindCoinFutures:    AccountTypeCoinFuture,
+}
+
+func newTransferRoute(from, to Wallet) (*transferRoute, error) {
+	unsupported := fmt.Errorf("%w: %s to %s", ErrTransferRoute, from, to)
+	for _, w := range []Wallet{from, to} {
+		if (w.Kind == WalletKindIsolatedMargin) != (w.Symbol != "") {
+			return nil, fmt.Errorf("%w: isolated margin wallets need a symbol, other wallets none", unsupported)
+		}
+	}
+	if from == to {
+		return nil, unsupported
+	}
+
+	if from.Email == to.Email && from.Email == "" {
+		transferType := userTransferWallets[from.Kind] + "_" + userTransferWallets[to.Kind]
+		if !userTransferTypes[transferType] {
+			return nil, unsupported
+		}
+		return &transferRoute{kind: transferRouteUser, transferType: transferType}, nil
+	}
+
+	if from.Email == to.Email {
+		switch {
+		case from.Kind == WalletKindSpot && to.Kind == WalletKindFutures:
+			return &transferRoute{kind: transferRouteSubAccountFutures, typeCode: 1}, nil
+		case from.Kind == WalletKindFutures && to.Kind == WalletKindSpot:
+			return &transferRoute{kind: transferRouteSubAccountFutures, typeCode: 2}, nil
+		case from.Kind == WalletKindSpot && to.Kind == WalletKindCoinFutures:
+			return &transferRoute{kind: transferRouteSubAccountFutures, typeCode: 3}, nil
+		case from.Kind == WalletKindCoinFutures && to.Kind == WalletKindSpot:
+			return &transferRoute{kind: transferRouteSubAccountFutures, typeCode: 4}, nil
+		case from.Kind == WalletKindSpot && to.Kind == WalletKindCrossMargin:
+			return &transferRoute{kind: transferRouteSubAccountMargin, typeCode: 1}, nil
+		case from.Kind == WalletKindCrossMargin && to.Kind == WalletKindSpot:
+			return &transferRoute{kind: transferRouteSubAccountMargin, typeCode: 2}, nil
+		}
+		return nil, unsupported
+	}
+
+	fromAccountType, fromOk := subAccountTransferTypes[from.Kind]
+	toAccountType, toOk := subAccountTransferTypes[to.Kind]
+	if !fromOk || !toOk || (from.Symbol != "" && to.Symbol != "" && from.Symbol != to.Symbol) {
+		return nil, unsupported
+	}
+	symbol := from.Symbol
+	if symbol == "" {
+		symbol = to.Symbol
+	}
+	return &transferRoute{kind: transferRouteSubAccount, fromAccountType: fromAccountType, toAccountType: toAccountType, symbol: symbol}, nil
+}
+
+// verifiable returns true for the routes with a history. The histories of
+// FuturesTransferForSubAccountService and MarginTransferForSubAccountService
+// are not available to the master account:
+// QuerySubAccountFuturesAssetTransferHistoryService lists the transfers
+// between the futures wallets of two accounts only.
+func (r *transferRoute) verifiable() bool {
+	return r.kind == transferRouteUser || r.kind == transferRouteSubAccount
+}
+
+func (r *transferRoute) endpoint() string {
+	switch r.kind {
+	case transferRouteSubAccount:
+		return universalTransferEndpoint
+	case transferRouteSubAccountFutures:
+		return futuresTransferForSubAccountEndpoint
+	case transferRouteSubAccountMargin:
+		return marginTransferForSubAccountEndpoint
+	}
+	return userUniversalTransferEndpoint
+}
@@ -0,0 +1,321 @@
+package binance_connector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+	"github.com/stretchr/testify/suite"
+)
+
+type transferOrchestratorTestSuite struct {
+	suite.Suite
+	exchange     *transferExchange
+	orchestrator *TransferOrchestrator
+}
+
+func TestTransferOrchestrator(t *testing.T) {
+	suite.Run(t, new(transferOrchestratorTestSuite))
+}
+
+// transferExchange fakes the transfer endpoints and their histories
+type transferExchange struct {
+	mu        sync.Mutex
+	nextId    int64
+	user      []map[string]any // UserUniversalTransferHistoryService rows
+	universal []*InternalUniversalTransfer
+	posts     map[string][]url.Values
+	gets      []string
+	status    string // status of new transfers in the histories
+	// the next drop transfers are made and answered with a connection error,
+	// the next lost transfers fail with a connection error before being made
+	drop, lost int
+	reject     bool
+	// the next lag queries of the master account history leave out its last row
+	lag int
+}
+
+func (e *transferExchange) client() *Client {
+	transfer := func(record func(query url.Values) interface{}) fakeHandler {
+		return func(req *http.Request) (interface{}, error) {
+			query := req.URL.Query()
+			e.posts[req.URL.Path] = append(e.posts[req.URL.Path], query)
+			if e.reject {
+				return nil, &handlers.APIError{Code: -5013, Message: "Asset transfer failed: insufficient balance"}
+			}
+			if e.lost > 0 {
+				e.lost--
+				return nil, errors.New("connection reset by peer")
+			}
+			e.nextId++
+			res := record(query)
+			if e.drop > 0 {
+				e.drop--
+				return nil, errors.New("i/o timeout")
+			}
+			return res, nil
+		}
+	}
+	post := fakeRoutes{
+		"/sapi/v1/asset/transfer": transfer(func(query url.Values) interface{} {
+			e.user = append(e.user, map[string]any{"tranId": e.nextId, "asset": query.Get("asset"), "amount": query.Get("amount"),
+				"type": query.Get("type"), "status": e.status, "timestamp": FormatTimestamp(time.Now())})
+			return map[string]any{"tranId": e.nextId}
+		}),
+		"/sapi/v1/asset/universalTransfer": transfer(func(query url.Values) interface{} {
+			e.universal = append(e.universal, &InternalUniversalTransfer{TranId: e.nextId, ClientTranId: query.Get("clientTranId"),
+				Asset: query.Get("asset"), Amount: query.Get("amount"), Status: e.status})
+			return map[string]any{"tranId": e.nextId, "clientTranId": query.Get("clientTranId")}
+		}),
+		"/sapi/v1/sub-account/futures/transfer": transfer(func(url.Values) interface{} {
+			return map[string]any{"txnId": e.nextId}
+		}),
+		"/sapi/v1/sub-account/margin/transfer": transfer(func(url.Values) interface{} {
+			return map[string]any{"txnId": e.nextId}
+		}),
+	}
+	get := fakeRoutes{
+		"/sapi/v1/asset/transfer": func(*http.Request) (interface{}, error) {
+			rows := e.user
+			if e.lag > 0 && len(rows) > 0 {
+				e.lag--
+				rows = rows[:len(rows)-1]
+			}
+			return map[string]any{"total": len(rows), "rows": rows}, nil
+		},
+		"/sapi/v1/asset/universalTransfer": func(req *http.Request) (interface{}, error) {
+			var res []*InternalUniversalTransfer
+			for _, t := range e.universal {
+				if t.ClientTranId == req.URL.Query().Get("clientTranId") {
+					res = append(res, t)
+				}
+			}
+			return QueryUniversalTransferHistoryResp{Result: res, TotalCount: len(res)}, nil
+		},
+	}
+	return newFakeClient(func(req *http.Request) (interface{}, error) {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if req.Method == http.MethodPost {
+			return post.serve(req)
+		}
+		e.gets = append(e.gets, req.URL.Path)
+		return get.serve(req)
+	})
+}
+
+func (e *transferExchange) setStatus(status string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.status = status
+	for _, row := range e.user {
+		row["status"] = status
+	}
+	for _, t := range e.universal {
+		t.Status = status
+	}
+}
+
+func (s *transferOrchestratorTestSuite) SetupTest() {
+	s.exchange = &transferExchange{posts: make(map[string][]url.Values), status: "CONFIRMED"}
+	s.orchestrator = NewTransferOrchestrator(s.exchange.client())
+	s.orchestrator.PollInterval = time.Millisecond
+}
+
+func (s *transferOrchestratorTestSuite) TestRoutes() {
+	r := s.Require()
+	spot, futures, margin := Wallet{Kind: WalletKindSpot}, Wallet{Kind: WalletKindFutures}, Wallet{Kind: WalletKindCrossMargin}
+	isolated := Wallet{Kind: WalletKindIsolatedMargin, Symbol: "BTCUSDT"}
+	subSpot := Wallet{Kind: WalletKindSpot, Email: "sub@test.com"}
+
+	route, err := newTransferRoute(spot, Wallet{Kind: WalletKindCoinFutures})
+	r.NoError(err)
+	r.Equal("MAIN_CMFUTURE", route.transferType)
+	route, err = newTransferRoute(isolated, Wallet{Kind: WalletKindIsolatedMargin, Symbol: "ETHUSDT"})
+	r.NoError(err)
+	r.Equal("ISOLATEDMARGIN_ISOLATEDMARGIN", route.transferType)
+	route, err = newTransferRoute(subSpot, Wallet{Kind: WalletKindCoinFutures, Email: "sub@test.com"})
+	r.NoError(err)
+	r.Equal(transferRouteSubAccountFutures, route.kind)
+	r.Equal(3, route.typeCode)
+	route, err = newTransferRoute(Wallet{Kind: WalletKindCrossMargin, Email: "sub@test.com"}, subSpot)
+	r.NoError(err)
+	r.Equal(transferRouteSubAccountMargin, route.kind)
+	r.Equal(2, route.typeCode)
+	route, err = newTransferRoute(spot, Wallet{Kind: WalletKindIsolatedMargin, Symbol: "BTCUSDT", Email: "sub@test.com"})
+	r.NoError(err)
+	r.Equal(transferRouteSubAccount, route.kind)
+	r.Equal("ISOLATED_MARGIN", route.toAccountType)
+	r.Equal("BTCUSDT", route.symbol)
+
+	for _, pair := range [][2]Wallet{
+		{spot, spot},
+		{spot, isolated},
+		{futures, Wallet{Kind: WalletKindIsolatedMargin}},
+		{margin, Wallet{Kind: WalletKindFunding, Email: "sub@test.com"}},
+		{Wallet{Kind: WalletKindFutures, Email: "sub@test.com"}, Wallet{Kind: WalletKindCrossMargin, Email: "sub@test.com"}},
+	} {
+		_, err = newTransferRoute(pair[0], pair[1])
+		r.ErrorIs(err, ErrTransferRoute, "%s to %s", pair[0], pair[1])
+	}
+}
+
+func (s *transferOrchestratorTestSuite) TestUserTransfer() {
+	r := s.Require()
+	s.exchange.status = "PENDING"
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.exchange.setStatus("CONFIRMED")
+	}()
+
+	t, err := s.orchestrator.Transfer(context.Background(), Wallet{Kind: WalletKindIsolatedMargin, Symbol: "BTCUSDT"},
+		Wallet{Kind: WalletKindCrossMargin}, "USDT", 0.00000001)
+	r.NoError(err)
+	r.Equal(TransferStatusConfirmed, t.Status)
+	r.Equal(int64(1), t.TranId)
+	r.Equal("/sapi/v1/asset/transfer", t.Endpoint)
+	r.Len(t.ClientTranId, 32)
+	post := s.exchange.posts["/sapi/v1/asset/transfer"][0]
+	r.Equal("ISOLATEDMARGIN_MARGIN", post.Get("type"))
+	r.Equal("BTCUSDT", post.Get("fromSymbol"))
+	r.Equal("0.00000001", post.Get("amount"))
+
+	// a confirmed transfer is not sent again
+	again, err := s.orchestrator.TransferWithId(context.Background(), t.ClientTranId, t.From, t.To, t.Asset, t.Amount)
+	r.NoError(err)
+	r.Equal(t.TranId, again.TranId)
+	r.Len(s.exchange.posts["/sapi/v1/asset/transfer"], 1)
+
+	_, err = s.orchestrator.TransferWithId(context.Background(), t.ClientTranId, t.From, t.To, t.Asset, 2)
+	r.Error(err)
+}
+
+func (s *transferOrchestratorTestSuite) TestUserTransferLostResponse() {
+	r := s.Require()
+	spot, funding := Wallet{Kind: WalletKindSpot}, Wallet{Kind: WalletKindFunding}
+	first, err := s.orchestrator.Transfer(context.Background(), spot, funding, "USDT", 10)
+	r.NoError(err)
+
+	// the response of an identical transfer is lost, the history row of the
+	// first transfer is not taken for it
+	s.exchange.drop = 1
+	t, err := s.orchestrator.Transfer(context.Background(), spot, funding, "USDT", 10)
+	r.NoError(err)
+	r.Equal(TransferStatusConfirmed, t.Status)
+	r.Equal(first.TranId+1, t.TranId)
+	r.Len(s.exchange.posts["/sapi/v1/asset/transfer"], 2)
+}
+
+func (s *transferOrchestratorTestSuite) TestUserTransferLaggingHistory() {
+	r := s.Require()
+	spot, funding := Wallet{Kind: WalletKindSpot}, Wallet{Kind: WalletKindFunding}
+
+	// made, but the response is lost and the history shows it only after the retry
+	s.exchange.drop, s.exchange.lag = 1, 2
+	t, err := s.orchestrator.Transfer(context.Background(), spot, funding, "USDT", 10)
+	r.ErrorIs(err, ErrTransferUnconfirmed)
+	r.Equal(TransferStatusUnknown, t.Status)
+
+	// the retry does not find it and does not send it again
+	again, err := s.orchestrator.TransferWithId(context.Background(), t.ClientTranId, spot, funding, "USDT", 10)
+	r.ErrorIs(err, ErrTransferUnconfirmed)
+	r.Equal(TransferStatusUnknown, again.Status)
+	r.Len(s.exchange.posts["/sapi/v1/asset/transfer"], 1)
+
+	again, err = s.orchestrator.TransferWithId(context.Background(), t.ClientTranId, spot, funding, "USDT", 10)
+	r.NoError(err)
+	r.Equal(TransferStatusConfirmed, again.Status)
+	r.Equal(int64(1), again.TranId)
+	r.Len(s.exchange.posts["/sapi/v1/asset/transfer"], 1)
+	r.Len(s.exchange.user, 1)
+}
+
+func (s *transferOrchestratorTestSuite) TestSubAccountTransferRetry() {
+	r := s.Require()
+	from, to := Wallet{Kind: WalletKindSpot}, Wallet{Kind: WalletKindFutures, Email: "sub@test.com"}
+
+	// made, but the response is lost: found by its clientTranId
+	s.exchange.drop = 1
+	t, err := s.orchestrator.TransferWithId(context.Background(), "id-1", from, to, "USDT", 5)
+	r.NoError(err)
+	r.Equal(TransferStatusConfirmed, t.Status)
+	post := s.exchange.posts["/sapi/v1/asset/universalTransfer"][0]
+	r.Equal("id-1", post.Get("clientTranId"))
+	r.Equal(AccountTypeSpot, post.Get("fromAccountType"))
+	r.Equal(AccountTypeUSDTFuture, post.Get("toAccountType"))
+	r.Equal("sub@test.com", post.Get("toEmail"))
+	r.Empty(post.Get("fromEmail"))
+
+	// not made: unknown until retried
+	s.exchange.lost = 1
+	t, err = s.orchestrator.TransferWithId(context.Background(), "id-2", from, to, "USDT", 5)
+	r.ErrorIs(err, ErrTransferUnconfirmed)
+	r.Equal(TransferStatusUnknown, t.Status)
+	r.Len(s.orchestrator.Transfers(), 2)
+
+	t, err = s.orchestrator.TransferWithId(context.Background(), "id-2", from, to, "USDT", 5)
+	r.NoError(err)
+	r.Equal(TransferStatusConfirmed, t.Status)
+	r.Len(s.exchange.posts["/sapi/v1/asset/universalTransfer"], 3)
+	r.Len(s.exchange.universal, 2)
+
+	// a restarted orchestrator knows the transfers
+	restarted := NewTransferOrchestrator(s.exchange.client())
+	restarted.Restore(s.orchestrator.Transfers()...)
+	_, err = restarted.TransferWithId(context.Background(), "id-2", from, to, "USDT", 5)
+	r.NoError(err)
+	r.Len(s.exchange.posts["/sapi/v1/asset/universalTransfer"], 3)
+}
+
+func (s *transferOrchestratorTestSuite) TestRejectedTransfer() {
+	r := s.Require()
+	from, to := Wallet{Kind: WalletKindSpot}, Wallet{Kind: WalletKindCrossMargin}
+	s.exchange.reject = true
+	_, err := s.orchestrator.TransferWithId(context.Background(), "id", from, to, "BTC", 1)
+	r.Error(err)
+	r.NotErrorIs(err, ErrTransferUnconfirmed)
+	r.Empty(s.orchestrator.Transfers())
+
+	s.exchange.reject = false
+	t, err := s.orchestrator.TransferWithId(context.Background(), "id", from, to, "BTC", 1)
+	r.NoError(err)
+	r.Equal(TransferStatusConfirmed, t.Status)
+}
+
+func (s *transferOrchestratorTestSuite) TestWithinSubAccount() {
+	r := s.Require()
+	spot, margin := Wallet{Kind: WalletKindSpot, Email: "sub@test.com"}, Wallet{Kind: WalletKindCrossMargin, Email: "sub@test.com"}
+	t, err := s.orchestrator.Transfer(context.Background(), spot, margin, "USDT", 1.5)
+	r.NoError(err)
+	r.Equal(TransferStatusConfirmed, t.Status)
+	post := s.exchange.posts["/sapi/v1/sub-account/margin/transfer"][0]
+	r.Equal("sub@test.com", post.Get("email"))
+	r.Equal("1", post.Get("type"))
+	r.Equal("1.5", post.Get("amount"))
+
+	// without a history, a transfer of unknown status is never looked up nor sent again
+	s.exchange.drop = 1
+	gets := len(s.exchange.gets)
+	t, err = s.orchestrator.Transfer(context.Background(), margin, spot, "USDT", 1.5)
+	r.ErrorIs(err, ErrTransferUnverifiable)
+	r.Equal(TransferStatusUnverifiable, t.Status)
+	r.True(t.Status.Final())
+	t, err = s.orchestrator.TransferWithId(context.Background(), t.ClientTranId, margin, spot, "USDT", 1.5)
+	r.ErrorIs(err, ErrTransferUnverifiable)
+	r.Equal(TransferStatusUnverifiable, t.Status)
+	r.Len(s.exchange.posts["/sapi/v1/sub-account/margin/transfer"], 2)
+	r.Len(s.exchange.gets, gets)
+
+	// transfers of unknown status restored from an earlier version are not looked up either
+	restored := *t
+	restored.ClientTranId, restored.Status = "restored", TransferStatusUnknown
+	s.orchestrator.Restore(&restored)
+	_, err = s.orchestrator.TransferWithId(context.Background(), "restored", margin, spot, "USDT", 1.5)
+	r.ErrorIs(err, ErrTransferUnverifiable)
+	r.Len(s.exchange.posts["/sapi/v1/sub-account/margin/transfer"], 2)
+}
@@ -3,7 +3,10 @@ package binance_connector
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // System Status (System)
@@ -70,42 +73,45 @@ func (s *GetAllCoinsInfoService) Do(ctx context.Context) (res []*CoinInfo, err e
 
 // CoinInfo define response of GetAllCoinsInfoService
 type CoinInfo struct {
-	Coin             string `json:"coin"`
-	DepositAllEnable bool   `json:"depositAllEnable"`
-	Free             string `json:"free"`
-	Freeze           string `json:"freeze"`
-	Ipoable          string `json:"ipoable"`
-	Ipoing           string `json:"ipoing"`
-	IsLegalMoney     bool   `json:"isLegalMoney"`
-	Locked           string `json:"locked"`
-	Name             string `json:"name"`
-	NetworkList      []struct {
-		AddressRegex            string `json:"addressRegex"`
-		Coin                    string `json:"coin"`
-		DepositDesc             string `json:"depositDesc"`
-		DepositEnable           bool   `json:"depositEnable"`
-		IsDefault               bool   `json:"isDefault"`
-		MemoRegex               string `json:"memoRegex"`
-		MinConfirm              int    `json:"minConfirm"`
-		Name                    string `json:"name"`
-		Network                 string `json:"network"`
-		ResetAddressStatus      bool   `json:"resetAddressStatus"`
-		SpecialTips             string `json:"specialTips"`
-		UnLockConfirm           int    `json:"unLockConfirm"`
-		WithdrawDesc            string `json:"withdrawDesc"`
-		WithdrawEnable          bool   `json:"withdrawEnable"`
-		WithdrawFee             string `json:"withdrawFee"`
-		WithdrawIntegerMultiple string `json:"withdrawIntegerMultiple"`
-		WithdrawMax             string `json:"withdrawMax"`
-		WithdrawMin             string `json:"withdrawMin"`
-		SameAddress             bool   `json:"sameAddress"`
-		EstimatedArrivalTime    uint64 `json:"estimatedArrivalTime"`
-		Busy                    bool   `json:"busy"`
-	} `json:"networkList"`
-	Storage           string `json:"storage"`
-	Trading           bool   `json:"trading"`
-	WithdrawAllEnable bool   `json:"withdrawAllEnable"`
-	Withdrawing       string `json:"withdrawing"`
+	Coin              string        `json:"coin"`
+	DepositAllEnable  bool          `json:"depositAllEnable"`
+	Free              string        `json:"free"`
+	Freeze            string        `json:"freeze"`
+	Ipoable           string        `json:"ipoable"`
+	Ipoing            string        `json:"ipoing"`
+	IsLegalMoney      bool          `json:"isLegalMoney"`
+	Locked            string        `json:"locked"`
+	Name              string        `json:"name"`
+	NetworkList       []CoinNetwork `json:"networkList"`
+	Storage           string        `json:"storage"`
+	Trading           bool          `json:"trading"`
+	WithdrawAllEnable bool          `json:"withdrawAllEnable"`
+	Withdrawing       string        `json:"withdrawing"`
+}
+
+// CoinNetwork define a network of CoinInfo
+type CoinNetwork struct {
+	AddressRegex            string `json:"addressRegex"`
+	Coin                    string `json:"coin"`
+	DepositDesc             string `json:"depositDesc"`
+	DepositEnable           bool   `json:"depositEnable"`
+	IsDefault               bool   `json:"isDefault"`
+	MemoRegex               string `json:"memoRegex"`
+	MinConfirm              int    `json:"minConfirm"`
+	Name                    string `json:"name"`
+	Network                 string `json:"network"`
+	ResetAddressStatus      bool   `json:"resetAddressStatus"`
+	SpecialTips             string `json:"specialTips"`
+	UnLockConfirm           int    `json:"unLockConfirm"`
+	WithdrawDesc            string `json:"withdrawDesc"`
+	WithdrawEnable          bool   `json:"withdrawEnable"`
+	WithdrawFee             string `json:"withdrawFee"`
+	WithdrawIntegerMultiple string `json:"withdrawIntegerMultiple"`
+	WithdrawMax             string `json:"withdrawMax"`
+	WithdrawMin             string `json:"withdrawMin"`
+	SameAddress             bool   `json:"sameAddress"`
+	EstimatedArrivalTime    uint64 `json:"estimatedArrivalTime"`
+	Busy                    bool   `json:"busy"`
 }
 
 // Daily Account Snapshot (USER_DATA)
@@ -174,22 +180,125 @@ func (s *GetAccountSnapshotService) Do(ctx context.Context) (res *AccountSnapsho
 	return res, nil
 }
 
+// Range returns an iterator over the daily snapshots taken between from and
+// to, splitting the range into the 30 day windows accepted by the endpoint.
+// Binance keeps the snapshots of the last month only.
+func (s *GetAccountSnapshotService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*AccountSnapshot] {
+	p := &windowPager[*AccountSnapshot]{
+		window: 30 * 24 * time.Hour,
+		// a window holds at most 30 daily snapshots, one call covers it
+		limit: 31,
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*AccountSnapshot, error) {
+			svc := *s
+			res, err := svc.StartTime(start).EndTime(end).Limit(30).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return res.SnapshotVos, nil
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
+// Market types of GetAccountSnapshotService
+const (
+	AccountSnapshotTypeSpot    = "SPOT"
+	AccountSnapshotTypeMargin  = "MARGIN"
+	AccountSnapshotTypeFutures = "FUTURES"
+)
+
 // AccountSnapshotResponse define response of GetAccountSnapshotService
 type AccountSnapshotResponse struct {
-	Code        int    `json:"code"`
-	Msg         string `json:"msg"`
-	SnapshotVos []struct {
-		Data struct {
-			Balances []struct {
-				Asset  string `json:"asset"`
-				Free   string `json:"free"`
-				Locked string `json:"locked"`
-			} `json:"balances"`
-			TotalAssetOfBtc string `json:"totalAssetOfBtc"`
-		} `json:"data"`
-		Type       string `json:"type"`
-		UpdateTime uint64 `json:"updateTime"`
-	} `json:"snapshotVos"`
+	Code        int                `json:"code"`
+	Msg         string             `json:"msg"`
+	SnapshotVos []*AccountSnapshot `json:"snapshotVos"`
+}
+
+// AccountSnapshot define a daily snapshot of GetAccountSnapshotService. Its
+// data depends on its Type, read it with SpotData, MarginData or FuturesData.
+type AccountSnapshot struct {
+	Data       json.RawMessage `json:"data"`
+	Type       string          `json:"type"`
+	UpdateTime uint64          `json:"updateTime"`
+}
+
+// SpotData returns the data of a SPOT snapshot
+func (s *AccountSnapshot) SpotData() (*SpotSnapshotData, error) {
+	data := new(SpotSnapshotData)
+	return data, s.decode(AccountSnapshotTypeSpot, data)
+}
+
+// MarginData returns the data of a MARGIN snapshot
+func (s *AccountSnapshot) MarginData() (*MarginSnapshotData, error) {
+	data := new(MarginSnapshotData)
+	return data, s.decode(AccountSnapshotTypeMargin, data)
+}
+
+// FuturesData returns the data of a FUTURES snapshot
+func (s *AccountSnapshot) FuturesData() (*FuturesSnapshotData, error) {
+	data := new(FuturesSnapshotData)
+	return data, s.decode(AccountSnapshotTypeFutures, data)
+}
+
+func (s *AccountSnapshot) decode(marketType string, data interface{}) error {
+	if s.Type != marketType {
+		return fmt.Errorf("snapshot of type %s, not %s", s.Type, marketType)
+	}
+	return json.Unmarshal(s.Data, data)
+}
+
+// SpotSnapshotData define the data of a SPOT snapshot
+type SpotSnapshotData struct {
+	Balances        []SpotSnapshotBalance `json:"balances"`
+	TotalAssetOfBtc string                `json:"totalAssetOfBtc"`
+}
+
+// MarginSnapshotData define the data of a MARGIN snapshot
+type MarginSnapshotData struct {
+	MarginLevel         string                `json:"marginLevel"`
+	TotalAssetOfBtc     string                `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc string                `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  string                `json:"totalNetAssetOfBtc"`
+	UserAssets          []MarginSnapshotAsset `json:"userAssets"`
+}
+
+// FuturesSnapshotData define the data of a FUTURES snapshot
+type FuturesSnapshotData struct {
+	Assets   []FuturesSnapshotAsset    `json:"assets"`
+	Position []FuturesSnapshotPosition `json:"position"`
+}
+
+// SpotSnapshotBalance define a balance of a SPOT snapshot
+type SpotSnapshotBalance struct {
+	Asset  string `json:"asset"`
+	Free   string `json:"free"`
+	Locked string `json:"locked"`
+}
+
+// MarginSnapshotAsset define an asset of a MARGIN snapshot
+type MarginSnapshotAsset struct {
+	Asset    string `json:"asset"`
+	Borrowed string `json:"borrowed"`
+	Free     string `json:"free"`
+	Interest string `json:"interest"`
+	Locked   string `json:"locked"`
+	NetAsset string `json:"netAsset"`
+}
+
+// FuturesSnapshotAsset define an asset of a FUTURES snapshot
+type FuturesSnapshotAsset struct {
+	Asset         string `json:"asset"`
+	MarginBalance string `json:"marginBalance"`
+	WalletBalance string `json:"walletBalance"`
+}
+
+// FuturesSnapshotPosition define a position of a FUTURES snapshot
+type FuturesSnapshotPosition struct {
+	EntryPrice       string `json:"entryPrice"`
+	MarkPrice        string `json:"markPrice"`
+	PositionAmt      string `json:"positionAmt"`
+	Symbol           string `json:"symbol"`
+	UnRealizedProfit string `json:"unRealizedProfit"`
 }
 
 // Disable Fast Withdraw Switch (USER_DATA)
@@ -339,7 +448,7 @@ func (s *WithdrawService) Do(ctx context.Context) (res *WithdrawResponse, err er
 	}
 	r.setParam("coin", s.coin)
 	r.setParam("address", s.address)
-	r.setParam("amount", s.amount)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
 	if s.withdrawOrderId != nil {
 		r.setParam("withdrawOrderId", *s.withdrawOrderId)
 	}
@@ -473,6 +582,21 @@ func (s *DepositHistoryService) Do(ctx context.Context) (res []*DepositHistoryRe
 	return res, nil
 }
 
+// Range returns an iterator over every deposit made between from and to,
+// splitting the range into the 90 day windows accepted by the endpoint
+func (s *DepositHistoryService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*DepositHistoryResponse] {
+	p := &windowPager[*DepositHistoryResponse]{
+		window: 90 * 24 * time.Hour,
+		limit:  1000,
+		key:    func(d *DepositHistoryResponse) any { return d.Id },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*DepositHistoryResponse, error) {
+			svc := *s
+			return svc.StartTime(start).EndTime(end).Offset(page * 1000).Limit(1000).Do(ctx)
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 // DepositHistoryResponse define response of DepositHistoryService
 type DepositHistoryResponse struct {
 	Id            string `json:"id"`
@@ -490,6 +614,42 @@ type DepositHistoryResponse struct {
 	WalletType    int    `json:"walletType"`
 }
 
+// DepositStatus define the status of a deposit in DepositHistoryResponse
+type DepositStatus int
+
+// Deposit statuses
+const (
+	DepositStatusPending            DepositStatus = 0
+	DepositStatusSuccess            DepositStatus = 1
+	DepositStatusRejected           DepositStatus = 2
+	DepositStatusCredited           DepositStatus = 6 // credited but cannot withdraw yet
+	DepositStatusWrongDeposit       DepositStatus = 7
+	DepositStatusWaitingUserConfirm DepositStatus = 8
+)
+
+// Final reports whether the deposit can no longer change status
+func (s DepositStatus) Final() bool {
+	return s == DepositStatusSuccess || s == DepositStatusRejected
+}
+
+func (s DepositStatus) String() string {
+	switch s {
+	case DepositStatusPending:
+		return "PENDING"
+	case DepositStatusSuccess:
+		return "SUCCESS"
+	case DepositStatusRejected:
+		return "REJECTED"
+	case DepositStatusCredited:
+		return "CREDITED"
+	case DepositStatusWrongDeposit:
+		return "WRONG_DEPOSIT"
+	case DepositStatusWaitingUserConfirm:
+		return "WAITING_USER_CONFIRM"
+	}
+	return "UNKNOWN(" + strconv.Itoa(int(s)) + ")"
+}
+
 // Withdraw History (supporting network) (USER_DATA)
 const (
 	withdrawHistoryEndpoint = "/sapi/v1/capital/withdraw/history"
@@ -588,6 +748,21 @@ func (s *WithdrawHistoryService) Do(ctx context.Context) (res []*WithdrawHistory
 	return res, nil
 }
 
+// Range returns an iterator over every withdrawal applied for between from
+// and to, splitting the range into the 90 day windows accepted by the endpoint
+func (s *WithdrawHistoryService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*WithdrawHistoryResponse] {
+	p := &windowPager[*WithdrawHistoryResponse]{
+		window: 90 * 24 * time.Hour,
+		limit:  1000,
+		key:    func(w *WithdrawHistoryResponse) any { return w.Id },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*WithdrawHistoryResponse, error) {
+			svc := *s
+			return svc.StartTime(start).EndTime(end).Offset(page * 1000).Limit(1000).Do(ctx)
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 // WithdrawHistoryResponse define response of WithdrawHistoryService
 type WithdrawHistoryResponse struct {
 	Id              string `json:"id"`
@@ -607,6 +782,49 @@ type WithdrawHistoryResponse struct {
 	TxKey           string `json:"txKey"`
 }
 
+// WithdrawStatus define the status of a withdrawal in WithdrawHistoryResponse
+type WithdrawStatus int
+
+// Withdraw statuses
+const (
+	WithdrawStatusEmailSent        WithdrawStatus = 0
+	WithdrawStatusCancelled        WithdrawStatus = 1
+	WithdrawStatusAwaitingApproval WithdrawStatus = 2
+	WithdrawStatusRejected         WithdrawStatus = 3
+	WithdrawStatusProcessing       WithdrawStatus = 4
+	WithdrawStatusFailure          WithdrawStatus = 5
+	WithdrawStatusCompleted        WithdrawStatus = 6
+)
+
+// Final reports whether the withdrawal can no longer change status
+func (s WithdrawStatus) Final() bool {
+	switch s {
+	case WithdrawStatusCancelled, WithdrawStatusRejected, WithdrawStatusFailure, WithdrawStatusCompleted:
+		return true
+	}
+	return false
+}
+
+func (s WithdrawStatus) String() string {
+	switch s {
+	case WithdrawStatusEmailSent:
+		return "EMAIL_SENT"
+	case WithdrawStatusCancelled:
+		return "CANCELLED"
+	case WithdrawStatusAwaitingApproval:
+		return "AWAITING_APPROVAL"
+	case WithdrawStatusRejected:
+		return "REJECTED"
+	case WithdrawStatusProcessing:
+		return "PROCESSING"
+	case WithdrawStatusFailure:
+		return "FAILURE"
+	case WithdrawStatusCompleted:
+		return "COMPLETED"
+	}
+	return "UNKNOWN(" + strconv.Itoa(int(s)) + ")"
+}
+
 // Deposit Address (supporting network) (USER_DATA)
 const (
 	depositAddressEndpoint = "/sapi/v1/capital/deposit/address"
@@ -784,23 +1002,50 @@ func (s *DustLogService) Do(ctx context.Context) (res *DustLogResponse, err erro
 	return res, nil
 }
 
+// Range returns an iterator over the dust conversions made between from and
+// to, splitting the range into 90 day windows. Binance returns the last 100
+// conversions of a window only.
+func (s *DustLogService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*DustLogDribblet] {
+	p := &windowPager[*DustLogDribblet]{
+		window: 90 * 24 * time.Hour,
+		limit:  100,
+		key:    func(d *DustLogDribblet) any { return d.TransId },
+		timeOf: func(d *DustLogDribblet) uint64 { return d.OperateTime },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*DustLogDribblet, error) {
+			svc := *s
+			res, err := svc.StartTime(start).EndTime(end).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return res.UserAssetDribblets, nil
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 // DustLogResponse define response of DustLogService
 type DustLogResponse struct {
-	Total              int `json:"total"`
-	UserAssetDribblets []struct {
-		OperateTime              uint64 `json:"operateTime"`
-		TotalTransferedAmount    string `json:"totalTransferedAmount"`
-		TotalServiceChargeAmount string `json:"totalServiceChargeAmount"`
-		TransId                  int64  `json:"transId"`
-		UserAssetDribbletDetails []struct {
-			TransId             int64  `json:"transId"`
-			ServiceChargeAmount string `json:"serviceChargeAmount"`
-			Amount              string `json:"amount"`
-			OperateTime         uint64 `json:"operateTime"`
-			TransferedAmount    string `json:"transferedAmount"`
-			FromAsset           string `json:"fromAsset"`
-		} `json:"userAssetDribbletDetails"`
-	} `json:"userAssetDribblets"`
+	Total              int                `json:"total"`
+	UserAssetDribblets []*DustLogDribblet `json:"userAssetDribblets"`
+}
+
+// DustLogDribblet define a conversion of dust to BNB
+type DustLogDribblet struct {
+	OperateTime              uint64          `json:"operateTime"`
+	TotalTransferedAmount    string          `json:"totalTransferedAmount"`
+	TotalServiceChargeAmount string          `json:"totalServiceChargeAmount"`
+	TransId                  int64           `json:"transId"`
+	UserAssetDribbletDetails []DustLogDetail `json:"userAssetDribbletDetails"`
+}
+
+// DustLogDetail define the conversion of an asset in a DustLogDribblet
+type DustLogDetail struct {
+	TransId             int64  `json:"transId"`
+	ServiceChargeAmount string `json:"serviceChargeAmount"`
+	Amount              string `json:"amount"`
+	OperateTime         uint64 `json:"operateTime"`
+	TransferedAmount    string `json:"transferedAmount"`
+	FromAsset           string `json:"fromAsset"`
 }
 
 // Get Assets That Can Be Converted Into BNB (USER_DATA)
@@ -970,17 +1215,40 @@ func (s *AssetDividendRecordService) Do(ctx context.Context) (res *AssetDividend
 	return res, nil
 }
 
+// Range returns an iterator over the dividends distributed between from and
+// to, splitting the range into the 180 day windows accepted by the endpoint
+func (s *AssetDividendRecordService) Range(ctx context.Context, from, to time.Time, opts ...IteratorOption) *Iterator[*AssetDividendRecord] {
+	p := &windowPager[*AssetDividendRecord]{
+		window: 180 * 24 * time.Hour,
+		limit:  500,
+		key:    func(d *AssetDividendRecord) any { return d.Id },
+		timeOf: func(d *AssetDividendRecord) uint64 { return d.DivTime },
+		fetch: func(ctx context.Context, start, end uint64, page int) ([]*AssetDividendRecord, error) {
+			svc := *s
+			res, err := svc.StartTime(start).EndTime(end).Limit(500).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return res.Rows, nil
+		},
+	}
+	return p.iterate(ctx, from, to, opts...)
+}
+
 // AssetDividendRecordResponse define response of AssetDividendRecordService
 type AssetDividendRecordResponse struct {
-	Rows []struct {
-		Id      int64  `json:"id"`
-		Amount  string `json:"amount"`
-		Asset   string `json:"asset"`
-		DivTime uint64 `json:"divTime"`
-		EnInfo  string `json:"enInfo"`
-		TranId  int64  `json:"tranId"`
-	} `json:"rows"`
-	Total int64 `json:"total"`
+	Rows  []*AssetDividendRecord `json:"rows"`
+	Total int64                  `json:"total"`
+}
+
+// AssetDividendRecord define a dividend of AssetDividendRecordService
+type AssetDividendRecord struct {
+	Id      int64  `json:"id"`
+	Amount  string `json:"amount"`
+	Asset   string `json:"asset"`
+	DivTime uint64 `json:"divTime"`
+	EnInfo  string `json:"enInfo"`
+	TranId  int64  `json:"tranId"`
 }
 
 // Asset Detail (USER_DATA)
@@ -1130,7 +1398,7 @@ func (s *UserUniversalTransferService) Do(ctx context.Context) (res *UserUnivers
 	}
 	r.setParam("type", s.transferType)
 	r.setParam("asset", s.asset)
-	r.setParam("amount", s.amount)
+	r.setParam("amount", strconv.FormatFloat(s.amount, 'f', -1, 64))
 	if s.fromSymbol != nil {
 		r.setParam("fromSymbol", *s.fromSymbol)
 	}
@@ -756,14 +756,18 @@ func (s *walletTestSuite) TestGetAccountSnapshot() {
 	s.Len(resp.SnapshotVos, 1)
 	s.Equal("SPOT", resp.SnapshotVos[0].Type)
 	s.Equal(uint64(1557712656239), resp.SnapshotVos[0].UpdateTime)
-	s.Len(resp.SnapshotVos[0].Data.Balances, 2)
-	s.Equal("BTC", resp.SnapshotVos[0].Data.Balances[0].Asset)
-	s.Equal("0.00219821", resp.SnapshotVos[0].Data.Balances[0].Free)
-	s.Equal("0.00000000", resp.SnapshotVos[0].Data.Balances[0].Locked)
-	s.Equal("LTC", resp.SnapshotVos[0].Data.Balances[1].Asset)
-	s.Equal("0.00000000", resp.SnapshotVos[0].Data.Balances[1].Free)
-	s.Equal("0.00000000", resp.SnapshotVos[0].Data.Balances[1].Locked)
-	s.Equal("0.00167717", resp.SnapshotVos[0].Data.TotalAssetOfBtc)
+	spot, err := resp.SnapshotVos[0].SpotData()
+	s.r().NoError(err)
+	s.Len(spot.Balances, 2)
+	s.Equal("BTC", spot.Balances[0].Asset)
+	s.Equal("0.00219821", spot.Balances[0].Free)
+	s.Equal("0.00000000", spot.Balances[0].Locked)
+	s.Equal("LTC", spot.Balances[1].Asset)
+	s.Equal("0.00000000", spot.Balances[1].Free)
+	s.Equal("0.00000000", spot.Balances[1].Locked)
+	s.Equal("0.00167717", spot.TotalAssetOfBtc)
+	_, err = resp.SnapshotVos[0].MarginData()
+	s.r().Error(err)
 }
 
 func (s *walletTestSuite) TestGetAllCoinsInfoService() {
@@ -436,6 +436,10 @@ func (w *WebsocketAPIClient) NewAccountPreventedMatchesService() *AccountPrevent
 	return &AccountPreventedMatchesService{websocketAPI: w}
 }
 
+func (w *WebsocketAPIClient) NewAccountCommissionService() *AccountCommissionService {
+	return &AccountCommissionService{websocketAPI: w}
+}
+
 // Market Websocket API Endpoints:
 func (w *WebsocketAPIClient) NewDepthService() *DepthService {
 	return &DepthService{websocketAPI: w}
@@ -526,6 +530,30 @@ func (w *WebsocketAPIClient) NewCancelOCOService() *OrderListCancelService {
 	return &OrderListCancelService{websocketAPI: w}
 }
 
+func (w *WebsocketAPIClient) NewPlaceSOROrderService() *SOROrderPlacementService {
+	return &SOROrderPlacementService{websocketAPI: w}
+}
+
+func (w *WebsocketAPIClient) NewTestPlaceSOROrderService() *TestSOROrderPlacementService {
+	return &TestSOROrderPlacementService{order: SOROrderPlacementService{websocketAPI: w}}
+}
+
+func (w *WebsocketAPIClient) NewPlaceOrderListOCOService() *OrderListPlaceOCOService {
+	return &OrderListPlaceOCOService{websocketAPI: w}
+}
+
+func (w *WebsocketAPIClient) NewPlaceOrderListOTOService() *OrderListPlaceOTOService {
+	return &OrderListPlaceOTOService{websocketAPI: w}
+}
+
+func (w *WebsocketAPIClient) NewPlaceOrderListOTOCOService() *OrderListPlaceOTOCOService {
+	return &OrderListPlaceOTOCOService{websocketAPI: w}
+}
+
+func (w *WebsocketAPIClient) NewAmendOrderKeepPriorityService() *OrderAmendKeepPriorityService {
+	return &OrderAmendKeepPriorityService{websocketAPI: w}
+}
+
 // User Data Websocket API Endpoints:
 func (w *WebsocketAPIClient) NewStartUserDataStreamService() *StartUserDataStreamService {
 	return &StartUserDataStreamService{websocketAPI: w}
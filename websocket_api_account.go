@@ -627,3 +627,72 @@ type PreventedMatch struct {
 	MakerPreventedQuantity  string `json:"makerPreventedQuantity"`
 	TransactTime            uint64 `json:"transactTime"`
 }
+
+type AccountCommissionService struct {
+	websocketAPI *WebsocketAPIClient
+	symbol       string
+	recvWindow   *int64
+}
+
+func (s *AccountCommissionService) Symbol(symbol string) *AccountCommissionService {
+	s.symbol = symbol
+	return s
+}
+
+func (s *AccountCommissionService) RecvWindow(recvWindow int64) *AccountCommissionService {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+func (s *AccountCommissionService) Do(ctx context.Context) (*AccountCommissionWsResponse, error) {
+	parameters := map[string]string{
+		"symbol": s.symbol,
+	}
+
+	if s.recvWindow != nil {
+		parameters["recvWindow"] = strconv.FormatInt(*s.recvWindow, 10)
+	}
+
+	signedParams, err := websocketAPISignature(s.websocketAPI.APIKey, s.websocketAPI.APISecret, parameters)
+	if err != nil {
+		panic(err)
+	}
+
+	id := getUUID()
+
+	payload := map[string]interface{}{
+		"id":     id,
+		"method": "account.commission",
+		"params": signedParams,
+	}
+
+	messageCh := make(chan []byte)
+	s.websocketAPI.ReqResponseMap[id] = messageCh
+
+	err2 := s.websocketAPI.SendMessage(payload)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	defer delete(s.websocketAPI.ReqResponseMap, id)
+
+	select {
+	case response := <-messageCh:
+		var accountCommissionResponse AccountCommissionWsResponse
+		err = json.Unmarshal(response, &accountCommissionResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &accountCommissionResponse, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type AccountCommissionWsResponse struct {
+	ID         string                     `json:"id"`
+	Status     int                        `json:"status"`
+	Error      *WsAPIErrorResponse        `json:"error,omitempty"`
+	Result     *AccountCommissionResponse `json:"result,omitempty"`
+	RateLimits []*WsAPIRateLimit          `json:"rateLimits"`
+}
@@ -1421,7 +1421,9 @@ type OrderReport struct {
 	TimeInForce             string `json:"timeInForce"`
 	Type                    string `json:"type"`
 	Side                    string `json:"side"`
-	WorkingTime             uint64 `json:"workingTime"`
+	StopPrice               string `json:"stopPrice,omitempty"`
+	IcebergQty              string `json:"icebergQty,omitempty"`
+	WorkingTime             int64  `json:"workingTime"`
 	SelfTradePreventionMode string `json:"selfTradePreventionMode"`
 }
 
@@ -1729,3 +1731,643 @@ type OpenOrderListItem struct {
 	OrderId       int64  `json:"orderId"`
 	ClientOrderId string `json:"clientOrderId"`
 }
+
+type SOROrderPlacementService struct {
+	websocketAPI            *WebsocketAPIClient
+	symbol                  string
+	side                    string
+	orderType               string
+	quantity                float64
+	timeInForce             *string
+	price                   *float64
+	newClientOrderId        *string
+	newOrderRespType        *string
+	icebergQty              *float64
+	strategyId              *int
+	strategyType            *int
+	selfTradePreventionMode *string
+	recvWindow              *int64
+}
+
+func (s *SOROrderPlacementService) Symbol(symbol string) *SOROrderPlacementService {
+	s.symbol = symbol
+	return s
+}
+
+func (s *SOROrderPlacementService) Side(side string) *SOROrderPlacementService {
+	s.side = side
+	return s
+}
+
+func (s *SOROrderPlacementService) OrderType(orderType string) *SOROrderPlacementService {
+	s.orderType = orderType
+	return s
+}
+
+func (s *SOROrderPlacementService) Quantity(quantity float64) *SOROrderPlacementService {
+	s.quantity = quantity
+	return s
+}
+
+func (s *SOROrderPlacementService) TimeInForce(timeInForce string) *SOROrderPlacementService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+func (s *SOROrderPlacementService) Price(price float64) *SOROrderPlacementService {
+	s.price = &price
+	return s
+}
+
+func (s *SOROrderPlacementService) NewClientOrderId(newClientOrderId string) *SOROrderPlacementService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+func (s *SOROrderPlacementService) NewOrderRespType(newOrderRespType string) *SOROrderPlacementService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+func (s *SOROrderPlacementService) IcebergQty(icebergQty float64) *SOROrderPlacementService {
+	s.icebergQty = &icebergQty
+	return s
+}
+
+func (s *SOROrderPlacementService) StrategyId(strategyId int) *SOROrderPlacementService {
+	s.strategyId = &strategyId
+	return s
+}
+
+func (s *SOROrderPlacementService) StrategyType(strategyType int) *SOROrderPlacementService {
+	s.strategyType = &strategyType
+	return s
+}
+
+func (s *SOROrderPlacementService) SelfTradePreventionMode(selfTradePreventionMode string) *SOROrderPlacementService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+func (s *SOROrderPlacementService) RecvWindow(recvWindow int64) *SOROrderPlacementService {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+func (s *SOROrderPlacementService) parameters() map[string]string {
+	parameters := map[string]string{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": strconv.FormatFloat(s.quantity, 'f', -1, 64),
+	}
+
+	if s.timeInForce != nil {
+		parameters["timeInForce"] = *s.timeInForce
+	}
+	if s.price != nil {
+		parameters["price"] = strconv.FormatFloat(*s.price, 'f', -1, 64)
+	}
+	if s.newClientOrderId != nil {
+		parameters["newClientOrderId"] = *s.newClientOrderId
+	}
+	if s.newOrderRespType != nil {
+		parameters["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.icebergQty != nil {
+		parameters["icebergQty"] = strconv.FormatFloat(*s.icebergQty, 'f', -1, 64)
+	}
+	if s.strategyId != nil {
+		parameters["strategyId"] = strconv.Itoa(*s.strategyId)
+	}
+	if s.strategyType != nil {
+		parameters["strategyType"] = strconv.Itoa(*s.strategyType)
+	}
+	if s.selfTradePreventionMode != nil {
+		parameters["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.recvWindow != nil {
+		parameters["recvWindow"] = strconv.FormatInt(*s.recvWindow, 10)
+	}
+	return parameters
+}
+
+func (s *SOROrderPlacementService) Do(ctx context.Context) (*SOROrderPlacementResponse, error) {
+	signedParams, err := websocketAPISignature(s.websocketAPI.APIKey, s.websocketAPI.APISecret, s.parameters())
+	if err != nil {
+		panic(err)
+	}
+
+	id := getUUID()
+
+	payload := map[string]interface{}{
+		"id":     id,
+		"method": "sor.order.place",
+		"params": signedParams,
+	}
+
+	messageCh := make(chan []byte)
+	s.websocketAPI.ReqResponseMap[id] = messageCh
+
+	err2 := s.websocketAPI.SendMessage(payload)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	defer delete(s.websocketAPI.ReqResponseMap, id)
+
+	select {
+	case response := <-messageCh:
+		var sorOrderPlacementResponse SOROrderPlacementResponse
+		err = json.Unmarshal(response, &sorOrderPlacementResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &sorOrderPlacementResponse, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type SOROrderPlacementResponse struct {
+	ID         string              `json:"id"`
+	Status     int                 `json:"status"`
+	Error      *WsAPIErrorResponse `json:"error,omitempty"`
+	Result     []*SOROrderResponse `json:"result,omitempty"`
+	RateLimits []*WsAPIRateLimit   `json:"rateLimits"`
+}
+
+type TestSOROrderPlacementService struct {
+	order                  SOROrderPlacementService
+	computeCommissionRates *bool
+}
+
+func (s *TestSOROrderPlacementService) Symbol(symbol string) *TestSOROrderPlacementService {
+	s.order.Symbol(symbol)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) Side(side string) *TestSOROrderPlacementService {
+	s.order.Side(side)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) OrderType(orderType string) *TestSOROrderPlacementService {
+	s.order.OrderType(orderType)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) Quantity(quantity float64) *TestSOROrderPlacementService {
+	s.order.Quantity(quantity)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) TimeInForce(timeInForce string) *TestSOROrderPlacementService {
+	s.order.TimeInForce(timeInForce)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) Price(price float64) *TestSOROrderPlacementService {
+	s.order.Price(price)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) NewClientOrderId(newClientOrderId string) *TestSOROrderPlacementService {
+	s.order.NewClientOrderId(newClientOrderId)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) NewOrderRespType(newOrderRespType string) *TestSOROrderPlacementService {
+	s.order.NewOrderRespType(newOrderRespType)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) IcebergQty(icebergQty float64) *TestSOROrderPlacementService {
+	s.order.IcebergQty(icebergQty)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) StrategyId(strategyId int) *TestSOROrderPlacementService {
+	s.order.StrategyId(strategyId)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) StrategyType(strategyType int) *TestSOROrderPlacementService {
+	s.order.StrategyType(strategyType)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) SelfTradePreventionMode(selfTradePreventionMode string) *TestSOROrderPlacementService {
+	s.order.SelfTradePreventionMode(selfTradePreventionMode)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) RecvWindow(recvWindow int64) *TestSOROrderPlacementService {
+	s.order.RecvWindow(recvWindow)
+	return s
+}
+
+func (s *TestSOROrderPlacementService) ComputeCommissionRates(computeCommissionRates bool) *TestSOROrderPlacementService {
+	s.computeCommissionRates = &computeCommissionRates
+	return s
+}
+
+func (s *TestSOROrderPlacementService) Do(ctx context.Context) (*TestSOROrderPlacementResponse, error) {
+	parameters := s.order.parameters()
+	if s.computeCommissionRates != nil {
+		parameters["computeCommissionRates"] = strconv.FormatBool(*s.computeCommissionRates)
+	}
+
+	websocketAPI := s.order.websocketAPI
+	signedParams, err := websocketAPISignature(websocketAPI.APIKey, websocketAPI.APISecret, parameters)
+	if err != nil {
+		panic(err)
+	}
+
+	id := getUUID()
+
+	payload := map[string]interface{}{
+		"id":     id,
+		"method": "sor.order.test",
+		"params": signedParams,
+	}
+
+	messageCh := make(chan []byte)
+	websocketAPI.ReqResponseMap[id] = messageCh
+
+	err2 := websocketAPI.SendMessage(payload)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	defer delete(websocketAPI.ReqResponseMap, id)
+
+	select {
+	case response := <-messageCh:
+		var testSOROrderPlacementResponse TestSOROrderPlacementResponse
+		err = json.Unmarshal(response, &testSOROrderPlacementResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &testSOROrderPlacementResponse, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type TestSOROrderPlacementResponse struct {
+	ID         string                `json:"id"`
+	Status     int                   `json:"status"`
+	Error      *WsAPIErrorResponse   `json:"error,omitempty"`
+	Result     *TestSOROrderResponse `json:"result,omitempty"`
+	RateLimits []*WsAPIRateLimit     `json:"rateLimits"`
+}
+
+type OrderListPlaceOCOService struct {
+	websocketAPI *WebsocketAPIClient
+	params       orderListOCOParams
+	recvWindow   *int64
+}
+
+func (s *OrderListPlaceOCOService) Symbol(symbol string) *OrderListPlaceOCOService {
+	s.params.symbol = symbol
+	return s
+}
+
+func (s *OrderListPlaceOCOService) ListClientOrderId(listClientOrderId string) *OrderListPlaceOCOService {
+	s.params.listClientOrderId = &listClientOrderId
+	return s
+}
+
+func (s *OrderListPlaceOCOService) Side(side string) *OrderListPlaceOCOService {
+	s.params.side = side
+	return s
+}
+
+func (s *OrderListPlaceOCOService) Quantity(quantity float64) *OrderListPlaceOCOService {
+	s.params.quantity = quantity
+	return s
+}
+
+func (s *OrderListPlaceOCOService) Above(above *OrderListLeg) *OrderListPlaceOCOService {
+	s.params.above = above
+	return s
+}
+
+func (s *OrderListPlaceOCOService) Below(below *OrderListLeg) *OrderListPlaceOCOService {
+	s.params.below = below
+	return s
+}
+
+func (s *OrderListPlaceOCOService) NewOrderRespType(newOrderRespType string) *OrderListPlaceOCOService {
+	s.params.newOrderRespType = &newOrderRespType
+	return s
+}
+
+func (s *OrderListPlaceOCOService) SelfTradePreventionMode(selfTradePreventionMode string) *OrderListPlaceOCOService {
+	s.params.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+func (s *OrderListPlaceOCOService) RecvWindow(recvWindow int64) *OrderListPlaceOCOService {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+func (s *OrderListPlaceOCOService) Do(ctx context.Context) (*OrderListPlaceResponse, error) {
+	parameters, err := s.params.parameters()
+	if err != nil {
+		return nil, err
+	}
+	return s.websocketAPI.placeOrderList(ctx, "orderList.place.oco", parameters, s.recvWindow)
+}
+
+type OrderListPlaceOTOService struct {
+	websocketAPI *WebsocketAPIClient
+	params       orderListOTOParams
+	recvWindow   *int64
+}
+
+func (s *OrderListPlaceOTOService) Symbol(symbol string) *OrderListPlaceOTOService {
+	s.params.symbol = symbol
+	return s
+}
+
+func (s *OrderListPlaceOTOService) ListClientOrderId(listClientOrderId string) *OrderListPlaceOTOService {
+	s.params.listClientOrderId = &listClientOrderId
+	return s
+}
+
+func (s *OrderListPlaceOTOService) Working(working *OrderListLeg) *OrderListPlaceOTOService {
+	s.params.working = working
+	return s
+}
+
+func (s *OrderListPlaceOTOService) WorkingSide(workingSide string) *OrderListPlaceOTOService {
+	s.params.workingSide = workingSide
+	return s
+}
+
+func (s *OrderListPlaceOTOService) WorkingQuantity(workingQuantity float64) *OrderListPlaceOTOService {
+	s.params.workingQuantity = workingQuantity
+	return s
+}
+
+func (s *OrderListPlaceOTOService) Pending(pending *OrderListLeg) *OrderListPlaceOTOService {
+	s.params.pending = pending
+	return s
+}
+
+func (s *OrderListPlaceOTOService) PendingSide(pendingSide string) *OrderListPlaceOTOService {
+	s.params.pendingSide = pendingSide
+	return s
+}
+
+func (s *OrderListPlaceOTOService) PendingQuantity(pendingQuantity float64) *OrderListPlaceOTOService {
+	s.params.pendingQuantity = pendingQuantity
+	return s
+}
+
+func (s *OrderListPlaceOTOService) NewOrderRespType(newOrderRespType string) *OrderListPlaceOTOService {
+	s.params.newOrderRespType = &newOrderRespType
+	return s
+}
+
+func (s *OrderListPlaceOTOService) SelfTradePreventionMode(selfTradePreventionMode string) *OrderListPlaceOTOService {
+	s.params.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+func (s *OrderListPlaceOTOService) RecvWindow(recvWindow int64) *OrderListPlaceOTOService {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+func (s *OrderListPlaceOTOService) Do(ctx context.Context) (*OrderListPlaceResponse, error) {
+	parameters, err := s.params.parameters()
+	if err != nil {
+		return nil, err
+	}
+	return s.websocketAPI.placeOrderList(ctx, "orderList.place.oto", parameters, s.recvWindow)
+}
+
+type OrderListPlaceOTOCOService struct {
+	websocketAPI *WebsocketAPIClient
+	params       orderListOTOCOParams
+	recvWindow   *int64
+}
+
+func (s *OrderListPlaceOTOCOService) Symbol(symbol string) *OrderListPlaceOTOCOService {
+	s.params.symbol = symbol
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) ListClientOrderId(listClientOrderId string) *OrderListPlaceOTOCOService {
+	s.params.listClientOrderId = &listClientOrderId
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) Working(working *OrderListLeg) *OrderListPlaceOTOCOService {
+	s.params.working = working
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) WorkingSide(workingSide string) *OrderListPlaceOTOCOService {
+	s.params.workingSide = workingSide
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) WorkingQuantity(workingQuantity float64) *OrderListPlaceOTOCOService {
+	s.params.workingQuantity = workingQuantity
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) PendingSide(pendingSide string) *OrderListPlaceOTOCOService {
+	s.params.pendingSide = pendingSide
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) PendingQuantity(pendingQuantity float64) *OrderListPlaceOTOCOService {
+	s.params.pendingQuantity = pendingQuantity
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) PendingAbove(pendingAbove *OrderListLeg) *OrderListPlaceOTOCOService {
+	s.params.pendingAbove = pendingAbove
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) PendingBelow(pendingBelow *OrderListLeg) *OrderListPlaceOTOCOService {
+	s.params.pendingBelow = pendingBelow
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) NewOrderRespType(newOrderRespType string) *OrderListPlaceOTOCOService {
+	s.params.newOrderRespType = &newOrderRespType
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) SelfTradePreventionMode(selfTradePreventionMode string) *OrderListPlaceOTOCOService {
+	s.params.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) RecvWindow(recvWindow int64) *OrderListPlaceOTOCOService {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+func (s *OrderListPlaceOTOCOService) Do(ctx context.Context) (*OrderListPlaceResponse, error) {
+	parameters, err := s.params.parameters()
+	if err != nil {
+		return nil, err
+	}
+	return s.websocketAPI.placeOrderList(ctx, "orderList.place.otoco", parameters, s.recvWindow)
+}
+
+func (w *WebsocketAPIClient) placeOrderList(ctx context.Context, method string, parameters map[string]string, recvWindow *int64) (*OrderListPlaceResponse, error) {
+	if recvWindow != nil {
+		parameters["recvWindow"] = strconv.FormatInt(*recvWindow, 10)
+	}
+
+	signedParams, err := websocketAPISignature(w.APIKey, w.APISecret, parameters)
+	if err != nil {
+		panic(err)
+	}
+
+	id := getUUID()
+
+	payload := map[string]interface{}{
+		"id":     id,
+		"method": method,
+		"params": signedParams,
+	}
+
+	messageCh := make(chan []byte)
+	w.ReqResponseMap[id] = messageCh
+
+	err2 := w.SendMessage(payload)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	defer delete(w.ReqResponseMap, id)
+
+	select {
+	case response := <-messageCh:
+		var orderListPlaceResponse OrderListPlaceResponse
+		err = json.Unmarshal(response, &orderListPlaceResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &orderListPlaceResponse, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type OrderAmendKeepPriorityService struct {
+	websocketAPI      *WebsocketAPIClient
+	symbol            string
+	orderId           *int64
+	origClientOrderId *string
+	newClientOrderId  *string
+	newQty            float64
+	recvWindow        *int64
+}
+
+func (s *OrderAmendKeepPriorityService) Symbol(symbol string) *OrderAmendKeepPriorityService {
+	s.symbol = symbol
+	return s
+}
+
+func (s *OrderAmendKeepPriorityService) OrderId(orderId int64) *OrderAmendKeepPriorityService {
+	s.orderId = &orderId
+	return s
+}
+
+func (s *OrderAmendKeepPriorityService) OrigClientOrderId(origClientOrderId string) *OrderAmendKeepPriorityService {
+	s.origClientOrderId = &origClientOrderId
+	return s
+}
+
+func (s *OrderAmendKeepPriorityService) NewClientOrderId(newClientOrderId string) *OrderAmendKeepPriorityService {
+	s.newClientOrderId = &newClientOrderId
+	return s
+}
+
+func (s *OrderAmendKeepPriorityService) NewQty(newQty float64) *OrderAmendKeepPriorityService {
+	s.newQty = newQty
+	return s
+}
+
+func (s *OrderAmendKeepPriorityService) RecvWindow(recvWindow int64) *OrderAmendKeepPriorityService {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+func (s *OrderAmendKeepPriorityService) Do(ctx context.Context) (*OrderAmendKeepPriorityResponse, error) {
+	parameters := map[string]string{
+		"symbol": s.symbol,
+		"newQty": strconv.FormatFloat(s.newQty, 'f', -1, 64),
+	}
+
+	if s.orderId != nil {
+		parameters["orderId"] = strconv.FormatInt(*s.orderId, 10)
+	}
+
+	if s.origClientOrderId != nil {
+		parameters["origClientOrderId"] = *s.origClientOrderId
+	}
+
+	if s.newClientOrderId != nil {
+		parameters["newClientOrderId"] = *s.newClientOrderId
+	}
+
+	if s.recvWindow != nil {
+		parameters["recvWindow"] = strconv.FormatInt(*s.recvWindow, 10)
+	}
+
+	signedParams, err := websocketAPISignature(s.websocketAPI.APIKey, s.websocketAPI.APISecret, parameters)
+	if err != nil {
+		panic(err)
+	}
+
+	id := getUUID()
+
+	payload := map[string]interface{}{
+		"id":     id,
+		"method": "order.amend.keepPriority",
+		"params": signedParams,
+	}
+
+	messageCh := make(chan []byte)
+	s.websocketAPI.ReqResponseMap[id] = messageCh
+
+	err2 := s.websocketAPI.SendMessage(payload)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	defer delete(s.websocketAPI.ReqResponseMap, id)
+
+	select {
+	case response := <-messageCh:
+		var orderAmendKeepPriorityResponse OrderAmendKeepPriorityResponse
+		err = json.Unmarshal(response, &orderAmendKeepPriorityResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &orderAmendKeepPriorityResponse, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type OrderAmendKeepPriorityResponse struct {
+	ID         string                          `json:"id"`
+	Status     int                             `json:"status"`
+	Error      *WsAPIErrorResponse             `json:"error,omitempty"`
+	Result     *AmendOrderKeepPriorityResponse `json:"result,omitempty"`
+	RateLimits []*WsAPIRateLimit               `json:"rateLimits"`
+}
@@ -42,6 +42,9 @@ const (
 	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
 )
 
+// ExecutionTypeReplaced is the execution type of an executionReport sent for an order amended with keepPriority
+const ExecutionTypeReplaced = "REPLACED"
+
 var (
 	// WebsocketTimeout is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
 	WebsocketTimeout = time.Second * 60
@@ -495,6 +498,7 @@ type WsUserDataEvent struct {
 	BalanceUpdate     WsBalanceUpdate
 	OrderUpdate       WsOrderUpdate
 	OCOUpdate         WsOCOUpdate
+	Amendment         *WsOrderAmendment // set for executionReport events of execution type REPLACED
 }
 
 type WsAccountUpdateList struct {
@@ -550,6 +554,18 @@ type WsOrderUpdate struct {
 	SelfTradePreventionMode string          `json:"V"`
 }
 
+// WsOrderAmendment define an order amendment reported on the user data stream
+type WsOrderAmendment struct {
+	Symbol            string
+	OrderId           int64
+	OrderListId       int64
+	OrigClientOrderId string
+	ClientOrderId     string
+	NewQty            string
+	ExecutedQty       string
+	TransactionTime   int64
+}
+
 type WsOCOUpdate struct {
 	Symbol          string `json:"s"`
 	OrderListId     int64  `json:"g"`
@@ -618,6 +634,18 @@ func (c *WebsocketStreamClient) WsUserDataServe(listenKey string, handler WsUser
 			event.OrderUpdate.Id = j.Get("i").MustInt64()
 			event.OrderUpdate.TradeId = j.Get("t").MustInt64()
 			event.OrderUpdate.FeeAsset = j.Get("N").MustString()
+			if event.OrderUpdate.ExecutionType == ExecutionTypeReplaced {
+				event.Amendment = &WsOrderAmendment{
+					Symbol:            event.OrderUpdate.Symbol,
+					OrderId:           event.OrderUpdate.Id,
+					OrderListId:       event.OrderUpdate.OrderListId,
+					OrigClientOrderId: event.OrderUpdate.OrigCustomOrderId,
+					ClientOrderId:     event.OrderUpdate.ClientOrderId,
+					NewQty:            event.OrderUpdate.Volume,
+					ExecutedQty:       event.OrderUpdate.FilledVolume,
+					TransactionTime:   event.OrderUpdate.TransactionTime,
+				}
+			}
 		case UserDataEventTypeListStatus:
 			err = json.Unmarshal(message, &event.OCOUpdate)
 			if err != nil {
@@ -824,3 +852,102 @@ func (c *WebsocketStreamClient) WsCombinedBookTickerServe(symbols []string, hand
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
+
+// Options user data event types
+const (
+	OptionsUserDataEventTypeAccountUpdate    UserDataEventType = "ACCOUNT_UPDATE"
+	OptionsUserDataEventTypeOrderTradeUpdate UserDataEventType = "ORDER_TRADE_UPDATE"
+)
+
+// WsOptionsUserDataEvent define options user data event. Balances, Greeks,
+// Positions and UserId are set on ACCOUNT_UPDATE, Orders on ORDER_TRADE_UPDATE.
+type WsOptionsUserDataEvent struct {
+	Event     UserDataEventType       `json:"e"`
+	Time      int64                   `json:"E"`
+	Balances  []*WsOptionsBalance     `json:"B"`
+	Greeks    []*WsOptionsGreeks      `json:"G"`
+	Positions []*WsOptionsPosition    `json:"P"`
+	UserId    int64                   `json:"uid"`
+	Orders    []*WsOptionsOrderUpdate `json:"o"`
+}
+
+// WsOptionsBalance define the balance of a margin asset of the options account
+type WsOptionsBalance struct {
+	Asset                 string  `json:"a"`
+	Balance               string  `json:"b"`
+	PositionValue         string  `json:"m"`
+	UnrealizedPNL         string  `json:"u"`
+	PositiveUnrealizedPNL float64 `json:"U"`
+	MaintenanceMargin     string  `json:"M"`
+	InitialMargin         string  `json:"i"`
+}
+
+// WsOptionsGreeks define the Greeks of the positions on an underlying
+type WsOptionsGreeks struct {
+	Underlying string  `json:"ui"`
+	Delta      float64 `json:"d"`
+	Theta      float64 `json:"t"`
+	Gamma      float64 `json:"g"`
+	Vega       float64 `json:"v"`
+}
+
+// WsOptionsPosition define an option position
+type WsOptionsPosition struct {
+	Symbol       string `json:"s"`
+	Quantity     string `json:"c"`
+	ReducibleQty string `json:"r"`
+	Value        string `json:"p"`
+	AvgPrice     string `json:"a"`
+}
+
+// WsOptionsOrderUpdate define an option order update
+type WsOptionsOrderUpdate struct {
+	CreateTime    int64                 `json:"T"`
+	UpdateTime    int64                 `json:"t"`
+	Symbol        string                `json:"s"`
+	ClientOrderId string                `json:"c"`
+	OrderId       string                `json:"oid"`
+	Price         string                `json:"p"`
+	Quantity      string                `json:"q"`
+	StpFlag       int                   `json:"stp"`
+	ReduceOnly    bool                  `json:"r"`
+	PostOnly      bool                  `json:"po"`
+	Status        string                `json:"S"`
+	ExecutedQty   string                `json:"e"`
+	ExecutedCost  string                `json:"ec"`
+	Fee           string                `json:"f"`
+	TimeInForce   TimeInForceType       `json:"tif"`
+	Type          OrderType             `json:"oty"`
+	Fills         []*WsOptionsOrderFill `json:"fi"`
+}
+
+// WsOptionsOrderFill define a fill of an option order
+type WsOptionsOrderFill struct {
+	TradeId   string `json:"t"`
+	Price     string `json:"p"`
+	Quantity  string `json:"q"`
+	TradeTime int64  `json:"T"`
+	Liquidity string `json:"m"` // TAKER or MAKER
+	Fee       string `json:"f"`
+}
+
+// WsOptionsUserDataHandler handle WsOptionsUserDataEvent
+type WsOptionsUserDataHandler func(event *WsOptionsUserDataEvent)
+
+// WsOptionsUserDataServe serve the options user data stream of listenKey, created with
+// OptionsClient.NewOptionsCreateListenKeyService. The client must be created with the
+// options stream base URL, "wss://nbstream.binance.com/eoptions".
+func (c *WebsocketStreamClient) WsOptionsUserDataServe(listenKey string, handler WsOptionsUserDataHandler, errHandler ErrHandler) (doneCh, stopCh chan struct{}, err error) {
+	endpoint := fmt.Sprintf("%s/%s", c.Endpoint, listenKey)
+	cfg := newWsConfig(endpoint)
+	wsHandler := func(message []byte) {
+		event := new(WsOptionsUserDataEvent)
+		err := json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
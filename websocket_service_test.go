@@ -63,6 +63,7 @@ func (s *websocketTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	}
 	s.assertOrderUpdate(&e.OrderUpdate, &a.OrderUpdate)
 	s.assertBalanceUpdate(&e.BalanceUpdate, &a.BalanceUpdate)
+	r.Equal(e.Amendment, a.Amendment, "Amendment")
 }
 
 func (s *websocketTestSuite) testWsUserDataServe(data []byte, expectedEvent *WsUserDataEvent) {
@@ -157,6 +158,87 @@ func (s *websocketTestSuite) TestWsUserDataServeAccountUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketTestSuite) TestWsUserDataServeOrderAmendment() {
+	data := []byte(`{
+	   "e":"executionReport",
+	   "E":1741923284382,
+	   "s":"BTCUSDT",
+	   "c":"amended",
+	   "S":"SELL",
+	   "o":"LIMIT",
+	   "f":"GTC",
+	   "q":"4.00000000",
+	   "p":"1.00000000",
+	   "P":"0.00000000",
+	   "F":"0.00000000",
+	   "g":-1,
+	   "C":"original",
+	   "x":"REPLACED",
+	   "X":"NEW",
+	   "r":"NONE",
+	   "i":9,
+	   "l":"0.00000000",
+	   "z":"0.00000000",
+	   "L":"0.00000000",
+	   "n":"0",
+	   "N":null,
+	   "T":1741923284381,
+	   "t":-1,
+	   "w":true,
+	   "m":false,
+	   "O":1741923284381,
+	   "Z":"0.00000000",
+	   "Y":"0.00000000",
+	   "Q":"0.00000000",
+	   "W":1741923284381,
+	   "V":"NONE"
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "executionReport",
+		Time:            1741923284382,
+		TransactionTime: 1741923284381,
+		OrderUpdate: WsOrderUpdate{
+			Symbol:            "BTCUSDT",
+			ClientOrderId:     "amended",
+			Side:              "SELL",
+			Type:              "LIMIT",
+			TimeInForce:       "GTC",
+			Volume:            "4.00000000",
+			Price:             "1.00000000",
+			StopPrice:         "0.00000000",
+			IceBergVolume:     "0.00000000",
+			OrderListId:       -1,
+			OrigCustomOrderId: "original",
+			ExecutionType:     "REPLACED",
+			Status:            "NEW",
+			RejectReason:      "NONE",
+			Id:                9,
+			LatestVolume:      "0.00000000",
+			FilledVolume:      "0.00000000",
+			LatestPrice:       "0.00000000",
+			FeeCost:           "0",
+			TransactionTime:   1741923284381,
+			TradeId:           -1,
+			IsInOrderBook:     true,
+			CreateTime:        1741923284381,
+			FilledQuoteVolume: "0.00000000",
+			LatestQuoteVolume: "0.00000000",
+			QuoteVolume:       "0.00000000",
+		},
+		Amendment: &WsOrderAmendment{
+			Symbol:            "BTCUSDT",
+			OrderId:           9,
+			OrderListId:       -1,
+			OrigClientOrderId: "original",
+			ClientOrderId:     "amended",
+			NewQty:            "4.00000000",
+			ExecutedQty:       "0.00000000",
+			TransactionTime:   1741923284381,
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketTestSuite) TestWsTradeServe() {
 	websocketStreamClient := NewWebsocketStreamClient(false, "wss://stream.testnet.binance.vision")
 
@@ -719,3 +801,118 @@ func (s *websocketTestSuite) assertWsMarketTickerStatEventEqual(e, a *WsMarketTi
 	r.Equal(e.LastID, a.LastID, "LastID")
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
 }
+
+func (s *websocketTestSuite) TestWsOptionsUserDataServe() {
+	data := []byte(`{
+		"e": "ORDER_TRADE_UPDATE",
+		"E": 1657613775883,
+		"o": [
+			{
+				"T": 1657613342918,
+				"t": 1657613342918,
+				"s": "BTC-220930-18000-C",
+				"c": "",
+				"oid": "4611869636869226548",
+				"p": "1993",
+				"q": "1",
+				"stp": 0,
+				"r": false,
+				"po": true,
+				"S": "PARTIALLY_FILLED",
+				"e": "0.1",
+				"ec": "199.3",
+				"f": "2",
+				"tif": "GTC",
+				"oty": "LIMIT",
+				"fi": [
+					{
+						"t": "20",
+						"p": "1993",
+						"q": "0.1",
+						"T": 1657613774336,
+						"m": "TAKER",
+						"f": "0.0002"
+					}
+				]
+			}
+		]
+	}`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	doneC, stopC, err := NewWebsocketStreamClient(false, "wss://nbstream.binance.com/eoptions").
+		WsOptionsUserDataServe("listenKey", func(event *WsOptionsUserDataEvent) {
+			s.Equal(OptionsUserDataEventTypeOrderTradeUpdate, event.Event)
+			s.Equal(int64(1657613775883), event.Time)
+			s.r().Len(event.Orders, 1)
+			order := event.Orders[0]
+			s.Equal("BTC-220930-18000-C", order.Symbol)
+			s.Equal("PARTIALLY_FILLED", order.Status)
+			s.Equal(int64(1657613342918), order.CreateTime)
+			s.Equal("0.1", order.ExecutedQty)
+			s.r().Len(order.Fills, 1)
+			s.Equal(int64(1657613774336), order.Fills[0].TradeTime)
+			s.Equal("20", order.Fills[0].TradeId)
+		}, func(err error) {
+			s.r().EqualError(err, fakeErrMsg)
+		})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
+func (s *websocketTestSuite) TestWsOptionsUserDataServeAccountUpdate() {
+	data := []byte(`{
+		"e": "ACCOUNT_UPDATE",
+		"E": 1591097736954,
+		"B": [
+			{
+				"b": "100000.00000000",
+				"m": "100000.00000000",
+				"u": "0.00000000",
+				"U": 0,
+				"M": "0.00000000",
+				"i": "0.00000000",
+				"a": "USDT"
+			}
+		],
+		"G": [
+			{
+				"ui": "SOLUSDT",
+				"d": -33.2933905,
+				"t": 35.5926375,
+				"g": -17.0482052,
+				"v": -1.2696719
+			}
+		],
+		"P": [
+			{
+				"s": "SOL-220912-35-C",
+				"c": "-50.00000000",
+				"r": "-50.00000000",
+				"p": "-100.00000000",
+				"a": "32.00000000"
+			}
+		],
+		"uid": 1000006559949
+	}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	doneC, stopC, err := NewWebsocketStreamClient(false, "wss://nbstream.binance.com/eoptions").
+		WsOptionsUserDataServe("listenKey", func(event *WsOptionsUserDataEvent) {
+			s.Equal(OptionsUserDataEventTypeAccountUpdate, event.Event)
+			s.Equal("100000.00000000", event.Balances[0].Balance)
+			s.Equal("USDT", event.Balances[0].Asset)
+			s.Equal(-33.2933905, event.Greeks[0].Delta)
+			s.Equal(35.5926375, event.Greeks[0].Theta)
+			s.Equal("-50.00000000", event.Positions[0].Quantity)
+			s.Equal(int64(1000006559949), event.UserId)
+		}, func(err error) {
+			s.r().NoError(err)
+		})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
@@ -0,0 +1,357 @@
+package binance_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+)
+
+// Errors returned by WithdrawalGuard. The checks wrap them with the reason of the refusal.
+var (
+	ErrWithdrawalNotAllowed  = errors.New("withdrawal address not allowed")
+	ErrWithdrawalLimit       = errors.New("withdrawal outside network limits")
+	ErrWithdrawalDailyCap    = errors.New("withdrawal exceeds daily cap")
+	ErrWithdrawalNotApproved = errors.New("withdrawal not approved")
+)
+
+// WithdrawalAddress define an allowed withdrawal destination. An empty
+// AddressTag allows any tag.
+type WithdrawalAddress struct {
+	Coin       string
+	Network    string
+	Address    string
+	AddressTag string
+}
+
+// WithdrawalRequest define a withdrawal submitted to WithdrawalGuard
+type WithdrawalRequest struct {
+	Coin               string
+	Network            string // the default network of Coin when empty
+	Address            string
+	AddressTag         string
+	Amount             float64
+	TransactionFeeFlag bool
+	Name               string
+	WalletType         int // 0 spot, 1 funding
+	// WithdrawOrderId is set by WithdrawalGuard before the request is approved
+	WithdrawOrderId string
+}
+
+// WithdrawalApprover approves a withdrawal that passed the checks of
+// WithdrawalGuard. The withdrawal is submitted only when it returns nil. req
+// is a copy of the checked request; changes to it are not sent.
+type WithdrawalApprover func(ctx context.Context, req *WithdrawalRequest) error
+
+// WithdrawalResult define the result of WithdrawalGuard.Withdraw
+type WithdrawalResult struct {
+	Request *WithdrawalRequest
+	Id      string                   // id returned by WithdrawService
+	History *WithdrawHistoryResponse // the withdrawal once in a final status
+}
+
+// WithdrawalGuard is an opt-in guarded path for withdrawals. Before a
+// withdrawal is sent it checks the destination against an allowlist, the
+// amount against the network limits of GetAllCoinsInfoService and the daily
+// cap of the coin, then asks Approve. Withdrawals sent with WithdrawService
+// are not guarded.
+type WithdrawalGuard struct {
+	// Approve is required, every withdrawal is refused without it
+	Approve WithdrawalApprover
+	// PollInterval between WithdrawHistoryService queries, 10s by default
+	PollInterval time.Duration
+	// CoinsTTL is how long the network limits are cached, 1h by default
+	CoinsTTL time.Duration
+
+	c         *Client
+	mu        sync.Mutex
+	allowlist []WithdrawalAddress
+	caps      map[string]float64
+	used      map[string]float64 // amount withdrawn today by coin
+	day       string
+	coins     map[string]*CoinInfo
+	coinsAt   time.Time
+	now       func() time.Time
+}
+
+// NewWithdrawalGuard create a WithdrawalGuard sending withdrawals with c.
+// Nothing is allowed until addresses are added with Allow.
+func NewWithdrawalGuard(c *Client, approve WithdrawalApprover) *WithdrawalGuard {
+	return &WithdrawalGuard{
+		Approve:      approve,
+		PollInterval: 10 * time.Second,
+		CoinsTTL:     time.Hour,
+		c:            c,
+		caps:         make(map[string]float64),
+		used:         make(map[string]float64),
+		now:          time.Now,
+	}
+}
+
+// Allow add addresses to the allowlist
+func (g *WithdrawalGuard) Allow(addresses ...WithdrawalAddress) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.allowlist = append(g.allowlist, addresses...)
+}
+
+// SetDailyCap set the largest amount of coin withdrawn per UTC day. Coins
+// without a cap are only limited by the network maximum.
+func (g *WithdrawalGuard) SetDailyCap(coin string, amount float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.caps[coin] = amount
+}
+
+// Check req against the allowlist, the network limits and the daily cap
+// without sending it. The network of req is set to the default network of
+// its coin when empty.
+func (g *WithdrawalGuard) Check(ctx context.Context, req *WithdrawalRequest) error {
+	network, err := g.network(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !g.allowed(req) {
+		return fmt.Errorf("%w: %s %s on %s", ErrWithdrawalNotAllowed, req.Coin, req.Address, req.Network)
+	}
+	if err = checkNetworkLimits(req, network); err != nil {
+		return err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if err = g.loadUsage(ctx, req.Coin); err != nil {
+		return err
+	}
+	return g.checkCap(req)
+}
+
+// Withdraw check req, ask Approve, send it with an automatic withdrawOrderId
+// and follow it with WithdrawHistoryService until it reaches a final status.
+// When ctx ends after the withdrawal was sent, the result is returned with
+// the error of ctx; Wait resumes following it. The amount of a withdrawal
+// whose result is unknown stays counted in the daily cap.
+func (g *WithdrawalGuard) Withdraw(ctx context.Context, req WithdrawalRequest) (*WithdrawalResult, error) {
+	if err := g.Check(ctx, &req); err != nil {
+		return nil, err
+	}
+	if g.Approve == nil {
+		return nil, fmt.Errorf("%w: no approver", ErrWithdrawalNotApproved)
+	}
+	req.WithdrawOrderId = strings.ReplaceAll(getUUID(), "-", "")
+	approval := req
+	if err := g.Approve(ctx, &approval); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWithdrawalNotApproved, err)
+	}
+
+	// reserve the amount so that concurrent withdrawals cannot exceed the cap
+	g.mu.Lock()
+	if err := g.loadUsage(ctx, req.Coin); err != nil {
+		g.mu.Unlock()
+		return nil, err
+	}
+	if err := g.checkCap(&req); err != nil {
+		g.mu.Unlock()
+		return nil, err
+	}
+	day := g.day
+	g.used[req.Coin] += req.Amount
+	g.mu.Unlock()
+
+	svc := g.c.NewWithdrawService().Coin(req.Coin).Address(req.Address).Amount(req.Amount).
+		Network(req.Network).WithdrawOrderId(req.WithdrawOrderId).WalletType(req.WalletType)
+	if req.AddressTag != "" {
+		svc.AddressTag(req.AddressTag)
+	}
+	if req.TransactionFeeFlag {
+		svc.TransactionFeeFlag(true)
+	}
+	if req.Name != "" {
+		svc.Name(req.Name)
+	}
+	res, err := svc.Do(ctx)
+	if err != nil {
+		// the reservation is kept when the withdrawal may have been sent
+		if !statusUnknown(err) {
+			g.mu.Lock()
+			if g.day == day {
+				g.used[req.Coin] -= req.Amount
+			}
+			g.mu.Unlock()
+		}
+		return nil, err
+	}
+
+	result := &WithdrawalResult{Request: &req, Id: res.Id}
+	result.History, err = g.Wait(ctx, req.Coin, req.WithdrawOrderId)
+	return result, err
+}
+
+// Wait poll WithdrawHistoryService until the withdrawal of coin with
+// withdrawOrderId reaches a final status
+func (g *WithdrawalGuard) Wait(ctx context.Context, coin string, withdrawOrderId string) (*WithdrawHistoryResponse, error) {
+	for {
+		history, err := g.c.NewWithdrawHistoryService().Coin(coin).WithdrawOrderId(withdrawOrderId).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range history {
+			if w.WithdrawOrderId == withdrawOrderId && WithdrawStatus(w.Status).Final() {
+				return w, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(g.PollInterval):
+		}
+	}
+}
+
+// network returns the network of req, setting req.Network to the default
+// network of the coin when empty
+func (g *WithdrawalGuard) network(ctx context.Context, req *WithdrawalRequest) (*CoinNetwork, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.coins == nil || g.now().Sub(g.coinsAt) > g.CoinsTTL {
+		coins, err := g.c.NewGetAllCoinsInfoService().Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		g.coins = make(map[string]*CoinInfo, len(coins))
+		for _, coin := range coins {
+			g.coins[coin.Coin] = coin
+		}
+		g.coinsAt = g.now()
+	}
+	coin, ok := g.coins[req.Coin]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown coin %s", ErrWithdrawalLimit, req.Coin)
+	}
+	for i := range coin.NetworkList {
+		network := &coin.NetworkList[i]
+		if network.Network == req.Network || (req.Network == "" && network.IsDefault) {
+			req.Network = network.Network
+			return network, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: unknown network %q for %s", ErrWithdrawalLimit, req.Network, req.Coin)
+}
+
+func (g *WithdrawalGuard) allowed(req *WithdrawalRequest) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, a := range g.allowlist {
+		if a.Coin == req.Coin && a.Network == req.Network && a.Address == req.Address &&
+			(a.AddressTag == "" || a.AddressTag == req.AddressTag) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkNetworkLimits(req *WithdrawalRequest, network *CoinNetwork) error {
+	if !network.WithdrawEnable {
+		return fmt.Errorf("%w: withdrawals of %s on %s are disabled", ErrWithdrawalLimit, req.Coin, req.Network)
+	}
+	if network.AddressRegex != "" {
+		re, err := regexp.Compile(network.AddressRegex)
+		if err != nil {
+			return fmt.Errorf("%w: invalid %s address pattern %q: %v", ErrWithdrawalLimit, req.Network, network.AddressRegex, err)
+		}
+		if !re.MatchString(req.Address) {
+			return fmt.Errorf("%w: invalid %s address %s", ErrWithdrawalLimit, req.Network, req.Address)
+		}
+	}
+	if req.Amount <= 0 {
+		return fmt.Errorf("%w: amount %v", ErrWithdrawalLimit, req.Amount)
+	}
+	if min, err := parseRate(network.WithdrawMin); err != nil {
+		return err
+	} else if req.Amount < min {
+		return fmt.Errorf("%w: amount %v below minimum %s", ErrWithdrawalLimit, req.Amount, network.WithdrawMin)
+	}
+	if max, err := parseRate(network.WithdrawMax); err != nil {
+		return err
+	} else if max > 0 && req.Amount > max {
+		return fmt.Errorf("%w: amount %v above maximum %s", ErrWithdrawalLimit, req.Amount, network.WithdrawMax)
+	}
+	multiple, err := parseRate(network.WithdrawIntegerMultiple)
+	if err != nil {
+		return err
+	}
+	if multiple > 0 {
+		n := req.Amount / multiple
+		if math.Abs(n-math.Round(n)) > 1e-9*math.Max(1, n) {
+			return fmt.Errorf("%w: amount %v not a multiple of %s", ErrWithdrawalLimit, req.Amount, network.WithdrawIntegerMultiple)
+		}
+	}
+	return nil
+}
+
+// statusUnknown returns true when err leaves the result of a request unknown:
+// the request failed before a response, or the server did not know the
+// result either
+func statusUnknown(err error) bool {
+	var apiErr *handlers.APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	return apiErr.Code == -1000 || apiErr.Code == -1006 || apiErr.Code == -1007
+}
+
+// loadUsage resets the daily usage on a new UTC day and loads the amount of
+// coin already withdrawn today from WithdrawHistoryService, so that caps
+// survive a restart. g.mu must be held.
+func (g *WithdrawalGuard) loadUsage(ctx context.Context, coin string) error {
+	if _, ok := g.caps[coin]; !ok {
+		return nil
+	}
+	now := g.now().UTC()
+	day := now.Format("2006-01-02")
+	if day != g.day {
+		g.day = day
+		g.used = make(map[string]float64)
+	}
+	if _, ok := g.used[coin]; ok {
+		return nil
+	}
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	history, err := g.c.NewWithdrawHistoryService().Coin(coin).
+		StartTime(uint64(FormatTimestamp(midnight))).EndTime(uint64(FormatTimestamp(now))).Do(ctx)
+	if err != nil {
+		return err
+	}
+	var used float64
+	for _, w := range history {
+		switch WithdrawStatus(w.Status) {
+		case WithdrawStatusCancelled, WithdrawStatusRejected, WithdrawStatusFailure:
+			continue
+		}
+		amount, err := strconv.ParseFloat(w.Amount, 64)
+		if err != nil {
+			return err
+		}
+		used += amount
+	}
+	g.used[coin] = used
+	return nil
+}
+
+// checkCap g.mu must be held
+func (g *WithdrawalGuard) checkCap(req *WithdrawalRequest) error {
+	limit, ok := g.caps[req.Coin]
+	if !ok {
+		return nil
+	}
+	if used := g.used[req.Coin]; used+req.Amount > limit {
+		return fmt.Errorf("%w: %v %s withdrawn today, cap is %v", ErrWithdrawalDailyCap, used, req.Coin, limit)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package binance_connector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/binance/binance-connector-go/handlers"
+	"github.com/stretchr/testify/suite"
+)
+
+type withdrawalGuardTestSuite struct {
+	suite.Suite
+	exchange *withdrawExchange
+	guard    *WithdrawalGuard
+	approved []*WithdrawalRequest
+}
+
+func TestWithdrawalGuard(t *testing.T) {
+	suite.Run(t, new(withdrawalGuardTestSuite))
+}
+
+// withdrawExchange fakes the wallet endpoints used by WithdrawalGuard
+type withdrawExchange struct {
+	history   []*WithdrawHistoryResponse // returned for the daily usage
+	statuses  []WithdrawStatus           // returned while following a withdrawal
+	withdraws []url.Values
+	coinsInfo int
+	regex     string // addressRegex of the BTC network
+	applyErr  error  // answer to withdrawals
+}
+
+func (e *withdrawExchange) client() *Client {
+	return newFakeClient(fakeRoutes{
+		"/sapi/v1/capital/config/getall": func(req *http.Request) (interface{}, error) {
+			e.coinsInfo++
+			regex, _ := json.Marshal(e.regex)
+			return `[{"coin":"BTC","networkList":[
+				{"network":"BTC","isDefault":true,"withdrawEnable":true,"withdrawMin":"0.00001","withdrawMax":"10","withdrawIntegerMultiple":"0.00000001","addressRegex":` + string(regex) + `},
+				{"network":"LIGHTNING","withdrawEnable":false,"withdrawMin":"0.00000001","withdrawMax":"0.1"}]}]`, nil
+		},
+		"/sapi/v1/capital/withdraw/apply": func(req *http.Request) (interface{}, error) {
+			e.withdraws = append(e.withdraws, req.URL.Query())
+			if e.applyErr != nil {
+				return nil, e.applyErr
+			}
+			return `{"id":"7213fea8e94b4a5593d507237e5a555b"}`, nil
+		},
+		"/sapi/v1/capital/withdraw/history": func(req *http.Request) (interface{}, error) {
+			id := req.URL.Query().Get("withdrawOrderId")
+			if id == "" {
+				return e.history, nil
+			}
+			status := e.statuses[0]
+			e.statuses = e.statuses[1:]
+			return []*WithdrawHistoryResponse{{Id: "7213fea8e94b4a5593d507237e5a555b", Coin: "BTC", Amount: "0.5",
+				WithdrawOrderId: id, Status: int(status)}}, nil
+		},
+	}.serve)
+}
+
+func (s *withdrawalGuardTestSuite) SetupTest() {
+	s.exchange = &withdrawExchange{regex: "^[13bc][a-zA-Z0-9]{25,61}$"}
+	s.approved = nil
+	s.guard = NewWithdrawalGuard(s.exchange.client(), func(ctx context.Context, req *WithdrawalRequest) error {
+		s.approved = append(s.approved, req)
+		return nil
+	})
+	s.guard.PollInterval = time.Millisecond
+	s.guard.now = func() time.Time { return time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC) }
+	s.guard.Allow(WithdrawalAddress{Coin: "BTC", Network: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"})
+}
+
+func (s *withdrawalGuardTestSuite) TestWithdraw() {
+	r := s.Require()
+	s.exchange.statuses = []WithdrawStatus{WithdrawStatusProcessing, WithdrawStatusCompleted}
+
+	res, err := s.guard.Withdraw(context.Background(), WithdrawalRequest{
+		Coin: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", Amount: 0.00005,
+	})
+	r.NoError(err)
+	r.Len(s.approved, 1)
+	r.Equal("BTC", res.Request.Network)
+	r.Len(res.Request.WithdrawOrderId, 32)
+	r.Equal("7213fea8e94b4a5593d507237e5a555b", res.Id)
+	r.Equal(int(WithdrawStatusCompleted), res.History.Status)
+
+	r.Len(s.exchange.withdraws, 1)
+	r.Equal("0.00005", s.exchange.withdraws[0].Get("amount"))
+	r.Equal("BTC", s.exchange.withdraws[0].Get("network"))
+	r.Equal(res.Request.WithdrawOrderId, s.exchange.withdraws[0].Get("withdrawOrderId"))
+	r.Empty(s.exchange.statuses)
+}
+
+func (s *withdrawalGuardTestSuite) TestCheckRefusals() {
+	r := s.Require()
+	ctx := context.Background()
+	address := "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"
+	s.guard.Allow(WithdrawalAddress{Coin: "BTC", Network: "LIGHTNING", Address: "lnbc1"})
+
+	r.ErrorIs(s.guard.Check(ctx, &WithdrawalRequest{Coin: "BTC", Address: "bc1qother0000000000000000000000000", Amount: 1}), ErrWithdrawalNotAllowed)
+	r.ErrorIs(s.guard.Check(ctx, &WithdrawalRequest{Coin: "ETH", Address: address, Amount: 1}), ErrWithdrawalLimit)
+	r.ErrorIs(s.guard.Check(ctx, &WithdrawalRequest{Coin: "BTC", Address: address, Amount: 0.000001}), ErrWithdrawalLimit)
+	r.ErrorIs(s.guard.Check(ctx, &WithdrawalRequest{Coin: "BTC", Address: address, Amount: 11}), ErrWithdrawalLimit)
+	r.ErrorIs(s.guard.Check(ctx, &WithdrawalRequest{Coin: "BTC", Address: address, Amount: 0.000100001}), ErrWithdrawalLimit)
+	r.ErrorIs(s.guard.Check(ctx, &WithdrawalRequest{Coin: "BTC", Network: "LIGHTNING", Address: "lnbc1", Amount: 0.01}), ErrWithdrawalLimit)
+	r.NoError(s.guard.Check(ctx, &WithdrawalRequest{Coin: "BTC", Address: address, Amount: 0.12345678}))
+	r.Equal(1, s.exchange.coinsInfo)
+	r.Empty(s.exchange.withdraws)
+}
+
+func (s *withdrawalGuardTestSuite) TestDailyCap() {
+	r := s.Require()
+	s.exchange.history = []*WithdrawHistoryResponse{
+		{Coin: "BTC", Amount: "0.4", Status: int(WithdrawStatusCompleted)},
+		{Coin: "BTC", Amount: "5", Status: int(WithdrawStatusCancelled)},
+	}
+	s.exchange.statuses = []WithdrawStatus{WithdrawStatusCompleted}
+	s.guard.SetDailyCap("BTC", 1)
+	req := WithdrawalRequest{Coin: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", Amount: 0.5}
+
+	_, err := s.guard.Withdraw(context.Background(), req)
+	r.NoError(err)
+	_, err = s.guard.Withdraw(context.Background(), req)
+	r.ErrorIs(err, ErrWithdrawalDailyCap)
+	r.Len(s.exchange.withdraws, 1)
+
+	// the usage is reset on the next UTC day
+	s.exchange.history = nil
+	s.exchange.statuses = []WithdrawStatus{WithdrawStatusCompleted}
+	s.guard.now = func() time.Time { return time.Date(2023, 6, 2, 0, 30, 0, 0, time.UTC) }
+	_, err = s.guard.Withdraw(context.Background(), req)
+	r.NoError(err)
+	r.Len(s.exchange.withdraws, 2)
+}
+
+func (s *withdrawalGuardTestSuite) TestNotApproved() {
+	r := s.Require()
+	s.guard.SetDailyCap("BTC", 1)
+	s.guard.Approve = func(ctx context.Context, req *WithdrawalRequest) error {
+		return errors.New("rejected by operator")
+	}
+
+	_, err := s.guard.Withdraw(context.Background(), WithdrawalRequest{
+		Coin: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", Amount: 0.5,
+	})
+	r.ErrorIs(err, ErrWithdrawalNotApproved)
+	r.Empty(s.exchange.withdraws)
+	r.Zero(s.guard.used["BTC"])
+}
+
+func (s *withdrawalGuardTestSuite) TestApproverCannotChangeRequest() {
+	r := s.Require()
+	s.exchange.statuses = []WithdrawStatus{WithdrawStatusCompleted}
+	s.guard.Approve = func(ctx context.Context, req *WithdrawalRequest) error {
+		req.Address, req.Network, req.Amount, req.AddressTag = "bc1qnotallowed00000000000000000000", "LIGHTNING", 9, "memo"
+		return nil
+	}
+
+	res, err := s.guard.Withdraw(context.Background(), WithdrawalRequest{
+		Coin: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", Amount: 0.5,
+	})
+	r.NoError(err)
+	r.Equal("bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", res.Request.Address)
+	r.Len(s.exchange.withdraws, 1)
+	sent := s.exchange.withdraws[0]
+	r.Equal("bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", sent.Get("address"))
+	r.Equal("BTC", sent.Get("network"))
+	r.Equal("0.5", sent.Get("amount"))
+	r.Empty(sent.Get("addressTag"))
+}
+
+func (s *withdrawalGuardTestSuite) TestInvalidAddressRegex() {
+	r := s.Require()
+	s.exchange.regex = "^[13bc"
+	err := s.guard.Check(context.Background(), &WithdrawalRequest{
+		Coin: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", Amount: 0.5,
+	})
+	r.ErrorIs(err, ErrWithdrawalLimit)
+}
+
+func (s *withdrawalGuardTestSuite) TestFailedWithdrawalReservation() {
+	r := s.Require()
+	s.guard.SetDailyCap("BTC", 1)
+	req := WithdrawalRequest{Coin: "BTC", Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh", Amount: 0.5}
+
+	// a rejected withdrawal releases its reservation
+	s.exchange.applyErr = &handlers.APIError{Code: -4026, Message: "Exceeded the balance."}
+	_, err := s.guard.Withdraw(context.Background(), req)
+	r.Error(err)
+	r.Zero(s.guard.used["BTC"])
+
+	// a withdrawal that may have been sent keeps it
+	s.exchange.applyErr = errors.New("i/o timeout")
+	_, err = s.guard.Withdraw(context.Background(), req)
+	r.Error(err)
+	r.Equal(0.5, s.guard.used["BTC"])
+	s.exchange.applyErr = &handlers.APIError{Code: -1007, Message: "Timeout waiting for response from backend server."}
+	_, err = s.guard.Withdraw(context.Background(), req)
+	r.Error(err)
+	r.Equal(1.0, s.guard.used["BTC"])
+	_, err = s.guard.Withdraw(context.Background(), req)
+	r.ErrorIs(err, ErrWithdrawalDailyCap)
+}